@@ -22,6 +22,7 @@ const (
 	errInvalidCount = "Некоректне число %s"
 	errInvalidScore = "Некоректне значення системи балів"
 	errInvalidValue = "Некоректне значення. Будь ласка, спробуйте ще раз."
+	errInputClosed  = "Введення завершилося раніше, ніж було отримано всі дані"
 
 	// Table formats
 	headerFormat     = "%-30s"
@@ -64,7 +65,10 @@ func newInputReader() *inputReader {
 // readString reads a string from input
 func (ir *inputReader) readString(prompt string) (string, error) {
 	fmt.Print(prompt)
-	input, _ := ir.reader.ReadString('\n')
+	input, err := ir.reader.ReadString('\n')
+	if err != nil && input == "" {
+		return "", err
+	}
 	return strings.TrimSpace(input), nil
 }
 
@@ -88,7 +92,12 @@ func (ir *inputReader) readStringArray(count int, promptTemplate string) []strin
 func (ir *inputReader) readScore(alt string, maxScore int) float64 {
 	for {
 		prompt := fmt.Sprintf(promptScore, alt, maxScore)
-		scoreStr, _ := ir.readString(prompt)
+		scoreStr, err := ir.readString(prompt)
+		if err != nil {
+			// Input is closed: asking again would loop forever
+			fmt.Println("\n" + errInputClosed)
+			os.Exit(1)
+		}
 		score, err := strconv.ParseFloat(scoreStr, 64)
 		if err == nil && score >= 1 && score <= float64(maxScore) {
 			return score
@@ -0,0 +1,38 @@
+package main
+
+import "os"
+
+const (
+	ansiReset  = "\033[0m"
+	ansiBest   = "\033[1;32m" // жирний зелений – найкращі значення та переможець
+	ansiAccent = "\033[33m"   // жовтий – додаткове виділення
+)
+
+// colorEnabled визначає, чи виводяться кольори; вимикається прапорцем -no-color,
+// змінною середовища NO_COLOR або якщо стандартний вивід не є терміналом
+var colorEnabled bool
+
+func setupColor(noColor bool) {
+	colorEnabled = !noColor && os.Getenv("NO_COLOR") == "" && isTerminal(os.Stdout)
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func paint(s, color string) string {
+	if !colorEnabled {
+		return s
+	}
+	return color + s + ansiReset
+}
+
+// highlight виділяє найкраще значення або альтернативу-переможця
+func highlight(s string) string {
+	return paint(s, ansiBest)
+}
+
+func accent(s string) string {
+	return paint(s, ansiAccent)
+}
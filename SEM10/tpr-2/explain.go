@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// explain вмикає покроковий вивід проміжних обчислень (прапорець -explain)
+var explain bool
+
+func explainf(format string, args ...any) {
+	if explain {
+		fmt.Printf(tr(format), localize(args)...)
+	}
+}
+
+// joinValues форматує значення через кому для запису формул
+func joinValues(values []float64) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%.2f", v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// listValues форматує значення для покрокових пояснень за обраною локаллю
+func listValues(values []float64, sep string) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%.2f", num(v))
+	}
+	return strings.Join(parts, sep)
+}
+
+// ExplainCriteria виводить обчислення всіх критеріїв з підстановкою значень
+func (u *UncertainDecisionSystem) ExplainCriteria(alts []Alternative) {
+	explainf("\nКрок 1. Критерій Вальда – найменша корисність альтернативи: W(a) = min_j u(a, j)\n")
+	for _, a := range alts {
+		explainf("  W(%s) = min(%s) = %.2f\n", a.name, listValues(u.outcomes[a.name], valueSep()), a.wald)
+	}
+
+	explainf("\nКрок 2. Критерій maxmax – найбільша корисність альтернативи: M(a) = max_j u(a, j)\n")
+	for _, a := range alts {
+		explainf("  M(%s) = max(%s) = %.2f\n", a.name, listValues(u.outcomes[a.name], valueSep()), a.maxmax)
+	}
+
+	explainf("\nКрок 3. Критерій Гурвіца: H(a) = α·M(a) + (1 − α)·W(a), α = %.2f\n", u.alpha)
+	for _, a := range alts {
+		explainf("  H(%s) = %.2f·%.2f + %.2f·%.2f = %.4f\n",
+			a.name, u.alpha, a.maxmax, 1-u.alpha, a.wald, a.hurwicz)
+	}
+}
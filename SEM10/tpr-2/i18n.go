@@ -0,0 +1,176 @@
+package main
+
+import (
+	"os"
+	"strings"
+)
+
+// lang – мова інтерфейсу: "uk" (за замовчуванням) або "en"
+var lang = "uk"
+
+// setupLang обирає мову з прапорця -lang, а якщо його не задано – зі змінної
+// середовища LANG; українська лишається мовою за замовчуванням для C/POSIX
+func setupLang(flagValue string) {
+	if flagValue == "" {
+		flagValue = os.Getenv("LANG")
+		if flagValue == "" || flagValue == "C" || flagValue == "POSIX" || strings.HasPrefix(flagValue, "C.") {
+			flagValue = "uk"
+		}
+	}
+	lang = "en"
+	if strings.HasPrefix(strings.ToLower(flagValue), "uk") {
+		lang = "uk"
+	}
+}
+
+// tr повертає переклад повідомлення обраною мовою. Ключем каталогу є
+// український текст, тому повідомлення без перекладу виводяться як є.
+func tr(s string) string {
+	if lang == "en" {
+		if t, ok := catalogEN[s]; ok {
+			return t
+		}
+	}
+	return s
+}
+
+// catalogEN – англійські переклади підказок, заголовків таблиць і повідомлень про помилки
+var catalogEN = map[string]string{
+	// Введення задачі
+	promptAltCount:     "Enter the number of alternatives: ",
+	promptAltName:      "Enter the name of alternative %d: ",
+	promptAltValue:     "\nEnter the utility values for alternative '%s':\n",
+	promptStateCount:   "Enter the number of external conditions (states): ",
+	promptStateValue:   "Enter the utility of alternative '%s' in state %d (1 to %d): ",
+	promptMaxScore:     "Enter the maximum score of the rating scale (e.g. 10): ",
+	promptAlpha:        "Enter the optimism coefficient α (0 to 1): ",
+	promptBackHint:     "To return to the previous question and correct the answer, enter '<' or back.\n",
+	promptResume:       "Found unfinished input from %s (alternatives: %s; %d of %d values entered). Continue? (y/n): ",
+	promptPaste:        "Paste a block of cells from Excel or Google Sheets (alternative names in the first column, state header optional) and press Enter on an empty line:\n",
+	promptPasteConfirm: "Was the matrix recognised correctly? (Y/n): ",
+	"Перший рядок розпізнано як заголовок станів і пропущено":        "The first row was recognised as the state header and skipped",
+	"Розпізнано альтернатив: %d, станів: %d, система балів: до %d\n": "Recognised alternatives: %d, states: %d, rating scale: up to %d\n",
+	"альтернатив":    "alternatives",
+	"зовнішніх умов": "external conditions",
+
+	// Результати
+	promptCriterionResults: "\nResults for the %s criterion:\n",
+	reportTitle:            "Decision making under uncertainty: Wald, maxmax and Hurwicz criteria",
+	"\nМатриця корисності альтернатив для кожного стану:": "\nUtility matrix of alternatives for each state:",
+	"Матриця корисності": "Utility matrix",
+	"Значення критеріїв": "Criterion values",
+	"Альтернатива":       "Alternative",
+	"Стан %d":            "State %d",
+	"Ранг":               "Rank",
+	"Вальда":             "Wald",
+	"Гурвіца":            "Hurwicz",
+	"Ранжування за критерієм %s":                        "Ranking by the %s criterion",
+	"За критерієм %s оптимальна альтернатива – %s (%s)": "By the %s criterion the optimal alternative is %s (%s)",
+	"Значення критерію Гурвіца залежно від α":           "Hurwicz criterion value depending on α",
+	"Альтернативи за критеріями":                        "Alternatives by criteria",
+	"\nЗвіт збережено у файл %s\n":                      "\nReport saved to %s\n",
+	"Звіт збережено у файл %s\n":                        "Report saved to %s\n",
+	"Діаграму збережено у файл %s\n":                    "Chart saved to %s\n",
+	"\nРезультати записано на аркуш '%s' книги %s\n":    "\nResults written to sheet '%s' of workbook %s\n",
+	"Результати записано на аркуш '%s' книги %s\n":      "Results written to sheet '%s' of workbook %s\n",
+	"Висновки":           "Conclusions",
+	"Аналіз результатів": "Analysis of the results",
+	summaryWald:          "By the Wald criterion the optimal alternative is %s, because its guaranteed payoff – the lowest value over all states – is %.2f, the highest among the alternatives. This is the choice of a cautious decision maker preparing for the worst state.",
+	summaryMaxmax:        "By the maxmax criterion the optimal alternative is %s: in the most favourable state it yields the highest payoff of %.2f. This is the choice of an optimist who disregards risk.",
+	summaryHurwicz:       "By the Hurwicz criterion with the optimism coefficient α = %.2f the optimal alternative is %s: its weighted score α·max + (1 − α)·min equals %.2f, the highest of all.",
+	summaryTie:           "By the %s criterion alternatives %s are equally good with the value %.2f.",
+	summaryRunner:        " The closest alternative, %s, falls short by %.2f.",
+	summaryAgree:         "All criteria recommend alternative %s, so the choice does not depend on the decision maker's attitude to risk.",
+	summaryDiffer:        "The criteria give different recommendations (%s), so the final choice depends on the attitude to risk: a pessimist follows the Wald criterion, an optimist follows maxmax, and the Hurwicz criterion sets an intermediate attitude through α.",
+	"Теорія прийняття рішень": "Decision theory",
+	"Варіант ": "Variant ",
+
+	// Покрокові пояснення
+	"\nКрок 1. Критерій Вальда – найменша корисність альтернативи: W(a) = min_j u(a, j)\n":  "\nStep 1. Wald criterion – the lowest utility of an alternative: W(a) = min_j u(a, j)\n",
+	"\nКрок 2. Критерій maxmax – найбільша корисність альтернативи: M(a) = max_j u(a, j)\n": "\nStep 2. Maxmax criterion – the highest utility of an alternative: M(a) = max_j u(a, j)\n",
+	"\nКрок 3. Критерій Гурвіца: H(a) = α·M(a) + (1 − α)·W(a), α = %.2f\n":                  "\nStep 3. Hurwicz criterion: H(a) = α·M(a) + (1 − α)·W(a), α = %.2f\n",
+
+	// Вбудовані задачі
+	"Вбудовані задачі (-example <назва>):": "Built-in problems (-example <name>):",
+	"Задача '%s': %s (α = %.2f)\n":         "Problem '%s': %s (α = %.2f)\n",
+	"вибір культури для посіву за невідомих погодних умов (посуха, норма, дощове літо)":           "choosing a crop to sow under unknown weather (drought, normal, rainy summer)",
+	"розподіл капіталу між інструментами за різних станів економіки (спад, стагнація, зростання)": "allocating capital between instruments in different economic states (recession, stagnation, growth)",
+	"обсяг випуску продукції за невідомого попиту (низький, середній, високий, дуже високий)":     "production volume under unknown demand (low, medium, high, very high)",
+
+	// Перевірка розв'язків
+	"\nРезультати перевірки розв'язку:": "\nSolution check results:",
+	"Крок":      "Step",
+	"Правильно": "Correct",
+	"Всього":    "Total",
+	"Бал, %":    "Score, %",
+	"\nЗагальна оцінка: %d з %d (%s%%)\n": "\nOverall score: %d of %d (%s%%)\n",
+	gradeMissing:     "value for '%s' is missing",
+	gradeWrongValue:  "'%s': expected %.4f, got %.4f",
+	gradeMissingRank: "position %d is missing",
+	gradeWrongRank:   "position %d: '%s' is out of place",
+
+	// Помилки
+	errInvalidCount:   "Invalid number of %s",
+	errInvalidScore:   "Invalid rating scale value",
+	errInvalidValue:   "Invalid value. Please try again.",
+	errNoFont:         "No font with Cyrillic glyphs found for PDF, specify one with -font",
+	errXLSXEmpty:      "Sheet '%s' has no matrix: a header row and at least one alternative are required",
+	errXLSXDuplicate:  "Alternative '%s' is repeated in the Excel workbook",
+	errXLSXCell:       "Sheet '%s', cell %s: invalid number '%s'",
+	errScoreRange:     "The largest value %g exceeds the largest scoring system – up to %d",
+	errUnknownExample: "Unknown problem '%s', available: %s",
+	errRounding:       "Unknown rounding mode '%s', available: half-up, half-even",
+	errGradeFormat:    "Answer file %s does not match the calculation log format: %v",
+	errSessionFormat:  "Session file %s is corrupted, input will start from the beginning",
+	errSessionSave:    "Could not save the session: %v\n",
+	errStdinJSON:      "Invalid problem on standard input: %v",
+	errStdinShape:     "The problem must contain at least one alternative and an outcomes row for each (alternatives: %d, rows: %d)",
+	errStdinAlpha:     "The optimism coefficient alpha must be between 0 and 1, not %g",
+	errStdinDuplicate: "Alternative '%s' is repeated",
+	errStdinRow:       "Alternative '%s': %d values, but there are %d states",
+	errPasteEmpty:     "The pasted block has no matrix: a column of alternative names, at least one column of values and at least one alternative are required",
+	errPasteDuplicate: "Alternative '%s' is repeated in the pasted block",
+	errPasteCell:      "Row %d, column %d of the pasted block: invalid number '%s'",
+
+	// Виключення домінованих альтернатив
+	"\nВиключення строго домінованих альтернатив (гірші за іншу альтернативу за кожного стану):": "\nEliminating strictly dominated alternatives (worse than another alternative in every state):",
+	"  Строго домінованих альтернатив немає":                                                     "  There are no strictly dominated alternatives",
+	"  %s виключено: домінують %s\n":                                                             "  %s eliminated: dominated by %s\n",
+	"  Залишилось альтернатив: %d (%s)\n":                                                        "  Alternatives remaining: %d (%s)\n",
+
+	// Що, якщо та команди після розрахунку
+	errWhatIfSyntax:                 "Usage: set <alternative> <state> <value>",
+	errWhatIfAlt:                    "Unknown alternative '%s'",
+	errWhatIfState:                  "Invalid state '%s': a number from 1 to %d is required (e.g. 3 or state3)",
+	errWhatIfValue:                  "Invalid value '%s': a number from 1 to %d is required",
+	"u(%s, стан %d): %.2f → %.2f\n": "u(%s, state %d): %.2f → %.2f\n",
+	"Перераховано %s: Вальда %.4f, maxmax %.4f, Гурвіца %.4f\n": "Recalculated %s: Wald %.4f, maxmax %.4f, Hurwicz %.4f\n",
+	"  %s: ранжування без змін\n":                               "  %s: ranking unchanged\n",
+	promptREPL: "\nCommand (help – help, quit – exit): ",
+	replHelp: `Commands:
+  set <alternative> <state> <value>      change one matrix value, e.g.: set A2 state3 7.5
+  rerun [criterion] [α]                  recalculate and print rankings (wald, maxmax, hurwicz),
+                                         e.g.: rerun hurwicz 0.7
+  add alt "<name>"                       add an alternative and enter its utility values
+  export <format> <file>                 save results: json, md, html, tex or xlsx
+  help                                   this help
+  quit                                   exit`,
+	errREPLCommand:   "Unknown command '%s', enter help for help",
+	errREPLQuote:     "Unclosed quotes in the command",
+	errREPLCriterion: "Unknown criterion '%s', available: %s",
+	errREPLAlpha:     "Invalid α '%s': a number from 0 to 1 is required",
+	errREPLAddSyntax: "Usage: add alt \"<name>\"",
+	errREPLAltExists: "Alternative '%s' already exists",
+	errREPLExport:    "Usage: export <format> <file>",
+	errREPLFormat:    "Unknown format '%s', available: json, md, html, tex, xlsx",
+	"Альтернативу '%s' додано\n": "Alternative '%s' added\n",
+
+	// Повноекранний редактор
+	tuiHelp:               "←↑↓→/Tab – move, Backspace – erase, [ ] – change α, Enter – calculate, Esc – exit",
+	tuiIncomplete:         "Fill in a row to see the criterion values",
+	errTUICell:            "Cell (%s, state %d): a number from 1 to %d is required",
+	errTUICancelled:       "Editing cancelled",
+	tuiSelectedValue:      "Alternative '%s', state %d: %s",
+	"Критерії (α = %.2f)": "Criteria (α = %.2f)",
+	"Найкраща за критерієм %s: %s": "Best by the %s criterion: %s",
+}
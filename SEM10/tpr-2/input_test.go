@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"tprtest"
+)
+
+// fuzzInput повертає читача введення з даних фазера
+func fuzzInput(data string) *inputReader {
+	return &inputReader{tprtest.Input(data)}
+}
+
+func FuzzReadNumbers(f *testing.F) {
+	for _, s := range []string{"3\n", "0\n-1\n2\n", "abc\n<\n", "7,5\n", "NaN\nInf\n1e400\n0.5", "\n\n\n"} {
+		f.Add(s)
+	}
+	tprtest.Silence(f)
+	f.Fuzz(func(t *testing.T, data string) {
+		// Повний перший рядок із коректною відповіддю має бути прийнятий без змін
+		first, complete := tprtest.FirstAnswer(data), strings.Contains(data, "\n")
+
+		n, err := fuzzInput(data).readPositive("", "")
+		switch {
+		case err == nil && n <= 0:
+			t.Fatalf("readPositive повернув %d", n)
+		case err != nil && err != io.EOF && !errors.Is(err, errBack):
+			t.Fatalf("readPositive: неочікувана помилка %v", err)
+		}
+		if want, perr := strconv.Atoi(first); complete && perr == nil && want > 0 && (err != nil || n != want) {
+			t.Fatalf("readPositive(%q) = %d, %v; очікувалося %d", first, n, err, want)
+		}
+
+		v, err := fuzzInput(data).readValidatedFloat("", 0, 1)
+		switch {
+		case err == nil && !(v >= 0 && v <= 1):
+			t.Fatalf("readValidatedFloat повернув %v поза [0, 1]", v)
+		case err != nil && err != io.EOF && !errors.Is(err, errBack):
+			t.Fatalf("readValidatedFloat: неочікувана помилка %v", err)
+		}
+		if want, perr := parseFloat(first); complete && perr == nil && want >= 0 && want <= 1 && (err != nil || v != want) {
+			t.Fatalf("readValidatedFloat(%q) = %v, %v; очікувалося %v", first, v, err, want)
+		}
+	})
+}
+
+func FuzzPaste(f *testing.F) {
+	for _, s := range []string{
+		"Альтернатива\tПосуха\tНорма\nA\t1\t2\nB\t3\t4\n\n",
+		"A\t1,5\t2\nB\t3",
+		"\t\t\n x \t\nA\tNaN\n",
+		"S1\tS2\n",
+		"A\t1e300\n",
+	} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, data string) {
+		rows, err := fuzzInput(data).readPasted()
+		if err != nil {
+			if strings.TrimSpace(data) != "" {
+				t.Fatalf("непорожній блок не прочитано: %v", err)
+			}
+			return
+		}
+		u, header, err := parsePasted(rows)
+		if err != nil {
+			return
+		}
+		if len(u.alternatives) == 0 || u.statesCount < 1 || u.maxScore < 0 {
+			t.Fatalf("прийнято некоректну матрицю: %d альтернатив, %d станів, бали до %d", len(u.alternatives), u.statesCount, u.maxScore)
+		}
+		if header {
+			rows = rows[1:]
+		}
+		if len(u.alternatives) != len(rows) || len(u.outcomes) != len(rows) {
+			t.Fatalf("%d рядків даних, а прийнято %d альтернатив (%d рядків результатів)", len(rows), len(u.alternatives), len(u.outcomes))
+		}
+		for _, alt := range u.alternatives {
+			if len(u.outcomes[alt]) != u.statesCount {
+				t.Fatalf("альтернатива %q: %d значень, а станів %d", alt, len(u.outcomes[alt]), u.statesCount)
+			}
+			for _, v := range u.outcomes[alt] {
+				if math.IsNaN(v) || math.IsInf(v, 0) {
+					t.Fatalf("альтернатива %q: некоректне значення %v", alt, v)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// decimalSep – десятковий роздільник у виведених числах (прапорець -locale)
+var decimalSep = "."
+
+// setupLocale обирає формат чисел: uk – десяткова кома, en – десяткова крапка.
+// Якщо прапорець не задано, локаль визначається зі змінних LC_ALL, LC_NUMERIC та LANG.
+func setupLocale(flagValue string) {
+	for _, env := range []string{"LC_ALL", "LC_NUMERIC", "LANG"} {
+		if flagValue != "" {
+			break
+		}
+		flagValue = os.Getenv(env)
+	}
+	decimalSep = "."
+	if strings.HasPrefix(strings.ToLower(flagValue), "uk") {
+		decimalSep = ","
+	}
+}
+
+// maxScoreLimit – найбільша система балів для матриці, зчитаної з файлу або вставленої
+const maxScoreLimit = 1_000_000
+
+// scoreScale повертає систему балів для матриці з найбільшим значенням maxVal
+func scoreScale(maxVal float64) (int, error) {
+	if maxVal > maxScoreLimit {
+		return 0, fmt.Errorf(tr(errScoreRange), maxVal, maxScoreLimit)
+	}
+	return int(math.Ceil(maxVal)), nil
+}
+
+// parseFloat розбирає число з десятковою крапкою або комою: українська
+// розкладка й електронні таблиці за замовчуванням використовують кому.
+// NaN і нескінченності, які приймає strconv.ParseFloat, не є коректними балами.
+func parseFloat(s string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.Replace(strings.TrimSpace(s), ",", ".", 1), 64)
+	if err == nil && (math.IsNaN(v) || math.IsInf(v, 0)) {
+		return 0, &strconv.NumError{Func: "ParseFloat", Num: s, Err: strconv.ErrSyntax}
+	}
+	return v, err
+}
+
+// valueSep повертає роздільник переліку чисел: з десятковою комою значення
+// розділяються крапкою з комою, щоб «7,5; 2» не читалося як три числа
+func valueSep() string {
+	if decimalSep == "," {
+		return "; "
+	}
+	return ", "
+}
+
+// precision – кількість знаків після коми для всіх виведених чисел (прапорець
+// -precision); від'ємне значення залишає типову точність кожної таблиці
+var precision = -1
+
+// halfEven вмикає банківське округлення (половина – до парного) замість
+// округлення половини вгору (прапорець -rounding)
+var halfEven bool
+
+// setupRounding обирає спосіб округлення: half-up або half-even
+func setupRounding(mode string) error {
+	switch mode {
+	case "half-up":
+		halfEven = false
+	case "half-even":
+		halfEven = true
+	default:
+		return fmt.Errorf(tr(errRounding), mode)
+	}
+	return nil
+}
+
+// roundDecimal округлює v до prec знаків після коми за десятковим записом
+// числа, а не за його двійковим наближенням, тому 2.675 дає 2.68, як і під
+// час обчислень вручну; половина округлюється вгору або до парного (halfEven)
+func roundDecimal(v float64, prec int) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return strconv.FormatFloat(v, 'f', prec, 64)
+	}
+	intPart, frac, _ := strings.Cut(strconv.FormatFloat(math.Abs(v), 'f', -1, 64), ".")
+	frac += strings.Repeat("0", max(prec-len(frac), 0))
+	digits, rest := []byte(intPart+frac[:prec]), frac[prec:]
+
+	up := rest != "" && rest[0] > '5'
+	if rest != "" && rest[0] == '5' {
+		odd := (digits[len(digits)-1]-'0')%2 == 1
+		up = !halfEven || odd || strings.TrimRight(rest[1:], "0") != ""
+	}
+	if up {
+		i := len(digits) - 1
+		for ; i >= 0 && digits[i] == '9'; i-- {
+			digits[i] = '0'
+		}
+		if i < 0 {
+			digits = append([]byte{'1'}, digits...)
+		} else {
+			digits[i]++
+		}
+	}
+
+	s := string(digits[:len(digits)-prec])
+	if prec > 0 {
+		s += "." + string(digits[len(digits)-prec:])
+	}
+	if v < 0 && strings.Trim(s, "0.") != "" {
+		s = "-" + s
+	}
+	return s
+}
+
+// num – число, яке під час форматування через fmt виводиться з обраними
+// точністю, способом округлення та десятковим роздільником; ширина дієслова
+// (%8.2f) зберігається
+type num float64
+
+func (n num) Format(f fmt.State, verb rune) {
+	prec, ok := f.Precision()
+	if precision >= 0 {
+		prec, ok = precision, true
+	}
+	if !ok {
+		prec = 6
+	}
+	s := strings.Replace(roundDecimal(float64(n), prec), ".", decimalSep, 1)
+
+	if w, ok := f.Width(); ok {
+		if f.Flag('-') {
+			s = fmt.Sprintf("%-*s", w, s)
+		} else {
+			s = fmt.Sprintf("%*s", w, s)
+		}
+	}
+	fmt.Fprint(f, s)
+}
+
+// localize замінює аргументи типу float64 на num, щоб вони виводилися за локаллю
+func localize(args []any) []any {
+	out := make([]any, len(args))
+	for i, a := range args {
+		if v, ok := a.(float64); ok {
+			a = num(v)
+		}
+		out[i] = a
+	}
+	return out
+}
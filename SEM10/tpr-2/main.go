@@ -2,7 +2,10 @@ package main
 
 import (
 	"bufio"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strconv"
@@ -18,17 +21,39 @@ const (
 	promptMaxScore         = "Введіть максимальне значення бальної системи (наприклад, 10): "
 	promptAlpha            = "Введіть коефіцієнт оптимізму α (від 0 до 1): "
 	promptCriterionResults = "\nРезультати за критерієм %s:\n"
-
-	errInvalidCount = "Некоректне число %s"
-	errInvalidScore = "Некоректне значення системи балів"
-	errInvalidValue = "Некоректне значення. Будь ласка, спробуйте ще раз."
-
-	headerFormat      = "%-20s"
-	altHeaderFormat   = "%-20s"
-	stateHeaderFormat = "%-15s"
-	scoreFormat       = "%-15.2f"
-	resultRankFormat  = "%-5s %-20s %-15s\n"
-	resultItemFormat  = "%-5d %-20s %-15.4f\n"
+	promptBackHint         = "Щоб повернутися до попереднього питання й виправити відповідь, введіть '<' або back.\n"
+	promptResume           = "Знайдено незавершене введення від %s (альтернативи: %s; введено %d з %d значень). Продовжити? (т/н): "
+	promptPaste            = "Вставте блок клітинок з Excel або Google Sheets (назви альтернатив у першому стовпці, заголовок станів – за бажанням) і натисніть Enter на порожньому рядку:\n"
+	promptPasteConfirm     = "Матрицю розпізнано правильно? (Т/н): "
+
+	reportTitle = "Прийняття рішень в умовах невизначеності: критерії Вальда, maxmax та Гурвіца"
+
+	errInvalidCount   = "Некоректне число %s"
+	errInvalidScore   = "Некоректне значення системи балів"
+	errScoreRange     = "Найбільше значення %g перевищує найбільшу систему балів – до %d"
+	errInvalidValue   = "Некоректне значення. Будь ласка, спробуйте ще раз."
+	errNoFont         = "Не знайдено шрифт із кирилицею для PDF, вкажіть його через -font"
+	errXLSXEmpty      = "Аркуш '%s' не містить матриці: потрібен рядок заголовків і хоча б одна альтернатива"
+	errXLSXDuplicate  = "Альтернатива '%s' повторюється в книзі Excel"
+	errXLSXCell       = "Аркуш '%s', клітинка %s: некоректне число '%s'"
+	errUnknownExample = "Невідома задача '%s', доступні: %s"
+	errRounding       = "Невідомий спосіб округлення '%s', доступні: half-up, half-even"
+	errGradeFormat    = "Файл відповідей %s не відповідає формату журналу обчислень: %v"
+	errSessionFormat  = "Файл сесії %s пошкоджено, введення почнеться спочатку"
+	errSessionSave    = "Не вдалося зберегти сесію: %v\n"
+	errStdinJSON      = "Некоректна задача на стандартному вході: %v"
+	errStdinShape     = "Задача має містити хоча б одну альтернативу й рядок outcomes для кожної (альтернатив: %d, рядків: %d)"
+	errStdinAlpha     = "Коефіцієнт оптимізму alpha має бути від 0 до 1, а не %g"
+	errStdinDuplicate = "Альтернатива '%s' повторюється"
+	errStdinRow       = "Альтернатива '%s': %d значень, а станів %d"
+	errPasteEmpty     = "Вставлений блок не містить матриці: потрібні стовпець назв альтернатив, хоча б один стовпець значень і хоча б одна альтернатива"
+	errPasteDuplicate = "Альтернатива '%s' повторюється у вставленому блоці"
+	errPasteCell      = "Рядок %d, стовпець %d вставленого блоку: некоректне число '%s'"
+
+	gradeMissing     = "значення для '%s' відсутнє"
+	gradeWrongValue  = "'%s': очікувалося %.4f, отримано %.4f"
+	gradeMissingRank = "позиція %d відсутня"
+	gradeWrongRank   = "позиція %d: '%s' не на своєму місці"
 )
 
 type (
@@ -47,8 +72,18 @@ type (
 		alternatives []string
 		statesCount  int
 		maxScore     int
+		alpha        float64 // коефіцієнт оптимізму для критерію Гурвіца
 		// outcomes maps alternative name to slice of outcomes
 		outcomes map[string][]float64
+		// sessionPath – файл для збереження незавершеного введення (порожній – не зберігати)
+		sessionPath string
+	}
+
+	// criterion – критерій вибору: назва для виводу, ідентифікатор для файлів і значення альтернативи
+	criterion struct {
+		name  string
+		file  string
+		value func(a Alternative) float64
 	}
 
 	ByCriterion struct {
@@ -57,6 +92,12 @@ type (
 	}
 )
 
+var criteria = []criterion{
+	{"Вальда", "wald", func(a Alternative) float64 { return a.wald }},
+	{"maxmax", "maxmax", func(a Alternative) float64 { return a.maxmax }},
+	{"Гурвіца", "hurwicz", func(a Alternative) float64 { return a.hurwicz }},
+}
+
 func newInputReader() *inputReader {
 	return &inputReader{bufio.NewReader(os.Stdin)}
 }
@@ -70,8 +111,26 @@ func (ir *inputReader) readString(prompt string) (string, error) {
 	return strings.TrimSpace(input), nil
 }
 
-func (ir *inputReader) readInt(prompt string) (int, error) {
+// errBack повертається під час читання відповіді, якщо користувач попросив
+// повернутися до попереднього питання
+var errBack = errors.New("повернення до попереднього питання")
+
+// isBack перевіряє, чи є відповідь командою повернення до попереднього питання
+func isBack(input string) bool {
+	return input == "<" || strings.EqualFold(input, "back") || strings.EqualFold(input, "назад")
+}
+
+// readAnswer зчитує відповідь на питання введення задачі, розпізнаючи команду повернення
+func (ir *inputReader) readAnswer(prompt string) (string, error) {
 	input, err := ir.readString(prompt)
+	if err == nil && isBack(input) {
+		return "", errBack
+	}
+	return input, err
+}
+
+func (ir *inputReader) readInt(prompt string) (int, error) {
+	input, err := ir.readAnswer(prompt)
 	if err != nil {
 		return 0, err
 	}
@@ -79,132 +138,343 @@ func (ir *inputReader) readInt(prompt string) (int, error) {
 }
 
 func (ir *inputReader) readFloat(prompt string) (float64, error) {
-	input, err := ir.readString(prompt)
+	input, err := ir.readAnswer(prompt)
 	if err != nil {
 		return 0, err
 	}
-	return strconv.ParseFloat(input, 64)
+	return parseFloat(input)
 }
 
-func (ir *inputReader) readStringArray(count int, promptTemplate string) []string {
-	items := make([]string, count)
-	for i := range count {
-		prompt := fmt.Sprintf(promptTemplate, i+1)
-		str, _ := ir.readString(prompt)
-		items[i] = str
+// readPositive запитує додатне ціле число, доки не буде введено коректне;
+// invalid виводиться після кожної некоректної відповіді
+func (ir *inputReader) readPositive(prompt, invalid string) (int, error) {
+	for {
+		value, err := ir.readInt(prompt)
+		var numErr *strconv.NumError
+		switch {
+		case err == nil && value > 0:
+			return value, nil
+		case err != nil && !errors.As(err, &numErr):
+			return 0, err
+		}
+		fmt.Println(invalid)
 	}
-	return items
 }
 
-func (ir *inputReader) readValidatedFloat(prompt string, min, max float64) float64 {
+// readValidatedFloat запитує число з відрізка [min, max], доки не буде введено
+// коректне; помилка повертається лише для команди повернення або кінця введення
+func (ir *inputReader) readValidatedFloat(prompt string, min, max float64) (float64, error) {
 	for {
 		value, err := ir.readFloat(prompt)
-		if err == nil && value >= min && value <= max {
-			return value
+		var numErr *strconv.NumError
+		switch {
+		case err == nil && value >= min && value <= max:
+			return value, nil
+		case err != nil && !errors.As(err, &numErr):
+			return 0, err
 		}
-		fmt.Println(errInvalidValue)
+		fmt.Println(tr(errInvalidValue))
 	}
 }
 
-func newUncertainDecisionSystem(ir *inputReader) (*UncertainDecisionSystem, error) {
-	altCount, err := ir.readInt(promptAltCount)
-	if err != nil || altCount <= 0 {
-		return nil, fmt.Errorf(errInvalidCount, "альтернатив")
-	}
-
-	alternatives := ir.readStringArray(altCount, promptAltName)
+func newUncertainDecisionSystem() *UncertainDecisionSystem {
+	return &UncertainDecisionSystem{outcomes: make(map[string][]float64)}
+}
 
-	stateCount, err := ir.readInt(promptStateCount)
-	if err != nil || stateCount <= 0 {
-		return nil, fmt.Errorf(errInvalidCount, "зовнішніх умов")
+// answered повертає кількість питань інтерактивного введення, на які вже є
+// відповіді: кількість альтернатив, їх назви, кількість станів, система балів
+// і введені значення матриці
+func (u *UncertainDecisionSystem) answered() int {
+	if u.maxScore == 0 {
+		return 0
 	}
-
-	maxScore, err := ir.readInt(promptMaxScore)
-	if err != nil || maxScore <= 0 {
-		return nil, fmt.Errorf(errInvalidScore)
+	n := len(u.alternatives) + 3
+	for _, alt := range u.alternatives {
+		n += len(u.outcomes[alt])
 	}
+	return n
+}
 
-	return &UncertainDecisionSystem{
-		alternatives: alternatives,
-		statesCount:  stateCount,
-		maxScore:     maxScore,
-		outcomes:     make(map[string][]float64),
-	}, nil
+// ReadProblem інтерактивно запитує задачу: альтернативи, кількість станів, систему
+// балів і матрицю корисності. Введення починається з першого питання без відповіді
+// (після відновлення сесії), відповідь '<' або back повертає до попереднього питання,
+// а після кожного значення матриці стан зберігається у файл сесії.
+func (u *UncertainDecisionSystem) ReadProblem(ir *inputReader) error {
+	return u.readProblem(ir, false)
 }
 
-func (u *UncertainDecisionSystem) CollectOutcomes(ir *inputReader) {
-	for _, alt := range u.alternatives {
-		fmt.Printf(promptAltValue, alt)
-		outcomeSlice := make([]float64, u.statesCount)
+// ReadHeader запитує лише альтернативи, кількість станів і систему балів,
+// залишаючи матрицю для заповнення в іншому режимі (наприклад, -tui)
+func (u *UncertainDecisionSystem) ReadHeader(ir *inputReader) error {
+	return u.readProblem(ir, true)
+}
 
-		for j := range u.statesCount {
-			prompt := fmt.Sprintf(promptStateValue, alt, j+1, u.maxScore)
-			outcomeSlice[j] = ir.readValidatedFloat(prompt, 1, float64(u.maxScore))
+func (u *UncertainDecisionSystem) readProblem(ir *inputReader, headerOnly bool) error {
+	for pos := u.answered(); ; {
+		n := len(u.alternatives)
+		cell := pos - n - 3
+		var err error
+
+		switch {
+		case pos == 0:
+			var count int
+			count, err = ir.readPositive(tr(promptAltCount), fmt.Sprintf(tr(errInvalidCount), tr("альтернатив")))
+			if err == nil && count != n {
+				u.alternatives = make([]string, count)
+			}
+		case pos <= n:
+			u.alternatives[pos-1], err = ir.readAnswer(fmt.Sprintf(tr(promptAltName), pos))
+		case pos == n+1:
+			u.statesCount, err = ir.readPositive(tr(promptStateCount), fmt.Sprintf(tr(errInvalidCount), tr("зовнішніх умов")))
+		case pos == n+2:
+			u.maxScore, err = ir.readPositive(tr(promptMaxScore), tr(errInvalidScore))
+			if err == nil {
+				for _, alt := range u.alternatives {
+					u.outcomes[alt] = nil
+				}
+				u.saveSession()
+			}
+		case headerOnly && cell == 0:
+			return nil
+		case cell < n*u.statesCount:
+			alt, j := u.alternatives[cell/u.statesCount], cell%u.statesCount
+			if j == 0 {
+				fmt.Printf(tr(promptAltValue), alt)
+			}
+			var value float64
+			prompt := fmt.Sprintf(tr(promptStateValue), alt, j+1, u.maxScore)
+			if value, err = ir.readValidatedFloat(prompt, 1, float64(u.maxScore)); err == nil {
+				u.outcomes[alt] = append(u.outcomes[alt][:j], value)
+				u.saveSession()
+			}
+		default:
+			u.removeSession()
+			return nil
 		}
 
-		u.outcomes[alt] = outcomeSlice
+		switch {
+		case err == errBack:
+			pos = max(pos-1, 0)
+			u.unanswer(pos)
+		case err != nil:
+			return err
+		default:
+			pos++
+		}
+	}
+}
+
+// unanswer скасовує відповідь на питання pos, якщо це значення матриці
+func (u *UncertainDecisionSystem) unanswer(pos int) {
+	cell := pos - len(u.alternatives) - 3
+	if cell < 0 {
+		return
+	}
+	alt := u.alternatives[cell/u.statesCount]
+	u.outcomes[alt] = u.outcomes[alt][:cell%u.statesCount]
+}
+
+// ReadAlpha запитує коефіцієнт оптимізму. Якщо матрицю введено вручну (interactive),
+// повернення '<' відкриває для виправлення останнє значення матриці.
+func (u *UncertainDecisionSystem) ReadAlpha(ir *inputReader, interactive bool) error {
+	for {
+		alpha, err := ir.readValidatedFloat(tr(promptAlpha), 0, 1)
+		switch {
+		case err == nil:
+			u.alpha = alpha
+			return nil
+		case err != errBack:
+			return err
+		case interactive:
+			u.unanswer(u.answered() - 1)
+			if err := u.ReadProblem(ir); err != nil {
+				return err
+			}
+			u.PrintOutcomesMatrix()
+		}
 	}
 }
 
 func (u *UncertainDecisionSystem) PrintOutcomesMatrix() {
-	fmt.Println("\nМатриця корисності альтернатив для кожного стану:")
-	fmt.Printf(headerFormat, "Альтернатива")
+	fmt.Println(tr("\nМатриця корисності альтернатив для кожного стану:"))
+
+	// Найкраще значення кожного стану виділяється кольором
+	best := make([]float64, u.statesCount)
+	for j := range best {
+		for i, alt := range u.alternatives {
+			if i == 0 || u.outcomes[alt][j] > best[j] {
+				best[j] = u.outcomes[alt][j]
+			}
+		}
+	}
 
+	RenderTable(os.Stdout, u.OutcomesTable(), func(row, col int, cell string) string {
+		if row >= 0 && col > 0 && u.outcomes[u.alternatives[row]][col-1] == best[col-1] {
+			return accent(cell)
+		}
+		return cell
+	})
+}
+
+// OutcomesTable повертає матрицю корисності у вигляді таблиці для звіту
+func (u *UncertainDecisionSystem) OutcomesTable() Table {
+	t := Table{Title: tr("Матриця корисності"), Header: []string{tr("Альтернатива")}}
 	for j := range u.statesCount {
-		fmt.Printf(stateHeaderFormat, fmt.Sprintf("Стан %d", j+1))
+		t.Header = append(t.Header, fmt.Sprintf(tr("Стан %d"), j+1))
 	}
-	fmt.Println()
-
 	for _, alt := range u.alternatives {
-		fmt.Printf(altHeaderFormat, alt)
+		row := []string{alt}
 		for _, outcome := range u.outcomes[alt] {
-			fmt.Printf(scoreFormat, outcome)
+			row = append(row, fmt.Sprintf("%.2f", num(outcome)))
 		}
-		fmt.Println()
+		t.Rows = append(t.Rows, row)
 	}
+	return t
 }
 
-func (u *UncertainDecisionSystem) CalculateCriteria(ir *inputReader) []Alternative {
-	alpha := ir.readValidatedFloat(promptAlpha, 0, 1)
-	alts := make([]Alternative, len(u.alternatives))
+// CriteriaTable зводить значення всіх критеріїв для кожної альтернативи
+func CriteriaTable(alts []Alternative) Table {
+	t := Table{
+		Title:  tr("Значення критеріїв"),
+		Header: []string{tr("Альтернатива"), tr("Вальда"), "maxmax", tr("Гурвіца")},
+	}
+	for _, a := range alts {
+		t.Rows = append(t.Rows, []string{
+			a.name,
+			fmt.Sprintf("%.4f", num(a.wald)),
+			fmt.Sprintf("%.4f", num(a.maxmax)),
+			fmt.Sprintf("%.4f", num(a.hurwicz)),
+		})
+	}
+	return t
+}
+
+// RankingTable повертає ранжування альтернатив за критерієм, не змінюючи порядок alts
+func RankingTable(criterionName string, alts []Alternative, valueFunc func(a Alternative) float64) Table {
+	sorted := append([]Alternative(nil), alts...)
+	sort.Stable(ByCriterion{alts: sorted, value: valueFunc})
+
+	t := Table{
+		Title:  fmt.Sprintf(tr("Ранжування за критерієм %s"), tr(criterionName)),
+		Header: []string{tr("Ранг"), tr("Альтернатива"), tr(criterionName)},
+	}
+	for i, a := range sorted {
+		t.Rows = append(t.Rows, []string{fmt.Sprint(i + 1), a.name, fmt.Sprintf("%.4f", num(valueFunc(a)))})
+	}
+	return t
+}
 
+// Results будує звіт і журнал обчислень для поточних значень критеріїв
+func (u *UncertainDecisionSystem) Results(alts []Alternative, variant string) (*Report, *Trace) {
+	report := &Report{Title: tr(reportTitle), Variant: variant}
+	report.Add(u.OutcomesTable())
+	report.Add(CriteriaTable(alts))
+
+	trace := u.Trace(alts)
+	for _, c := range criteria {
+		ranking := RankingTable(c.name, alts, c.value)
+		report.Add(ranking)
+		best := ranking.Rows[0]
+		report.Conclusions = append(report.Conclusions,
+			fmt.Sprintf(tr("За критерієм %s оптимальна альтернатива – %s (%s)"), tr(c.name), best[1], best[2]))
+
+		labels, values := rankingValues(alts, c.value)
+		trace.AddRanking(c.file, labels, values)
+	}
+	report.Analysis = u.Summary(alts)
+	return report, trace
+}
+
+func (u *UncertainDecisionSystem) CalculateCriteria() []Alternative {
+	alts := make([]Alternative, len(u.alternatives))
 	for i, alt := range u.alternatives {
-		data := u.outcomes[alt]
-		if len(data) == 0 {
-			continue
-		}
+		alts[i] = u.evaluate(alt)
+	}
 
-		minVal, maxVal := data[0], data[0]
-		for _, v := range data {
-			if v < minVal {
-				minVal = v
-			}
-			if v > maxVal {
-				maxVal = v
-			}
-		}
+	if explain {
+		u.ExplainCriteria(alts)
+	}
+	return alts
+}
 
-		hurwicz := alpha*maxVal + (1-alpha)*minVal
+// evaluate обчислює значення всіх критеріїв для однієї альтернативи
+func (u *UncertainDecisionSystem) evaluate(alt string) Alternative {
+	data := u.outcomes[alt]
+	if len(data) == 0 {
+		return Alternative{}
+	}
 
-		alts[i] = Alternative{
-			name:    alt,
-			wald:    minVal,
-			maxmax:  maxVal,
-			hurwicz: hurwicz,
+	minVal, maxVal := data[0], data[0]
+	for _, v := range data {
+		if v < minVal {
+			minVal = v
+		}
+		if v > maxVal {
+			maxVal = v
 		}
 	}
-	return alts
+
+	hurwicz := u.alpha*maxVal + (1-u.alpha)*minVal
+
+	return Alternative{
+		name:    alt,
+		wald:    minVal,
+		maxmax:  maxVal,
+		hurwicz: hurwicz,
+	}
 }
 
 func (u *UncertainDecisionSystem) PrintRankings(criterionName string, alts []Alternative, valueFunc func(a Alternative) float64) {
-	sort.Sort(ByCriterion{alts: alts, value: valueFunc})
+	ranking := RankingTable(criterionName, alts, valueFunc)
+	top := ranking.Rows[0][2]
+
+	fmt.Printf(tr(promptCriterionResults), tr(criterionName))
+	RenderTable(os.Stdout, ranking, func(row, col int, cell string) string {
+		if row >= 0 && ranking.Rows[row][2] == top {
+			return highlight(cell)
+		}
+		return cell
+	})
 
-	fmt.Printf(promptCriterionResults, criterionName)
-	fmt.Printf(resultRankFormat, "Ранг", "Альтернатива", criterionName)
+	labels, values := rankingValues(alts, valueFunc)
+	fmt.Println()
+	PrintBarChart(os.Stdout, labels, values, "%.4f", func(i int, s string) string {
+		if values[i] == values[0] {
+			return highlight(s)
+		}
+		return s
+	})
+}
 
-	for i, alt := range alts {
-		fmt.Printf(resultItemFormat, i+1, alt.name, valueFunc(alt))
+// rankingValues повертає назви альтернатив і значення критерію в порядку ранжування
+func rankingValues(alts []Alternative, valueFunc func(a Alternative) float64) ([]string, []float64) {
+	sorted := append([]Alternative(nil), alts...)
+	sort.Stable(ByCriterion{alts: sorted, value: valueFunc})
+	labels := make([]string, len(sorted))
+	values := make([]float64, len(sorted))
+	for i, a := range sorted {
+		labels[i], values[i] = a.name, valueFunc(a)
 	}
+	return labels, values
+}
+
+// HurwiczSeries повертає значення критерію Гурвіца кожної альтернативи як функцію α;
+// оскільки H(α) = α·max + (1-α)·min лінійна, досить двох точок
+func HurwiczSeries(alts []Alternative) []Series {
+	series := make([]Series, len(alts))
+	for i, a := range alts {
+		series[i] = Series{Name: a.name, X: []float64{0, 1}, Y: []float64{a.wald, a.maxmax}}
+	}
+	return series
+}
+
+// CriteriaSeries повертає значення всіх критеріїв кожної альтернативи для радарної діаграми
+func CriteriaSeries(alts []Alternative) []Series {
+	series := make([]Series, len(alts))
+	for i, a := range alts {
+		series[i] = Series{Name: a.name, Y: []float64{a.wald, a.maxmax, a.hurwicz}}
+	}
+	return series
 }
 
 func (b ByCriterion) Len() int           { return len(b.alts) }
@@ -212,19 +482,166 @@ func (b ByCriterion) Swap(i, j int)      { b.alts[i], b.alts[j] = b.alts[j], b.a
 func (b ByCriterion) Less(i, j int) bool { return b.value(b.alts[i]) > b.value(b.alts[j]) }
 
 func main() {
+	reportPath := flag.String("report", "", "зберегти HTML-звіт у вказаний файл")
+	mdPath := flag.String("md", "", "зберегти звіт у форматі Markdown у вказаний файл")
+	texPath := flag.String("tex", "", "зберегти таблиці звіту у форматі LaTeX у вказаний файл")
+	pdfPath := flag.String("pdf", "", "зберегти звіт у форматі PDF у вказаний файл")
+	fontPath := flag.String("font", "", "TrueType-шрифт із кирилицею для PDF-звіту")
+	variant := flag.String("variant", "", "номер варіанту для титульної сторінки звіту")
+	exampleName := flag.String("example", "", "використати вбудовану задачу (list – перелік задач)")
+	xlsxPath := flag.String("xlsx", "", "зчитати матрицю корисності з книги Excel")
+	sheet := flag.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	xlsxOut := flag.String("xlsx-out", "", "записати результати на аркуш книги Excel")
+	tracePath := flag.String("trace", "", "зберегти хід обчислень у форматі JSON у вказаний файл")
+	gradePath := flag.String("grade", "", "перевірити відповіді студента (JSON у форматі -trace)")
+	tolerance := flag.Float64("tolerance", 0.01, "допустиме відхилення значень під час перевірки")
+	chartsDir := flag.String("charts", "", "зберегти SVG-діаграми у вказаний каталог")
+	noColor := flag.Bool("no-color", false, "вимкнути кольорове виділення у терміналі")
+	dominance := flag.Bool("dominance", false, "перед застосуванням критеріїв виключити строго доміновані альтернативи")
+	whatIf := flag.Bool("whatif", false, "після розрахунку змінювати окремі значення матриці й бачити зміни ранжувань")
+	sessionPath := flag.String("session", defaultSessionFile, "файл для автозбереження незавершеного введення (порожній рядок – вимкнути)")
+	repl := flag.Bool("repl", false, "після розрахунку приймати команди: перерахунок, додавання альтернатив, експорт")
+	tuiMode := flag.Bool("tui", false, "редагувати матрицю в повноекранному режимі з живими значеннями критеріїв")
+	paste := flag.Bool("paste", false, "вставити матрицю, скопійовану з Excel або Google Sheets (клітинки через табуляцію)")
+	langFlag := flag.String("lang", "", "мова інтерфейсу: uk або en (за замовчуванням визначається з LANG)")
+	flag.IntVar(&precision, "precision", -1, "кількість знаків після коми в усіх таблицях і звітах (за замовчуванням 2 для матриці та 4 для критеріїв)")
+	rounding := flag.String("rounding", "half-up", "спосіб округлення: half-up (половина вгору) або half-even (банківське)")
+	localeFlag := flag.String("locale", "", "формат чисел у виводі: uk – десяткова кома, en – крапка (за замовчуванням з LC_NUMERIC/LANG)")
+	flag.BoolVar(&explain, "explain", false, "виводити проміжні обчислення покроково")
+	summary := flag.Bool("summary", false, "вивести текстове обґрунтування рекомендацій критеріїв (воно також входить у звіти)")
+	flag.BoolVar(&tableBorders, "box", false, "виводити таблиці в рамках із псевдографіки")
+	stdinJSON := flag.Bool("stdin-json", false, "зчитати задачу в JSON (як поле inputs журналу -trace) зі стандартного входу й вивести журнал обчислень у JSON без підказок")
+	flag.Parse()
+	setupColor(*noColor)
+	setupLang(*langFlag)
+	setupLocale(*localeFlag)
+	if err := setupRounding(*rounding); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if *stdinJSON {
+		if err := runStdinJSON(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	ir := newInputReader()
-	u, err := newUncertainDecisionSystem(ir)
+	var u *UncertainDecisionSystem
+	var err error
+	switch {
+	case *exampleName == "list":
+		PrintExamples()
+		return
+	case *exampleName != "":
+		u, err = loadExample(*exampleName)
+	case *xlsxPath != "":
+		u, err = loadXLSX(*xlsxPath, *sheet)
+	case *paste:
+		u, err = ReadPaste(ir)
+	case *tuiMode:
+		u = newUncertainDecisionSystem()
+		fmt.Print(tr(promptBackHint))
+		err = u.ReadHeader(ir)
+	default:
+		if u = resumeSession(ir, *sessionPath); u == nil {
+			u = newUncertainDecisionSystem()
+		}
+		u.sessionPath = *sessionPath
+		fmt.Print(tr(promptBackHint))
+		err = u.ReadProblem(ir)
+	}
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
 
-	u.CollectOutcomes(ir)
-	u.PrintOutcomesMatrix()
+	if *tuiMode {
+		if *exampleName == "" {
+			u.alpha = 0.5
+		}
+		ok, err := u.EditMatrixTUI()
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if !ok {
+			fmt.Println(tr(errTUICancelled))
+			return
+		}
+	}
+	if !*paste {
+		// Вставлену матрицю вже показано для підтвердження
+		u.PrintOutcomesMatrix()
+	}
+	if *dominance {
+		u.RemoveDominated()
+	}
+
+	if *exampleName == "" && !*tuiMode {
+		if err := u.ReadAlpha(ir, *xlsxPath == "" && !*paste); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	alts := u.CalculateCriteria()
+
+	report, trace := u.Results(alts, *variant)
+	var charts []exportTarget
+	for _, c := range criteria {
+		u.PrintRankings(c.name, alts, c.value)
+
+		labels, values := rankingValues(alts, c.value)
+		title := fmt.Sprintf(tr("Ранжування за критерієм %s"), tr(c.name))
+		charts = append(charts, exportTarget{"ranking-" + c.file + ".svg", func(w io.Writer) error {
+			return WriteBarChartSVG(w, title, labels, values)
+		}})
+	}
+	if *summary {
+		printAnalysis(report)
+	}
+	charts = append(charts,
+		exportTarget{"hurwicz-alpha.svg", func(w io.Writer) error {
+			return WriteLineChartSVG(w, tr("Значення критерію Гурвіца залежно від α"), "α", "H(α)", HurwiczSeries(alts), u.alpha)
+		}},
+		exportTarget{"radar.svg", func(w io.Writer) error {
+			return WriteRadarChartSVG(w, tr("Альтернативи за критеріями"), []string{tr("Вальда"), "maxmax", tr("Гурвіца")}, CriteriaSeries(alts))
+		}},
+	)
+
+	if *gradePath != "" {
+		answer, err := loadTrace(*gradePath)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		PrintGrade(GradeTrace(trace, answer, *tolerance))
+	}
 
-	alts := u.CalculateCriteria(ir)
+	if *chartsDir != "" {
+		fmt.Println()
+		exportCharts(*chartsDir, charts)
+	}
 
-	u.PrintRankings("Вальда", alts, func(a Alternative) float64 { return a.wald })
-	u.PrintRankings("maxmax", alts, func(a Alternative) float64 { return a.maxmax })
-	u.PrintRankings("Гурвіца", alts, func(a Alternative) float64 { return a.hurwicz })
+	exportReport([]exportTarget{
+		{*reportPath, report.WriteHTML},
+		{*mdPath, report.WriteMarkdown},
+		{*texPath, report.WriteLaTeX},
+		{*pdfPath, func(w io.Writer) error { return report.WritePDF(w, *fontPath) }},
+		{*tracePath, trace.WriteJSON},
+	})
+
+	if *xlsxOut != "" {
+		if err := report.SaveXLSX(*xlsxOut); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf(tr("\nРезультати записано на аркуш '%s' книги %s\n"), xlsxResultsSheet, *xlsxOut)
+	}
+
+	if *whatIf || *repl {
+		u.RunREPL(ir, alts, *variant)
+	}
 }
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// readPasted зчитує блок клітинок, скопійований з Excel або Google Sheets: рядки
+// з клітинками, розділеними табуляцією, до порожнього рядка або кінця введення.
+// Порожні рядки перед блоком і порожні клітинки в кінці рядків пропускаються.
+func (ir *inputReader) readPasted() ([][]string, error) {
+	var rows [][]string
+	for {
+		line, err := ir.reader.ReadString('\n')
+		if strings.TrimSpace(line) != "" {
+			cells := strings.Split(strings.TrimRight(line, "\r\n"), "\t")
+			for j := range cells {
+				cells[j] = strings.TrimSpace(cells[j])
+			}
+			for len(cells) > 0 && cells[len(cells)-1] == "" {
+				cells = cells[:len(cells)-1]
+			}
+			rows = append(rows, cells)
+		} else if len(rows) > 0 {
+			return rows, nil
+		}
+		// Блок, переданий через конвеєр, може закінчуватися без порожнього рядка й символу нового рядка
+		if err != nil && len(rows) > 0 {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parsePasted перетворює вставлений блок на задачу так само, як loadXLSX: перший
+// стовпець – назви альтернатив, решта – значення за станами. Перший рядок
+// вважається заголовком, якщо серед його значень немає жодного числа; header
+// повідомляє, що заголовок пропущено. Система балів визначається з найбільшого значення.
+func parsePasted(rows [][]string) (u *UncertainDecisionSystem, header bool, err error) {
+	header = len(rows[0]) > 1
+	for _, cell := range rows[0][1:] {
+		if _, err := parseFloat(cell); err == nil {
+			header = false
+		}
+	}
+	first := 1
+	if header {
+		first = 2
+	}
+	if len(rows) < first || len(rows[first-1]) < 2 {
+		return nil, header, fmt.Errorf(tr(errPasteEmpty))
+	}
+
+	u = &UncertainDecisionSystem{
+		statesCount: len(rows[first-1]) - 1,
+		outcomes:    make(map[string][]float64),
+	}
+	if header {
+		rows = rows[1:]
+	}
+	maxVal := 0.0
+	for i, row := range rows {
+		alt := row[0]
+		if _, ok := u.outcomes[alt]; ok {
+			return nil, header, fmt.Errorf(tr(errPasteDuplicate), alt)
+		}
+		values := make([]float64, u.statesCount)
+		for j := range values {
+			cell := ""
+			if j+1 < len(row) {
+				cell = row[j+1]
+			}
+			v, err := parseFloat(cell)
+			if err != nil {
+				return nil, header, fmt.Errorf(tr(errPasteCell), i+first, j+2, cell)
+			}
+			values[j] = v
+			maxVal = math.Max(maxVal, v)
+		}
+		u.alternatives = append(u.alternatives, alt)
+		u.outcomes[alt] = values
+	}
+
+	if u.maxScore, err = scoreScale(maxVal); err != nil {
+		return nil, header, err
+	}
+	return u, header, nil
+}
+
+// ReadPaste запитує вставлений блок клітинок, показує, як його розпізнано, і
+// повторює запит, доки користувач не підтвердить матрицю. Кінець введення замість
+// відповіді вважається підтвердженням, щоб блок можна було передати через конвеєр.
+func ReadPaste(ir *inputReader) (*UncertainDecisionSystem, error) {
+	for {
+		fmt.Print(tr(promptPaste))
+		rows, err := ir.readPasted()
+		if err != nil {
+			return nil, err
+		}
+		u, header, err := parsePasted(rows)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		u.PrintOutcomesMatrix()
+		if header {
+			fmt.Println(tr("Перший рядок розпізнано як заголовок станів і пропущено"))
+		}
+		fmt.Printf(tr("Розпізнано альтернатив: %d, станів: %d, система балів: до %d\n"), len(u.alternatives), u.statesCount, u.maxScore)
+		answer, err := ir.readString(tr(promptPasteConfirm))
+		switch {
+		case err == io.EOF:
+			fmt.Println()
+			return u, nil
+		case err != nil:
+			return nil, err
+		}
+		switch strings.ToLower(answer) {
+		case "", "т", "так", "y", "yes":
+			return u, nil
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	pdfFontFamily = "report"
+	pdfMargin     = 20.0
+	pdfRowHeight  = 7.0
+)
+
+// pdfFontCandidates – типові розташування шрифтів із кирилицею, якщо шрифт не вказано явно
+var pdfFontCandidates = []string{
+	"/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf",
+	"/usr/share/fonts/TTF/DejaVuSans.ttf",
+	"/usr/share/fonts/dejavu/DejaVuSans.ttf",
+	"/Library/Fonts/Arial Unicode.ttf",
+	"/System/Library/Fonts/Supplemental/Arial.ttf",
+	`C:\Windows\Fonts\arial.ttf`,
+}
+
+func findPDFFont(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	for _, candidate := range pdfFontCandidates {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", errors.New(tr(errNoFont))
+}
+
+// WritePDF записує звіт у PDF: титульна сторінка з номером варіанту,
+// таблиці вхідних даних і розрахунків та висновки.
+// Для кирилиці потрібен TrueType-шрифт (fontPath або один із системних).
+func (r *Report) WritePDF(w io.Writer, fontPath string) error {
+	fontFile, err := findPDFFont(fontPath)
+	if err != nil {
+		return err
+	}
+	font, err := os.ReadFile(fontFile)
+	if err != nil {
+		return err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "", font)
+	if err := pdf.Error(); err != nil {
+		return err
+	}
+
+	r.pdfTitlePage(pdf)
+
+	pdf.AddPage()
+	for i, t := range r.Tables {
+		pdf.SetFont(pdfFontFamily, "", 13)
+		pdf.MultiCell(0, 8, fmt.Sprintf("%d. %s", i+1, t.Title), "", "L", false)
+		pdf.Ln(1)
+		pdfTable(pdf, t)
+		pdf.Ln(6)
+	}
+
+	if len(r.Analysis) > 0 {
+		pdf.SetFont(pdfFontFamily, "", 13)
+		pdf.MultiCell(0, 8, r.AnalysisTitle(), "", "L", false)
+		pdf.SetFont(pdfFontFamily, "", 11)
+		for _, p := range r.Analysis {
+			pdf.MultiCell(0, 6, p, "", "L", false)
+			pdf.Ln(2)
+		}
+		pdf.Ln(4)
+	}
+
+	if len(r.Conclusions) > 0 {
+		pdf.SetFont(pdfFontFamily, "", 13)
+		pdf.MultiCell(0, 8, tr("Висновки"), "", "L", false)
+		pdf.SetFont(pdfFontFamily, "", 11)
+		for _, c := range r.Conclusions {
+			pdf.MultiCell(0, 6, "• "+c, "", "L", false)
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+func (r *Report) pdfTitlePage(pdf *gofpdf.Fpdf) {
+	pdf.AddPage()
+	pdf.SetY(80)
+	pdf.SetFont(pdfFontFamily, "", 14)
+	pdf.MultiCell(0, 8, tr("Теорія прийняття рішень"), "", "C", false)
+	pdf.Ln(6)
+	pdf.SetFont(pdfFontFamily, "", 18)
+	pdf.MultiCell(0, 10, r.Title, "", "C", false)
+	pdf.Ln(10)
+
+	pdf.SetFont(pdfFontFamily, "", 14)
+	if r.Variant != "" {
+		pdf.MultiCell(0, 8, tr("Варіант ")+r.Variant, "", "C", false)
+	}
+	pdf.MultiCell(0, 8, time.Now().Format("02.01.2006"), "", "C", false)
+}
+
+// pdfTable малює таблицю; ширини стовпців пропорційні найдовшому тексту
+// і за потреби стискаються до ширини сторінки
+func pdfTable(pdf *gofpdf.Fpdf, t Table) {
+	pdf.SetFont(pdfFontFamily, "", 10)
+	pageWidth, _ := pdf.GetPageSize()
+	available := pageWidth - 2*pdfMargin
+
+	widths := make([]float64, len(t.Header))
+	total := 0.0
+	for j, h := range t.Header {
+		widths[j] = pdf.GetStringWidth(h)
+		for _, row := range t.Rows {
+			if j < len(row) {
+				widths[j] = max(widths[j], pdf.GetStringWidth(row[j]))
+			}
+		}
+		widths[j] += 4
+		total += widths[j]
+	}
+	if total > available {
+		for j := range widths {
+			widths[j] *= available / total
+		}
+	}
+
+	pdf.SetFillColor(232, 234, 242)
+	for j, h := range t.Header {
+		pdf.CellFormat(widths[j], pdfRowHeight, h, "1", 0, "C", true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	for _, row := range t.Rows {
+		for j := range t.Header {
+			cell, align := "", "L"
+			if j < len(row) {
+				cell = row[j]
+			}
+			if t.numericColumn(j) {
+				align = "R"
+			}
+			pdf.CellFormat(widths[j], pdfRowHeight, cell, "1", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// runStdinJSON – режим -stdin-json: задача (як поле inputs журналу -trace)
+// надходить зі стандартного входу, а журнал обчислень з ранжуваннями за
+// критеріями Вальда, maxmax і Гурвіца записується у w у форматі JSON, тож
+// програму можна викликати з інших скриптів і конвеєрів. Якщо alpha не
+// задано, використовується 0.5.
+func runStdinJSON(r io.Reader, w io.Writer) error {
+	in := traceInputs{Alpha: 0.5}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&in); err != nil {
+		return fmt.Errorf(tr(errStdinJSON), err)
+	}
+	u, err := in.system()
+	if err != nil {
+		return err
+	}
+	_, trace := u.Results(u.CalculateCriteria(), "")
+	return trace.WriteJSON(w)
+}
+
+// system перевіряє вхідні дані й будує задачу; states і max_score можна не
+// вказувати – вони визначаються з матриці так само, як для книги Excel
+func (in traceInputs) system() (*UncertainDecisionSystem, error) {
+	if len(in.Alternatives) == 0 || len(in.Outcomes) != len(in.Alternatives) {
+		return nil, fmt.Errorf(tr(errStdinShape), len(in.Alternatives), len(in.Outcomes))
+	}
+	if in.Alpha < 0 || in.Alpha > 1 {
+		return nil, fmt.Errorf(tr(errStdinAlpha), in.Alpha)
+	}
+	if in.States == 0 {
+		in.States = len(in.Outcomes[0])
+	}
+
+	u := &UncertainDecisionSystem{
+		alternatives: in.Alternatives,
+		statesCount:  in.States,
+		maxScore:     in.MaxScore,
+		alpha:        in.Alpha,
+		outcomes:     make(map[string][]float64),
+	}
+	maxVal := 0.0
+	for i, alt := range in.Alternatives {
+		if _, ok := u.outcomes[alt]; ok {
+			return nil, fmt.Errorf(tr(errStdinDuplicate), alt)
+		}
+		if len(in.Outcomes[i]) != in.States || in.States == 0 {
+			return nil, fmt.Errorf(tr(errStdinRow), alt, len(in.Outcomes[i]), in.States)
+		}
+		u.outcomes[alt] = in.Outcomes[i]
+		for _, v := range in.Outcomes[i] {
+			maxVal = math.Max(maxVal, v)
+		}
+	}
+	if u.maxScore == 0 {
+		u.maxScore = int(math.Ceil(maxVal))
+	}
+	return u, nil
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	promptREPL = "\nКоманда (help – довідка, quit – вихід): "
+	replHelp   = `Команди:
+  set <альтернатива> <стан> <значення>   змінити одне значення матриці, наприклад: set A2 state3 7.5
+  rerun [критерій] [α]                   перерахувати й вивести ранжування (wald, maxmax, hurwicz),
+                                         наприклад: rerun hurwicz 0.7
+  add alt "<назва>"                      додати альтернативу та ввести її значення корисності
+  export <формат> <файл>                 зберегти результати: json, md, html, tex або xlsx
+  help                                   ця довідка
+  quit                                   завершити роботу`
+
+	errREPLCommand   = "Невідома команда '%s', введіть help для довідки"
+	errREPLQuote     = "Незакриті лапки в команді"
+	errREPLCriterion = "Невідомий критерій '%s', доступні: %s"
+	errREPLAlpha     = "Некоректне α '%s': потрібне число від 0 до 1"
+	errREPLAddSyntax = "Використання: add alt \"<назва>\""
+	errREPLAltExists = "Альтернатива '%s' вже є в задачі"
+	errREPLExport    = "Використання: export <формат> <файл>"
+	errREPLFormat    = "Невідомий формат '%s', доступні: json, md, html, tex, xlsx"
+)
+
+// splitCommand розбиває команду на слова; слова в подвійних лапках
+// (наприклад, назви альтернатив із пробілами) залишаються цілими
+func splitCommand(line string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		quoted  bool
+		started bool
+	)
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			started = true
+		case unicode.IsSpace(r) && !quoted:
+			if started {
+				words = append(words, word.String())
+				word.Reset()
+				started = false
+			}
+		default:
+			word.WriteRune(r)
+			started = true
+		}
+	}
+	if quoted {
+		return nil, errors.New(tr(errREPLQuote))
+	}
+	if started {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// findCriterion шукає критерій за ідентифікатором (wald) або назвою (Вальда)
+func findCriterion(name string) (criterion, bool) {
+	for _, c := range criteria {
+		if strings.EqualFold(name, c.file) || strings.EqualFold(name, c.name) || strings.EqualFold(name, tr(c.name)) {
+			return c, true
+		}
+	}
+	return criterion{}, false
+}
+
+// Rerun перераховує критерії з новим α (якщо задано) і виводить ранжування
+// за вказаним критерієм або за всіма, якщо критерій не задано
+func (u *UncertainDecisionSystem) Rerun(args []string) ([]Alternative, error) {
+	selected := criteria
+	alpha := u.alpha
+	for _, arg := range args {
+		if c, ok := findCriterion(arg); ok {
+			selected = []criterion{c}
+			continue
+		}
+		v, err := parseFloat(arg)
+		if err != nil {
+			ids := make([]string, len(criteria))
+			for i, c := range criteria {
+				ids[i] = c.file
+			}
+			return nil, fmt.Errorf(tr(errREPLCriterion), arg, strings.Join(ids, ", "))
+		}
+		if v < 0 || v > 1 {
+			return nil, fmt.Errorf(tr(errREPLAlpha), arg)
+		}
+		alpha = v
+	}
+
+	u.alpha = alpha
+	alts := u.CalculateCriteria()
+	for _, c := range selected {
+		u.PrintRankings(c.name, alts, c.value)
+	}
+	return alts, nil
+}
+
+// AddAlternative запитує значення корисності нової альтернативи для всіх станів
+// і додає її до задачі; '<' повертає до попереднього стану
+func (u *UncertainDecisionSystem) AddAlternative(ir *inputReader, args []string) error {
+	if len(args) != 2 || args[0] != "alt" || args[1] == "" {
+		return errors.New(tr(errREPLAddSyntax))
+	}
+	name := args[1]
+	if slices.Contains(u.alternatives, name) {
+		return fmt.Errorf(tr(errREPLAltExists), name)
+	}
+
+	fmt.Printf(tr(promptAltValue), name)
+	values := make([]float64, 0, u.statesCount)
+	for len(values) < u.statesCount {
+		prompt := fmt.Sprintf(tr(promptStateValue), name, len(values)+1, u.maxScore)
+		v, err := ir.readValidatedFloat(prompt, 1, float64(u.maxScore))
+		switch {
+		case err == errBack:
+			values = values[:max(len(values)-1, 0)]
+		case err != nil:
+			return err
+		default:
+			values = append(values, v)
+		}
+	}
+
+	u.alternatives = append(u.alternatives, name)
+	u.outcomes[name] = values
+	return nil
+}
+
+// Export зберігає поточні результати у файл вказаного формату
+func (u *UncertainDecisionSystem) Export(alts []Alternative, variant string, args []string) error {
+	if len(args) != 2 {
+		return errors.New(tr(errREPLExport))
+	}
+	format, path := strings.ToLower(args[0]), args[1]
+	report, trace := u.Results(alts, variant)
+
+	var write func(w io.Writer) error
+	switch format {
+	case "json":
+		write = trace.WriteJSON
+	case "md":
+		write = report.WriteMarkdown
+	case "html":
+		write = report.WriteHTML
+	case "tex":
+		write = report.WriteLaTeX
+	case "xlsx":
+		if err := report.SaveXLSX(path); err != nil {
+			return err
+		}
+		fmt.Printf(tr("Результати записано на аркуш '%s' книги %s\n"), xlsxResultsSheet, path)
+		return nil
+	default:
+		return fmt.Errorf(tr(errREPLFormat), format)
+	}
+	if err := saveFile(path, write); err != nil {
+		return err
+	}
+	fmt.Printf(tr("Звіт збережено у файл %s\n"), path)
+	return nil
+}
+
+// RunREPL після основного розрахунку приймає команди, щоб виконати кілька
+// аналізів на тих самих даних без повторного введення задачі
+func (u *UncertainDecisionSystem) RunREPL(ir *inputReader, alts []Alternative, variant string) {
+	for {
+		line, err := ir.readString(tr(promptREPL))
+		if err != nil {
+			return
+		}
+		words, err := splitCommand(line)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		if len(words) == 0 {
+			continue
+		}
+
+		switch cmd, args := words[0], words[1:]; cmd {
+		case "set":
+			err = u.SetOutcome(alts, args)
+		case "rerun":
+			var updated []Alternative
+			if updated, err = u.Rerun(args); err == nil {
+				alts = updated
+			}
+		case "add":
+			before := rankingOrders(alts)
+			if err = u.AddAlternative(ir, args); err == nil {
+				alts = u.CalculateCriteria()
+				fmt.Printf(tr("Альтернативу '%s' додано\n"), args[1])
+				printRankingChanges(before, alts)
+			}
+		case "export":
+			err = u.Export(alts, variant, args)
+		case "help":
+			fmt.Println(tr(replHelp))
+		case "quit", "exit":
+			return
+		default:
+			err = fmt.Errorf(tr(errREPLCommand), cmd)
+		}
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+}
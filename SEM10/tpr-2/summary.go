@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Шаблони обґрунтування рекомендацій; значення підставляються з обчислених критеріїв
+const (
+	summaryWald    = "За критерієм Вальда оптимальною є альтернатива %s, оскільки її гарантований виграш – найменше значення за всіма станами – становить %.2f і є найбільшим серед альтернатив. Це вибір обережної особи, що готується до найгіршого стану."
+	summaryMaxmax  = "За критерієм maxmax оптимальною є альтернатива %s: за найсприятливішого стану вона дає найбільший виграш %.2f. Такий вибір робить оптиміст, який не зважає на ризик."
+	summaryHurwicz = "За критерієм Гурвіца з коефіцієнтом оптимізму α = %.2f оптимальною є альтернатива %s: її зважена оцінка α·max + (1 − α)·min дорівнює %.2f і є найбільшою."
+	summaryTie     = "За критерієм %s рівноцінні альтернативи %s зі значенням %.2f."
+	summaryRunner  = " Найближча альтернатива %s поступається на %.2f."
+	summaryAgree   = "Усі критерії рекомендують альтернативу %s, тож вибір не залежить від ставлення особи, що приймає рішення, до ризику."
+	summaryDiffer  = "Критерії дають різні рекомендації (%s), тому остаточний вибір залежить від ставлення до ризику: песиміст обирає за критерієм Вальда, оптиміст – за maxmax, а критерій Гурвіца задає проміжне ставлення через α."
+)
+
+// printAnalysis виводить розділ аналізу результатів звіту в термінал
+func printAnalysis(r *Report) {
+	fmt.Printf("\n%s:\n", r.AnalysisTitle())
+	for _, p := range r.Analysis {
+		fmt.Println(p)
+	}
+}
+
+// Summary будує текстове обґрунтування рекомендації кожного критерію та
+// висновок щодо їх узгодженості – заготовку розділу аналізу результатів у звіті
+func (u *UncertainDecisionSystem) Summary(alts []Alternative) []string {
+	var out, picks []string
+	bests := make(map[string]bool)
+	for _, c := range criteria {
+		sorted := make([]Alternative, len(alts))
+		copy(sorted, alts)
+		sort.SliceStable(sorted, func(i, j int) bool { return c.value(sorted[i]) > c.value(sorted[j]) })
+
+		top := c.value(sorted[0])
+		var best []string
+		for _, a := range sorted {
+			if c.value(a) == top {
+				best = append(best, a.name)
+			}
+		}
+		name := strings.Join(best, ", ")
+		bests[name] = true
+		picks = append(picks, tr(c.name)+" – "+name)
+
+		var s string
+		switch {
+		case len(best) > 1:
+			s = fmt.Sprintf(tr(summaryTie), tr(c.name), name, num(top))
+		case c.file == "hurwicz":
+			s = fmt.Sprintf(tr(summaryHurwicz), num(u.alpha), name, num(top))
+		case c.file == "wald":
+			s = fmt.Sprintf(tr(summaryWald), name, num(top))
+		default:
+			s = fmt.Sprintf(tr(summaryMaxmax), name, num(top))
+		}
+		if len(best) < len(sorted) {
+			runner := sorted[len(best)]
+			s += fmt.Sprintf(tr(summaryRunner), runner.name, num(top-c.value(runner)))
+		}
+		out = append(out, s)
+	}
+
+	if len(bests) == 1 {
+		for name := range bests {
+			out = append(out, fmt.Sprintf(tr(summaryAgree), name))
+		}
+	} else {
+		out = append(out, fmt.Sprintf(tr(summaryDiffer), strings.Join(picks, "; ")))
+	}
+	return out
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	svgWidth       = 760
+	svgHeight      = 480
+	svgMargin      = 60
+	svgLegendWidth = 170
+	svgTicks       = 5
+)
+
+// svgPalette – кольори серій (палітра Tableau 10)
+var svgPalette = []string{
+	"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f",
+	"#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac",
+}
+
+// Series – іменована послідовність точок для лінійної або радарної діаграми;
+// для радарної діаграми використовуються лише значення Y
+type Series struct {
+	Name string
+	X, Y []float64
+}
+
+func svgColor(i int) string {
+	return svgPalette[i%len(svgPalette)]
+}
+
+// svgText екранує текст для вставки в SVG
+func svgText(s string) string {
+	return html.EscapeString(s)
+}
+
+func svgDocument(w io.Writer, title string, body func(b *strings.Builder)) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Arial, sans-serif" font-size="12">`+"\n",
+		svgWidth, svgHeight, svgWidth, svgHeight)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="#fff"/>`+"\n")
+	fmt.Fprintf(&b, `<text x="%d" y="30" text-anchor="middle" font-size="16">%s</text>`+"\n", svgWidth/2, svgText(title))
+	body(&b)
+	b.WriteString("</svg>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// svgLegend виводить підписи серій праворуч від області побудови
+func svgLegend(b *strings.Builder, names []string) {
+	x := svgWidth - svgLegendWidth + 20
+	for i, name := range names {
+		y := svgMargin + i*20
+		fmt.Fprintf(b, `<rect x="%d" y="%d" width="12" height="12" fill="%s"/>`+"\n", x, y, svgColor(i))
+		fmt.Fprintf(b, `<text x="%d" y="%d">%s</text>`+"\n", x+18, y+11, svgText(name))
+	}
+}
+
+// WriteBarChartSVG записує горизонтальну стовпчасту діаграму значень у порядку labels;
+// перший стовпець (переможець ранжування) виділяється кольором
+func WriteBarChartSVG(w io.Writer, title string, labels []string, values []float64) error {
+	return svgDocument(w, title, func(b *strings.Builder) {
+		if len(values) == 0 {
+			return
+		}
+		lo, hi := 0.0, values[0]
+		for _, v := range values {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+		if hi == lo {
+			hi = lo + 1
+		}
+
+		left, right := 2*svgMargin+40, svgWidth-svgMargin-40
+		rowHeight := float64(svgHeight-2*svgMargin) / float64(len(values))
+		x := func(v float64) float64 {
+			return float64(left) + (v-lo)/(hi-lo)*float64(right-left)
+		}
+
+		for i, v := range values {
+			y := float64(svgMargin) + float64(i)*rowHeight
+			color := svgColor(0)
+			if v == values[0] {
+				color = svgColor(4)
+			}
+			x0, x1 := math.Min(x(0), x(v)), math.Max(x(0), x(v))
+			fmt.Fprintf(b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`+"\n",
+				x0, y+rowHeight*0.15, x1-x0, rowHeight*0.7, color)
+			fmt.Fprintf(b, `<text x="%d" y="%.1f" text-anchor="end" dominant-baseline="middle">%s</text>`+"\n",
+				left-8, y+rowHeight/2, svgText(labels[i]))
+			fmt.Fprintf(b, `<text x="%.1f" y="%.1f" dominant-baseline="middle">%.4f</text>`+"\n",
+				x1+6, y+rowHeight/2, num(v))
+		}
+		fmt.Fprintf(b, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#333"/>`+"\n",
+			x(0), svgMargin, x(0), svgHeight-svgMargin)
+	})
+}
+
+// WriteLineChartSVG записує лінійну діаграму серій; якщо marker не NaN,
+// на осі X проводиться вертикальна пунктирна лінія (наприклад, обране значення параметра)
+func WriteLineChartSVG(w io.Writer, title, xLabel, yLabel string, series []Series, marker float64) error {
+	return svgDocument(w, title, func(b *strings.Builder) {
+		xMin, xMax := math.Inf(1), math.Inf(-1)
+		yMin, yMax := math.Inf(1), math.Inf(-1)
+		for _, s := range series {
+			for i := range s.X {
+				xMin, xMax = math.Min(xMin, s.X[i]), math.Max(xMax, s.X[i])
+				yMin, yMax = math.Min(yMin, s.Y[i]), math.Max(yMax, s.Y[i])
+			}
+		}
+		if math.IsInf(xMin, 0) {
+			return
+		}
+		if xMax == xMin {
+			xMax = xMin + 1
+		}
+		if yMax == yMin {
+			yMin, yMax = yMin-1, yMax+1
+		}
+
+		left, right := svgMargin, svgWidth-svgLegendWidth
+		top, bottom := svgMargin, svgHeight-svgMargin
+		px := func(v float64) float64 { return float64(left) + (v-xMin)/(xMax-xMin)*float64(right-left) }
+		py := func(v float64) float64 { return float64(bottom) - (v-yMin)/(yMax-yMin)*float64(bottom-top) }
+
+		// Сітка та підписи осей
+		for i := range svgTicks + 1 {
+			t := float64(i) / svgTicks
+			xv, yv := xMin+t*(xMax-xMin), yMin+t*(yMax-yMin)
+			fmt.Fprintf(b, `<line x1="%d" y1="%.1f" x2="%d" y2="%.1f" stroke="#ddd"/>`+"\n", left, py(yv), right, py(yv))
+			fmt.Fprintf(b, `<text x="%d" y="%.1f" text-anchor="end" dominant-baseline="middle">%.2f</text>`+"\n", left-6, py(yv), num(yv))
+			fmt.Fprintf(b, `<text x="%.1f" y="%d" text-anchor="middle">%.2f</text>`+"\n", px(xv), bottom+18, num(xv))
+		}
+		fmt.Fprintf(b, `<rect x="%d" y="%d" width="%d" height="%d" fill="none" stroke="#333"/>`+"\n", left, top, right-left, bottom-top)
+		fmt.Fprintf(b, `<text x="%d" y="%d" text-anchor="middle">%s</text>`+"\n", (left+right)/2, bottom+40, svgText(xLabel))
+		fmt.Fprintf(b, `<text x="18" y="%d" text-anchor="middle" transform="rotate(-90 18 %d)">%s</text>`+"\n",
+			(top+bottom)/2, (top+bottom)/2, svgText(yLabel))
+
+		if !math.IsNaN(marker) {
+			fmt.Fprintf(b, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#333" stroke-dasharray="4 4"/>`+"\n",
+				px(marker), top, px(marker), bottom)
+		}
+
+		names := make([]string, len(series))
+		for i, s := range series {
+			names[i] = s.Name
+			points := make([]string, len(s.X))
+			for j := range s.X {
+				points[j] = fmt.Sprintf("%.1f,%.1f", px(s.X[j]), py(s.Y[j]))
+			}
+			fmt.Fprintf(b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n",
+				strings.Join(points, " "), svgColor(i))
+		}
+		svgLegend(b, names)
+	})
+}
+
+// WriteRadarChartSVG записує радарну діаграму альтернатив за осями axes.
+// Значення кожної осі нормуються на максимум по всіх серіях, тому осі
+// з різними шкалами можна порівнювати; значення мають бути невід'ємними.
+func WriteRadarChartSVG(w io.Writer, title string, axes []string, series []Series) error {
+	return svgDocument(w, title, func(b *strings.Builder) {
+		n := len(axes)
+		if n < 3 {
+			return
+		}
+		axisMax := make([]float64, n)
+		for _, s := range series {
+			for j, v := range s.Y {
+				axisMax[j] = math.Max(axisMax[j], v)
+			}
+		}
+
+		cx, cy := float64(svgWidth-svgLegendWidth)/2, float64(svgHeight)/2+10
+		radius := float64(svgHeight)/2 - svgMargin
+		point := func(j int, r float64) (float64, float64) {
+			angle := 2*math.Pi*float64(j)/float64(n) - math.Pi/2
+			return cx + r*math.Cos(angle), cy + r*math.Sin(angle)
+		}
+
+		for level := 1; level <= svgTicks; level++ {
+			points := make([]string, n)
+			for j := range n {
+				x, y := point(j, radius*float64(level)/svgTicks)
+				points[j] = fmt.Sprintf("%.1f,%.1f", x, y)
+			}
+			fmt.Fprintf(b, `<polygon points="%s" fill="none" stroke="#ddd"/>`+"\n", strings.Join(points, " "))
+		}
+		for j, axis := range axes {
+			x, y := point(j, radius)
+			fmt.Fprintf(b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#bbb"/>`+"\n", cx, cy, x, y)
+			lx, ly := point(j, radius+18)
+			fmt.Fprintf(b, `<text x="%.1f" y="%.1f" text-anchor="middle" dominant-baseline="middle">%s</text>`+"\n",
+				lx, ly, svgText(axis))
+		}
+
+		names := make([]string, len(series))
+		for i, s := range series {
+			names[i] = s.Name
+			points := make([]string, n)
+			for j := range n {
+				r := 0.0
+				if j < len(s.Y) && axisMax[j] > 0 {
+					r = radius * s.Y[j] / axisMax[j]
+				}
+				x, y := point(j, r)
+				points[j] = fmt.Sprintf("%.1f,%.1f", x, y)
+			}
+			fmt.Fprintf(b, `<polygon points="%s" fill="%s" fill-opacity="0.15" stroke="%s" stroke-width="2"/>`+"\n",
+				strings.Join(points, " "), svgColor(i), svgColor(i))
+		}
+		svgLegend(b, names)
+	})
+}
+
+// exportCharts записує діаграми у каталог dir, створюючи його за потреби
+func exportCharts(dir string, charts []exportTarget) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, c := range charts {
+		path := filepath.Join(dir, c.path)
+		if err := saveFile(path, c.write); err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf(tr("Діаграму збережено у файл %s\n"), path)
+	}
+}
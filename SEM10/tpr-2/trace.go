@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Структури журналу обчислень для експорту в JSON: вхідні дані, кроки
+// з формулами та операндами кожної підстановки і підсумкові ранжування
+type (
+	Trace struct {
+		Task     string         `json:"task"`
+		Inputs   traceInputs    `json:"inputs"`
+		Steps    []traceStep    `json:"steps"`
+		Rankings []traceRanking `json:"rankings"`
+	}
+
+	traceInputs struct {
+		Alternatives []string    `json:"alternatives"`
+		States       int         `json:"states"`
+		MaxScore     int         `json:"max_score"`
+		Alpha        float64     `json:"alpha"`
+		Outcomes     [][]float64 `json:"outcomes"`
+	}
+
+	traceStep struct {
+		Name    string       `json:"name"`
+		Formula string       `json:"formula"`
+		Entries []traceEntry `json:"entries"`
+	}
+
+	traceEntry struct {
+		Alternative string    `json:"alternative"`
+		Expression  string    `json:"expression"`
+		Operands    []float64 `json:"operands"`
+		Result      float64   `json:"result"`
+	}
+
+	traceRanking struct {
+		Criterion string    `json:"criterion"`
+		Order     []string  `json:"order"`
+		Values    []float64 `json:"values"`
+		Best      []string  `json:"best"`
+	}
+)
+
+// key ідентифікує підстановку в межах кроку для порівняння відповідей
+func (e traceEntry) key() string {
+	return e.Alternative
+}
+
+// Trace збирає повний хід розрахунку критеріїв Вальда, maxmax і Гурвіца
+func (u *UncertainDecisionSystem) Trace(alts []Alternative) *Trace {
+	t := &Trace{
+		Task: reportTitle,
+		Inputs: traceInputs{
+			Alternatives: u.alternatives,
+			States:       u.statesCount,
+			MaxScore:     u.maxScore,
+			Alpha:        u.alpha,
+		},
+	}
+	for _, alt := range u.alternatives {
+		t.Inputs.Outcomes = append(t.Inputs.Outcomes, u.outcomes[alt])
+	}
+
+	wald := traceStep{Name: "wald", Formula: "W(a) = min_j u(a, j)"}
+	maxmax := traceStep{Name: "maxmax", Formula: "M(a) = max_j u(a, j)"}
+	hurwicz := traceStep{Name: "hurwicz", Formula: "H(a) = α·M(a) + (1 − α)·W(a)"}
+	for _, a := range alts {
+		outcomes := u.outcomes[a.name]
+		wald.Entries = append(wald.Entries, traceEntry{
+			Alternative: a.name,
+			Expression:  fmt.Sprintf("min(%s)", joinValues(outcomes)),
+			Operands:    outcomes,
+			Result:      a.wald,
+		})
+		maxmax.Entries = append(maxmax.Entries, traceEntry{
+			Alternative: a.name,
+			Expression:  fmt.Sprintf("max(%s)", joinValues(outcomes)),
+			Operands:    outcomes,
+			Result:      a.maxmax,
+		})
+		hurwicz.Entries = append(hurwicz.Entries, traceEntry{
+			Alternative: a.name,
+			Expression:  fmt.Sprintf("%g·%g + %g·%g", u.alpha, a.maxmax, 1-u.alpha, a.wald),
+			Operands:    []float64{u.alpha, a.maxmax, a.wald},
+			Result:      a.hurwicz,
+		})
+	}
+	t.Steps = []traceStep{wald, maxmax, hurwicz}
+	return t
+}
+
+// AddRanking додає до журналу ранжування за критерієм; найкращими вважаються
+// всі альтернативи зі значенням, рівним першому
+func (t *Trace) AddRanking(criterion string, labels []string, values []float64) {
+	r := traceRanking{Criterion: criterion, Order: labels, Values: values}
+	for i, v := range values {
+		if v == values[0] {
+			r.Best = append(r.Best, labels[i])
+		}
+	}
+	t.Rankings = append(t.Rankings, r)
+}
+
+func (t *Trace) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
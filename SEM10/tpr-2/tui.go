@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	ansiCursor  = "\033[7m"    // інверсія – поточна клітинка
+	ansiInvalid = "\033[1;31m" // жирний червоний – некоректне значення
+
+	tuiAlphaStep = 0.05
+	tuiCellWidth = 8
+
+	tuiHelp          = "←↑↓→/Tab – переміщення, Backspace – стерти, [ ] – змінити α, Enter – розрахувати, Esc – вийти"
+	tuiIncomplete    = "Заповніть рядок, щоб побачити значення критеріїв"
+	errTUICell       = "Клітинка (%s, стан %d): потрібне число від 1 до %d"
+	errTUICancelled  = "Редагування скасовано"
+	tuiSelectedValue = "Альтернатива '%s', стан %d: %s"
+)
+
+// matrixEditor – повноекранний редактор матриці корисності: клітинки
+// редагуються як текст, а значення критеріїв перераховуються після кожної зміни
+type matrixEditor struct {
+	u         *UncertainDecisionSystem
+	cells     [][]string
+	row, col  int
+	status    string
+	done      bool
+	cancelled bool
+}
+
+func newMatrixEditor(u *UncertainDecisionSystem) *matrixEditor {
+	m := &matrixEditor{u: u, cells: make([][]string, len(u.alternatives))}
+	for i, alt := range u.alternatives {
+		m.cells[i] = make([]string, u.statesCount)
+		for j, v := range u.outcomes[alt] {
+			m.cells[i][j] = strings.Replace(strconv.FormatFloat(v, 'f', -1, 64), ".", decimalSep, 1)
+		}
+	}
+	return m
+}
+
+// value повертає значення клітинки, якщо воно коректне; кома також
+// приймається як десятковий роздільник
+func (m *matrixEditor) value(i, j int) (float64, bool) {
+	v, err := parseFloat(m.cells[i][j])
+	return v, err == nil && v >= 1 && v <= float64(m.u.maxScore)
+}
+
+// rowValues повертає значення рядка i, якщо всі його клітинки коректні
+func (m *matrixEditor) rowValues(i int) ([]float64, bool) {
+	values := make([]float64, len(m.cells[i]))
+	for j := range m.cells[i] {
+		v, ok := m.value(i, j)
+		if !ok {
+			return nil, false
+		}
+		values[j] = v
+	}
+	return values, true
+}
+
+// firstInvalid повертає координати першої некоректної клітинки
+func (m *matrixEditor) firstInvalid() (int, int, bool) {
+	for i := range m.cells {
+		for j := range m.cells[i] {
+			if _, ok := m.value(i, j); !ok {
+				return i, j, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+func (m *matrixEditor) Init() tea.Cmd {
+	return nil
+}
+
+func (m *matrixEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	rows, cols := len(m.cells), m.u.statesCount
+	m.status = ""
+
+	switch key.String() {
+	case "esc", "ctrl+c":
+		m.cancelled = true
+		return m, tea.Quit
+	case "enter":
+		if i, j, ok := m.firstInvalid(); ok {
+			m.row, m.col = i, j
+			m.status = fmt.Sprintf(tr(errTUICell), m.u.alternatives[i], j+1, m.u.maxScore)
+			return m, nil
+		}
+		for i, alt := range m.u.alternatives {
+			m.u.outcomes[alt], _ = m.rowValues(i)
+		}
+		m.done = true
+		return m, tea.Quit
+	case "up":
+		m.row = (m.row + rows - 1) % rows
+	case "down":
+		m.row = (m.row + 1) % rows
+	case "left":
+		m.col = (m.col + cols - 1) % cols
+	case "right":
+		m.col = (m.col + 1) % cols
+	case "tab", "shift+tab":
+		// Tab переходить до наступної клітинки по рядках, як під час звичайного введення
+		step := 1
+		if key.String() == "shift+tab" {
+			step = rows*cols - 1
+		}
+		k := (m.row*cols + m.col + step) % (rows * cols)
+		m.row, m.col = k/cols, k%cols
+	case "backspace":
+		cell := []rune(m.cells[m.row][m.col])
+		if len(cell) > 0 {
+			m.cells[m.row][m.col] = string(cell[:len(cell)-1])
+		}
+	case "delete":
+		m.cells[m.row][m.col] = ""
+	case "[":
+		m.u.alpha = max(0, m.u.alpha-tuiAlphaStep)
+	case "]":
+		m.u.alpha = min(1, m.u.alpha+tuiAlphaStep)
+	default:
+		if key.Type == tea.KeyRunes {
+			for _, r := range key.Runes {
+				if strings.ContainsRune("0123456789.,", r) {
+					m.cells[m.row][m.col] += string(r)
+				}
+			}
+		}
+	}
+
+	if _, ok := m.value(m.row, m.col); !ok && m.cells[m.row][m.col] != "" {
+		m.status = fmt.Sprintf(tr(errTUICell), m.u.alternatives[m.row], m.col+1, m.u.maxScore)
+	}
+	return m, nil
+}
+
+// gridLines малює матрицю з поточною клітинкою та виділеними некоректними значеннями
+func (m *matrixEditor) gridLines() []string {
+	nameWidth := runewidth.StringWidth(tr("Альтернатива"))
+	for _, alt := range m.u.alternatives {
+		nameWidth = max(nameWidth, runewidth.StringWidth(alt))
+	}
+
+	header := padCell(tr("Альтернатива"), nameWidth, false)
+	for j := range m.u.statesCount {
+		header += " " + padCell(fmt.Sprintf(tr("Стан %d"), j+1), tuiCellWidth, true)
+	}
+	lines := []string{header}
+
+	for i, alt := range m.u.alternatives {
+		line := padCell(alt, nameWidth, false)
+		for j, text := range m.cells[i] {
+			cell := padCell(text, tuiCellWidth, true)
+			if _, ok := m.value(i, j); !ok && text != "" {
+				cell = ansiInvalid + cell + ansiReset
+			}
+			if i == m.row && j == m.col {
+				cell = ansiCursor + cell + ansiReset
+			}
+			line += " " + cell
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// panelLines обчислює критерії для альтернатив, рядки яких заповнено повністю
+func (m *matrixEditor) panelLines() []string {
+	partial := *m.u
+	partial.outcomes = make(map[string][]float64)
+
+	var alts []Alternative
+	lines := []string{
+		fmt.Sprintf(tr("Критерії (α = %.2f)"), num(m.u.alpha)),
+		fmt.Sprintf("%-12s %8s %8s %8s", "", tr("Вальда"), "maxmax", tr("Гурвіца")),
+	}
+	for i, alt := range m.u.alternatives {
+		values, ok := m.rowValues(i)
+		if !ok {
+			lines = append(lines, fmt.Sprintf("%-12s %8s %8s %8s", runewidth.Truncate(alt, 12, "…"), "–", "–", "–"))
+			continue
+		}
+		partial.outcomes[alt] = values
+		a := partial.evaluate(alt)
+		alts = append(alts, a)
+		lines = append(lines, fmt.Sprintf("%-12s %8.2f %8.2f %8.4f", runewidth.Truncate(alt, 12, "…"), num(a.wald), num(a.maxmax), num(a.hurwicz)))
+	}
+
+	lines = append(lines, "")
+	if len(alts) == 0 {
+		return append(lines, tr(tuiIncomplete))
+	}
+	for _, c := range criteria {
+		labels, values := rankingValues(alts, c.value)
+		var best []string
+		for i, v := range values {
+			if v == values[0] {
+				best = append(best, labels[i])
+			}
+		}
+		lines = append(lines, fmt.Sprintf(tr("Найкраща за критерієм %s: %s"), tr(c.name), strings.Join(best, ", ")))
+	}
+	return lines
+}
+
+func (m *matrixEditor) View() string {
+	if m.done || m.cancelled {
+		return ""
+	}
+	grid, panel := m.gridLines(), m.panelLines()
+	gridWidth := runewidth.StringWidth(grid[0])
+
+	var b strings.Builder
+	b.WriteString(tr(reportTitle) + "\n\n")
+	for i := range max(len(grid), len(panel)) {
+		left := ""
+		if i < len(grid) {
+			left = grid[i]
+		}
+		// Ширина рядка сітки рахується без ANSI-послідовностей
+		b.WriteString(left + strings.Repeat(" ", gridWidth-runewidth.StringWidth(stripANSI(left))))
+		if i < len(panel) {
+			b.WriteString("   │ " + panel[i])
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if m.status != "" {
+		b.WriteString(ansiInvalid + m.status + ansiReset + "\n")
+	} else {
+		b.WriteString(fmt.Sprintf(tr(tuiSelectedValue)+"\n", m.u.alternatives[m.row], m.col+1, m.cells[m.row][m.col]))
+	}
+	b.WriteString(tr(tuiHelp) + "\n")
+	return b.String()
+}
+
+// stripANSI прибирає керівні послідовності кольору для обчислення ширини рядка
+func stripANSI(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\033' {
+			for i < len(s) && s[i] != 'm' {
+				i++
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// EditMatrixTUI відкриває повноекранний редактор матриці; повертає false,
+// якщо користувач вийшов без розрахунку
+func (u *UncertainDecisionSystem) EditMatrixTUI() (bool, error) {
+	final, err := tea.NewProgram(newMatrixEditor(u), tea.WithAltScreen()).Run()
+	if err != nil {
+		return false, err
+	}
+	return final.(*matrixEditor).done, nil
+}
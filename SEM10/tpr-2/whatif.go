@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	errWhatIfSyntax = "Використання: set <альтернатива> <стан> <значення>"
+	errWhatIfAlt    = "Невідома альтернатива '%s'"
+	errWhatIfState  = "Некоректний стан '%s': потрібен номер від 1 до %d (наприклад, 3 або state3)"
+	errWhatIfValue  = "Некоректне значення '%s': потрібне число від 1 до %d"
+)
+
+// parseState приймає номер стану у вигляді "3", "state3" або "стан3" і повертає індекс з нуля
+func (u *UncertainDecisionSystem) parseState(token string) (int, error) {
+	digits := strings.TrimLeftFunc(token, func(r rune) bool { return !unicode.IsDigit(r) })
+	j, err := strconv.Atoi(digits)
+	if err != nil || j < 1 || j > u.statesCount {
+		return 0, fmt.Errorf(tr(errWhatIfState), token, u.statesCount)
+	}
+	return j - 1, nil
+}
+
+// SetOutcome змінює значення корисності альтернативи при стані та перераховує
+// лише її критерії: Вальда, maxmax і Гурвіца інших альтернатив від цієї клітинки не залежать
+func (u *UncertainDecisionSystem) SetOutcome(alts []Alternative, args []string) error {
+	if len(args) < 3 {
+		return errors.New(tr(errWhatIfSyntax))
+	}
+	// Назва альтернативи може містити пробіли, тому стан і значення беруться з кінця
+	alt := strings.Join(args[:len(args)-2], " ")
+	i := slices.Index(u.alternatives, alt)
+	if i < 0 {
+		return fmt.Errorf(tr(errWhatIfAlt), alt)
+	}
+	j, err := u.parseState(args[len(args)-2])
+	if err != nil {
+		return err
+	}
+	raw := args[len(args)-1]
+	value, err := parseFloat(raw)
+	if err != nil || value < 1 || value > float64(u.maxScore) {
+		return fmt.Errorf(tr(errWhatIfValue), raw, u.maxScore)
+	}
+
+	before := rankingOrders(alts)
+	old := u.outcomes[alt][j]
+	u.outcomes[alt][j] = value
+	alts[i] = u.evaluate(alt)
+	fmt.Printf(tr("u(%s, стан %d): %.2f → %.2f\n"), alt, j+1, num(old), num(value))
+	fmt.Printf(tr("Перераховано %s: Вальда %.4f, maxmax %.4f, Гурвіца %.4f\n"),
+		alt, num(alts[i].wald), num(alts[i].maxmax), num(alts[i].hurwicz))
+	printRankingChanges(before, alts)
+	return nil
+}
+
+// rankingOrders повертає порядок альтернатив за кожним критерієм
+func rankingOrders(alts []Alternative) [][]string {
+	orders := make([][]string, len(criteria))
+	for k, c := range criteria {
+		orders[k], _ = rankingValues(alts, c.value)
+	}
+	return orders
+}
+
+// printRankingChanges порівнює ранжування з попередніми й виводить змінені
+func printRankingChanges(before [][]string, alts []Alternative) {
+	for k, after := range rankingOrders(alts) {
+		name := tr(criteria[k].name)
+		if slices.Equal(before[k], after) {
+			fmt.Printf(tr("  %s: ранжування без змін\n"), name)
+			continue
+		}
+		fmt.Println(highlight(fmt.Sprintf("  %s: %s → %s",
+			name, strings.Join(before[k], " ≻ "), strings.Join(after, " ≻ "))))
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const xlsxResultsSheet = "Результати"
+
+// loadXLSX зчитує матрицю корисності з аркуша Excel: перший рядок – заголовки станів,
+// далі в кожному рядку назва альтернативи та її значення за станами.
+// Якщо аркуш не вказано, використовується перший аркуш книги.
+func loadXLSX(path, sheet string) (*UncertainDecisionSystem, error) {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 || len(rows[0]) < 2 {
+		return nil, fmt.Errorf(tr(errXLSXEmpty), sheet)
+	}
+
+	u := &UncertainDecisionSystem{
+		statesCount: len(rows[0]) - 1,
+		outcomes:    make(map[string][]float64),
+	}
+	maxVal := 0.0
+	for i, row := range rows[1:] {
+		if len(row) == 0 || row[0] == "" {
+			continue
+		}
+		alt := row[0]
+		if _, ok := u.outcomes[alt]; ok {
+			return nil, fmt.Errorf(tr(errXLSXDuplicate), alt)
+		}
+
+		values := make([]float64, u.statesCount)
+		for j := range values {
+			cell := ""
+			if j+1 < len(row) {
+				cell = row[j+1]
+			}
+			v, err := parseFloat(cell)
+			if err != nil {
+				name, _ := excelize.CoordinatesToCellName(j+2, i+2)
+				return nil, fmt.Errorf(tr(errXLSXCell), sheet, name, cell)
+			}
+			values[j] = v
+			maxVal = math.Max(maxVal, v)
+		}
+
+		u.alternatives = append(u.alternatives, alt)
+		u.outcomes[alt] = values
+	}
+	if len(u.alternatives) == 0 {
+		return nil, fmt.Errorf(tr(errXLSXEmpty), sheet)
+	}
+
+	if u.maxScore, err = scoreScale(maxVal); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// SaveXLSX записує таблиці звіту на аркуш результатів. Якщо файл уже існує
+// (наприклад, це книга з вхідними даними), аркуш результатів у ньому замінюється,
+// а решта аркушів залишається без змін.
+func (r *Report) SaveXLSX(path string) error {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		f = excelize.NewFile()
+	}
+	defer f.Close()
+
+	if idx, _ := f.GetSheetIndex(xlsxResultsSheet); idx >= 0 {
+		if err := f.DeleteSheet(xlsxResultsSheet); err != nil {
+			return err
+		}
+	}
+	idx, err := f.NewSheet(xlsxResultsSheet)
+	if err != nil {
+		return err
+	}
+	f.SetActiveSheet(idx)
+	// Порожній аркуш за замовчуванням нової книги не потрібен
+	if sheet := "Sheet1"; f.GetSheetName(0) == sheet && len(f.GetSheetList()) > 1 {
+		if rows, _ := f.GetRows(sheet); len(rows) == 0 {
+			f.DeleteSheet(sheet)
+		}
+	}
+
+	line := 1
+	for _, t := range r.Tables {
+		if err := f.SetCellValue(xlsxResultsSheet, fmt.Sprintf("A%d", line), t.Title); err != nil {
+			return err
+		}
+		line++
+
+		rows := append([][]string{t.Header}, t.Rows...)
+		for _, row := range rows {
+			for j, cell := range row {
+				name, _ := excelize.CoordinatesToCellName(j+1, line)
+				var value any = cell
+				if v, err := parseFloat(cell); err == nil {
+					value = v
+				}
+				if err := f.SetCellValue(xlsxResultsSheet, name, value); err != nil {
+					return err
+				}
+			}
+			line++
+		}
+		line++
+	}
+
+	return f.SaveAs(path)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// strictlyDominates перевіряє, чи альтернатива a краща за b за кожного стану
+func (u *UncertainDecisionSystem) strictlyDominates(a, b string) bool {
+	for j, v := range u.outcomes[a] {
+		if v <= u.outcomes[b][j] {
+			return false
+		}
+	}
+	return true
+}
+
+// Dominators повертає для кожної строго домінованої альтернативи перелік
+// альтернатив, що домінують над нею (у порядку введення)
+func (u *UncertainDecisionSystem) Dominators() map[string][]string {
+	out := make(map[string][]string)
+	for _, b := range u.alternatives {
+		for _, a := range u.alternatives {
+			if a != b && u.strictlyDominates(a, b) {
+				out[b] = append(out[b], a)
+			}
+		}
+	}
+	return out
+}
+
+// RemoveDominated виключає строго доміновані альтернативи перед застосуванням
+// критеріїв і виводить, яку альтернативу виключено і які над нею домінують.
+// Домінування транзитивне, тому серед недомінованих завжди є домінуюча.
+func (u *UncertainDecisionSystem) RemoveDominated() {
+	dominators := u.Dominators()
+	fmt.Println(tr("\nВиключення строго домінованих альтернатив (гірші за іншу альтернативу за кожного стану):"))
+	if len(dominators) == 0 {
+		fmt.Println(tr("  Строго домінованих альтернатив немає"))
+		return
+	}
+	for _, alt := range u.alternatives {
+		if by, ok := dominators[alt]; ok {
+			fmt.Printf(tr("  %s виключено: домінують %s\n"), alt, strings.Join(by, ", "))
+		}
+	}
+	u.alternatives = slices.DeleteFunc(u.alternatives, func(alt string) bool {
+		_, dominated := dominators[alt]
+		if dominated {
+			delete(u.outcomes, alt)
+		}
+		return dominated
+	})
+	fmt.Printf(tr("  Залишилось альтернатив: %d (%s)\n"), len(u.alternatives), strings.Join(u.alternatives, ", "))
+}
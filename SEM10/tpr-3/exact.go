@@ -0,0 +1,47 @@
+package main
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// exact вмикає точну раціональну арифметику для жалю та середніх значень
+// (прапорець -exact), щоб результати збігалися з обчисленнями вручну
+var exact bool
+
+// rat повертає значення як раціональне число за його найкоротшим десятковим
+// записом: введене 7.1 стає рівно 71/10, а не двійковим наближенням
+func rat(v float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	return r
+}
+
+// ratFloat повертає найближче до r значення float64; рівні раціональні числа
+// дають рівні значення, тому однакові результати не розходяться в ранжуванні
+func ratFloat(r *big.Rat) float64 {
+	f, _ := r.Float64()
+	return f
+}
+
+// sub повертає a − b; у точному режимі різниця обчислюється в раціональних числах
+func sub(a, b float64) float64 {
+	if !exact {
+		return a - b
+	}
+	return ratFloat(new(big.Rat).Sub(rat(a), rat(b)))
+}
+
+// sumRat повертає точну суму значень
+func sumRat(values []float64) *big.Rat {
+	sum := new(big.Rat)
+	for _, v := range values {
+		sum.Add(sum, rat(v))
+	}
+	return sum
+}
+
+// meanRat повертає точне середнє арифметичне значень
+func meanRat(values []float64) *big.Rat {
+	sum := sumRat(values)
+	return sum.Quo(sum, big.NewRat(int64(len(values)), 1))
+}
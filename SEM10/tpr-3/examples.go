@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// example – вбудована задача з підручника для демонстрації без введення даних
+type example struct {
+	description  string
+	alternatives []string
+	maxScore     int
+	outcomes     [][]float64 // рядок – альтернатива, стовпець – стан
+}
+
+var examples = map[string]example{
+	"farming": {
+		description:  "вибір культури для посіву за невідомих погодних умов (посуха, норма, дощове літо)",
+		alternatives: []string{"Пшениця", "Кукурудза", "Соняшник", "Ячмінь"},
+		maxScore:     10,
+		outcomes: [][]float64{
+			{4, 7, 6},
+			{2, 8, 9},
+			{6, 7, 3},
+			{5, 6, 5},
+		},
+	},
+	"investment": {
+		description:  "розподіл капіталу між інструментами за різних станів економіки (спад, стагнація, зростання)",
+		alternatives: []string{"Акції", "Облігації", "Депозит", "Нерухомість"},
+		maxScore:     10,
+		outcomes: [][]float64{
+			{1, 5, 10},
+			{4, 6, 7},
+			{5, 5, 5},
+			{3, 6, 8},
+		},
+	},
+	"production": {
+		description:  "обсяг випуску продукції за невідомого попиту (низький, середній, високий, дуже високий)",
+		alternatives: []string{"100 од.", "200 од.", "300 од.", "400 од."},
+		maxScore:     10,
+		outcomes: [][]float64{
+			{5, 5, 5, 5},
+			{3, 7, 7, 7},
+			{1, 5, 9, 9},
+			{1, 3, 7, 10},
+		},
+	},
+}
+
+func exampleNames() []string {
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// PrintExamples виводить перелік вбудованих задач
+func PrintExamples() {
+	fmt.Println(tr("Вбудовані задачі (-example <назва>):"))
+	for _, name := range exampleNames() {
+		fmt.Printf("  %-12s %s\n", name, tr(examples[name].description))
+	}
+}
+
+// loadExample створює систему з вбудованої задачі
+func loadExample(name string) (*UncertainDecisionSystem, error) {
+	e, ok := examples[name]
+	if !ok {
+		return nil, fmt.Errorf(tr(errUnknownExample), name, strings.Join(exampleNames(), ", "))
+	}
+	u := &UncertainDecisionSystem{
+		alternatives: e.alternatives,
+		statesCount:  len(e.outcomes[0]),
+		maxScore:     e.maxScore,
+		outcomes:     make(map[string][]float64),
+	}
+	for i, alt := range e.alternatives {
+		u.outcomes[alt] = slices.Clone(e.outcomes[i])
+	}
+	fmt.Printf(tr("Задача '%s': %s\n"), name, tr(e.description))
+	return u, nil
+}
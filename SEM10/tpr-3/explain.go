@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// explain вмикає покроковий вивід проміжних обчислень (прапорець -explain)
+var explain bool
+
+func explainf(format string, args ...any) {
+	if explain {
+		fmt.Printf(tr(format), localize(args)...)
+	}
+}
+
+// joinValues форматує значення через кому для запису формул
+func joinValues(values []float64) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%.2f", v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// listValues форматує значення для покрокових пояснень за обраною локаллю
+func listValues(values []float64, sep string) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%.2f", num(v))
+	}
+	return strings.Join(parts, sep)
+}
+
+// stateColumn повертає значення корисності всіх альтернатив за станом j
+func (u *UncertainDecisionSystem) stateColumn(j int) []float64 {
+	column := make([]float64, len(u.alternatives))
+	for i, alt := range u.alternatives {
+		column[i] = u.outcomes[alt][j]
+	}
+	return column
+}
+
+// ExplainSavage виводить побудову матриці жалю: максимуми стовпців,
+// кожне віднімання та максимальний жаль кожної альтернативи
+func (u *UncertainDecisionSystem) ExplainSavage() {
+	explainf("\nКрок 1. Максимальна корисність кожного стану: max_a u(a, j)\n")
+	maxOutcomes := u.StateMaxima()
+	for j := range u.statesCount {
+		explainf("  Стан %d: max(%s) = %.2f\n", j+1, listValues(u.stateColumn(j), valueSep()), maxOutcomes[j])
+	}
+
+	regrets := u.RegretMatrix()
+	if relativeRegret {
+		explainf("\nКрок 2. Відносний жаль r(a, j) = (max_a u(a, j) − u(a, j)) / max_a u(a, j) · 100 %%\n")
+		for _, alt := range u.alternatives {
+			for j, outcome := range u.outcomes[alt] {
+				explainf("  r(%s, %d) = (%.2f − %.2f) / %.2f · 100 = %.2f\n", alt, j+1, maxOutcomes[j], outcome,
+					maxOutcomes[j], regrets[alt][j])
+			}
+		}
+	} else {
+		explainf("\nКрок 2. Жаль r(a, j) = max_a u(a, j) − u(a, j)\n")
+		for _, alt := range u.alternatives {
+			for j, outcome := range u.outcomes[alt] {
+				explainf("  r(%s, %d) = %.2f − %.2f = %.2f\n", alt, j+1, maxOutcomes[j], outcome, regrets[alt][j])
+			}
+		}
+	}
+
+	explainf("\nКрок 3. Критерій Севіджа – найбільший жаль альтернативи: S(a) = max_j r(a, j)\n")
+	savage := u.CalculateSavage()
+	for _, alt := range u.alternatives {
+		explainf("  S(%s) = max(%s) = %.2f\n", alt, listValues(regrets[alt], valueSep()), savage[alt])
+	}
+	explainf("  Оптимальна альтернатива має найменше S(a)\n")
+}
+
+// ExplainLaplace виводить обчислення середньої корисності кожної альтернативи
+func (u *UncertainDecisionSystem) ExplainLaplace() {
+	explainf("\nКрок 4. Критерій Лапласа – середня корисність за рівноймовірних станів: L(a) = Σ_j u(a, j) / n, n = %d\n",
+		u.statesCount)
+	laplace := u.CalculateLaplace()
+	for _, alt := range u.alternatives {
+		sum := 0.0
+		for _, outcome := range u.outcomes[alt] {
+			sum += outcome
+		}
+		if exact {
+			sum = ratFloat(sumRat(u.outcomes[alt]))
+		}
+		explainf("  L(%s) = (%s) / %d = %.2f / %d = %.4f\n", alt,
+			listValues(u.outcomes[alt], " + "), u.statesCount, sum, u.statesCount, laplace[alt])
+		if exact {
+			explainf("    точне значення: L(%s) = %s\n", alt, meanRat(u.outcomes[alt]).RatString())
+		}
+	}
+	explainf("  Оптимальна альтернатива має найбільше L(a)\n")
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+)
+
+// stepScore – результат перевірки одного кроку розв'язку
+type stepScore struct {
+	name     string
+	correct  int
+	total    int
+	mistakes []string
+}
+
+// loadTrace зчитує відповіді студента у форматі журналу обчислень (-trace)
+func loadTrace(path string) (*Trace, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var t Trace
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf(tr(errGradeFormat), path, err)
+	}
+	return &t, nil
+}
+
+func closeEnough(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+// GradeTrace порівнює відповіді з еталонним розв'язком: кожне значення кроку
+// та кожна позиція ранжування зараховується, якщо відхилення не перевищує tolerance.
+// Позиція ранжування правильна, якщо еталонне значення названої альтернативи
+// збігається зі значенням на цій позиції, тому порядок рівноцінних альтернатив не важливий.
+func GradeTrace(reference, answer *Trace, tolerance float64) []stepScore {
+	var scores []stepScore
+
+	for _, ref := range reference.Steps {
+		score := stepScore{name: ref.Name, total: len(ref.Entries)}
+		given := make(map[string]float64)
+		for _, s := range answer.Steps {
+			if s.Name == ref.Name {
+				for _, e := range s.Entries {
+					given[e.key()] = e.Result
+				}
+			}
+		}
+		for _, e := range ref.Entries {
+			v, ok := given[e.key()]
+			switch {
+			case !ok:
+				score.mistakes = append(score.mistakes, fmt.Sprintf(tr(gradeMissing), e.key()))
+			case !closeEnough(v, e.Result, tolerance):
+				score.mistakes = append(score.mistakes, fmt.Sprintf(tr(gradeWrongValue), e.key(), num(e.Result), num(v)))
+			default:
+				score.correct++
+			}
+		}
+		scores = append(scores, score)
+	}
+
+	for _, ref := range reference.Rankings {
+		score := stepScore{name: "ranking/" + ref.Criterion, total: len(ref.Order)}
+		refValue := make(map[string]float64)
+		for i, alt := range ref.Order {
+			refValue[alt] = ref.Values[i]
+		}
+		var order []string
+		for _, r := range answer.Rankings {
+			if r.Criterion == ref.Criterion {
+				order = r.Order
+			}
+		}
+		for i := range ref.Order {
+			if i >= len(order) {
+				score.mistakes = append(score.mistakes, fmt.Sprintf(tr(gradeMissingRank), i+1))
+				continue
+			}
+			v, ok := refValue[order[i]]
+			if !ok || !closeEnough(v, ref.Values[i], tolerance) {
+				score.mistakes = append(score.mistakes, fmt.Sprintf(tr(gradeWrongRank), i+1, order[i]))
+				continue
+			}
+			score.correct++
+		}
+		scores = append(scores, score)
+	}
+	return scores
+}
+
+// PrintGrade виводить бали за кожен крок, перелік помилок і загальну оцінку у відсотках
+func PrintGrade(scores []stepScore) {
+	fmt.Println(tr("\nРезультати перевірки розв'язку:"))
+	t := Table{Header: []string{tr("Крок"), tr("Правильно"), tr("Всього"), tr("Бал, %")}}
+	correct, total := 0, 0
+	for _, s := range scores {
+		t.Rows = append(t.Rows, []string{s.name, fmt.Sprint(s.correct), fmt.Sprint(s.total), percent(s.correct, s.total)})
+		correct += s.correct
+		total += s.total
+	}
+	RenderTable(os.Stdout, t, func(row, col int, cell string) string {
+		if row >= 0 && scores[row].correct == scores[row].total {
+			return highlight(cell)
+		}
+		return cell
+	})
+
+	for _, s := range scores {
+		for _, m := range s.mistakes {
+			fmt.Printf("  %s: %s\n", s.name, m)
+		}
+	}
+	fmt.Printf(tr("\nЗагальна оцінка: %d з %d (%s%%)\n"), correct, total, percent(correct, total))
+}
+
+func percent(part, total int) string {
+	if total == 0 {
+		return "100.0"
+	}
+	return fmt.Sprintf("%.1f", num(100*float64(part)/float64(total)))
+}
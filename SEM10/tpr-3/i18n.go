@@ -0,0 +1,200 @@
+package main
+
+import (
+	"os"
+	"strings"
+)
+
+// lang – мова інтерфейсу: "uk" (за замовчуванням) або "en"
+var lang = "uk"
+
+// setupLang обирає мову з прапорця -lang, а якщо його не задано – зі змінної
+// середовища LANG; українська лишається мовою за замовчуванням для C/POSIX
+func setupLang(flagValue string) {
+	if flagValue == "" {
+		flagValue = os.Getenv("LANG")
+		if flagValue == "" || flagValue == "C" || flagValue == "POSIX" || strings.HasPrefix(flagValue, "C.") {
+			flagValue = "uk"
+		}
+	}
+	lang = "en"
+	if strings.HasPrefix(strings.ToLower(flagValue), "uk") {
+		lang = "uk"
+	}
+}
+
+// tr повертає переклад повідомлення обраною мовою. Ключем каталогу є
+// український текст, тому повідомлення без перекладу виводяться як є.
+func tr(s string) string {
+	if lang == "en" {
+		if t, ok := catalogEN[s]; ok {
+			return t
+		}
+	}
+	return s
+}
+
+// catalogEN – англійські переклади підказок, заголовків таблиць і повідомлень про помилки
+var catalogEN = map[string]string{
+	// Введення задачі
+	promptAltCount:     "Enter the number of alternatives: ",
+	promptAltName:      "Enter the name of alternative %d: ",
+	promptStateCount:   "Enter the number of external conditions (states): ",
+	promptStateValue:   "Enter the utility of alternative '%s' in state %d (1 to %d): ",
+	promptMaxScore:     "Enter the maximum score of the rating scale (e.g. 10): ",
+	promptBackHint:     "To return to the previous question and correct the answer, enter '<' or back.\n",
+	promptResume:       "Found unfinished input from %s (alternatives: %s; %d of %d values entered). Continue? (y/n): ",
+	promptPaste:        "Paste a block of cells from Excel or Google Sheets (alternative names in the first column, state header optional) and press Enter on an empty line:\n",
+	promptPasteConfirm: "Was the matrix recognised correctly? (Y/n): ",
+	"Перший рядок розпізнано як заголовок станів і пропущено":        "The first row was recognised as the state header and skipped",
+	"Розпізнано альтернатив: %d, станів: %d, система балів: до %d\n": "Recognised alternatives: %d, states: %d, rating scale: up to %d\n",
+	"альтернатив":    "alternatives",
+	"зовнішніх умов": "external conditions",
+	"\nВведіть значення корисності для альтернативи '%s':\n": "\nEnter the utility values for alternative '%s':\n",
+
+	// Результати
+	promptCriterionResults:  "\nResults for the %s criterion:\n",
+	reportTitle:             "Decision making under uncertainty: Savage and Laplace criteria",
+	"\nМатриця корисності:": "\nUtility matrix:",
+	"Матриця корисності":    "Utility matrix",
+	"Матриця жалю":          "Regret matrix",
+	"Альтернатива":          "Alternative",
+	"Стан %d":               "State %d",
+	"Ранг":                  "Rank",
+	"Севіджа":               "Savage",
+	"Лапласа":               "Laplace",
+	"Макс. жалю":            "Max. regret",
+	"Матриця відносного жалю, %": "Relative regret matrix, %",
+	"бал.":                       "pts",
+	"Макс. жалю, %":              "Max. regret, %",
+	"Середня корисність":         "Mean utility",
+	"Ранжування за критерієм %s": "Ranking by the %s criterion",
+	"За критерієм Севіджа оптимальна альтернатива – %s (максимальний жаль %.4f)":  "By the Savage criterion the optimal alternative is %s (maximum regret %.4f)",
+	"За критерієм Лапласа оптимальна альтернатива – %s (середня корисність %.4f)": "By the Laplace criterion the optimal alternative is %s (mean utility %.4f)",
+	"Матриця жалю (критерій Севіджа)":                                             "Regret matrix (Savage criterion)",
+	"Матриця відносного жалю, % (критерій Севіджа)":                               "Relative regret matrix, % (Savage criterion)",
+	"Корисність альтернатив за станами":                                           "Utility of alternatives by state",
+	"\nЗвіт збережено у файл %s\n":                                                "\nReport saved to %s\n",
+	"Звіт збережено у файл %s\n":                                                  "Report saved to %s\n",
+	"Діаграму збережено у файл %s\n":                                              "Chart saved to %s\n",
+	"\nРезультати записано на аркуш '%s' книги %s\n":                              "\nResults written to sheet '%s' of workbook %s\n",
+	"Результати записано на аркуш '%s' книги %s\n":                                "Results written to sheet '%s' of workbook %s\n",
+	"Висновки":           "Conclusions",
+	"Аналіз результатів": "Analysis of the results",
+	summarySavage:        "By the Savage criterion the optimal alternative is %s, because its maximum regret – the largest loss against the best decision in any state – is %.2f, the lowest among the alternatives. This is the choice of a decision maker who wants to minimise possible disappointment.",
+	summaryLaplace:       "By the Laplace criterion the optimal alternative is %s: with equally likely states its mean utility of %.2f is the highest. The criterion fits when there is no reason to consider any state more likely.",
+	summaryTie:           "By the %s criterion alternatives %s are equally good with the value %.2f.",
+	summaryRunner:        " The closest alternative, %s, falls short by %.2f.",
+	summaryAgree:         "Both criteria recommend alternative %s, so the choice holds whether one focuses on possible regret or on the average outcome.",
+	summaryDiffer:        "The criteria give different recommendations (Savage – %s; Laplace – %s): the first minimises the largest regret, the second maximises the average outcome, so the choice depends on what matters more to the decision maker.",
+	"Теорія прийняття рішень": "Decision theory",
+	"Варіант ": "Variant ",
+
+	// Покрокові пояснення
+	"\nКрок 1. Максимальна корисність кожного стану: max_a u(a, j)\n": "\nStep 1. Maximum utility of each state: max_a u(a, j)\n",
+	"  Стан %d: max(%s) = %.2f\n":                                                                                "  State %d: max(%s) = %.2f\n",
+	"\nКрок 2. Жаль r(a, j) = max_a u(a, j) − u(a, j)\n":                                                         "\nStep 2. Regret r(a, j) = max_a u(a, j) − u(a, j)\n",
+	"\nКрок 2. Відносний жаль r(a, j) = (max_a u(a, j) − u(a, j)) / max_a u(a, j) · 100 %%\n":                    "\nStep 2. Relative regret r(a, j) = (max_a u(a, j) − u(a, j)) / max_a u(a, j) · 100 %%\n",
+	"\nКрок 3. Критерій Севіджа – найбільший жаль альтернативи: S(a) = max_j r(a, j)\n":                          "\nStep 3. Savage criterion – the largest regret of an alternative: S(a) = max_j r(a, j)\n",
+	"  Оптимальна альтернатива має найменше S(a)\n":                                                              "  The optimal alternative has the lowest S(a)\n",
+	"\nКрок 4. Критерій Лапласа – середня корисність за рівноймовірних станів: L(a) = Σ_j u(a, j) / n, n = %d\n": "\nStep 4. Laplace criterion – mean utility with equally likely states: L(a) = Σ_j u(a, j) / n, n = %d\n",
+	"  Оптимальна альтернатива має найбільше L(a)\n":                                                             "  The optimal alternative has the highest L(a)\n",
+	"    точне значення: L(%s) = %s\n":                                                                           "    exact value: L(%s) = %s\n",
+
+	// Вбудовані задачі
+	"Вбудовані задачі (-example <назва>):": "Built-in problems (-example <name>):",
+	"Задача '%s': %s\n":                    "Problem '%s': %s\n",
+	"вибір культури для посіву за невідомих погодних умов (посуха, норма, дощове літо)":           "choosing a crop to sow under unknown weather (drought, normal, rainy summer)",
+	"розподіл капіталу між інструментами за різних станів економіки (спад, стагнація, зростання)": "allocating capital between instruments in different economic states (recession, stagnation, growth)",
+	"обсяг випуску продукції за невідомого попиту (низький, середній, високий, дуже високий)":     "production volume under unknown demand (low, medium, high, very high)",
+
+	// Перевірка розв'язків
+	"\nРезультати перевірки розв'язку:": "\nSolution check results:",
+	"Крок":      "Step",
+	"Правильно": "Correct",
+	"Всього":    "Total",
+	"Бал, %":    "Score, %",
+	"\nЗагальна оцінка: %d з %d (%s%%)\n": "\nOverall score: %d of %d (%s%%)\n",
+	gradeMissing:     "value for '%s' is missing",
+	gradeWrongValue:  "'%s': expected %.4f, got %.4f",
+	gradeMissingRank: "position %d is missing",
+	gradeWrongRank:   "position %d: '%s' is out of place",
+
+	// Помилки
+	errInvalidCount:   "Invalid number of %s",
+	errInvalidScore:   "Invalid rating scale value",
+	errInvalidValue:   "Invalid value. Please try again.",
+	errNoFont:         "No font with Cyrillic glyphs found for PDF, specify one with -font",
+	errXLSXEmpty:      "Sheet '%s' has no matrix: a header row and at least one alternative are required",
+	errXLSXDuplicate:  "Alternative '%s' is repeated in the Excel workbook",
+	errXLSXCell:       "Sheet '%s', cell %s: invalid number '%s'",
+	errScoreRange:     "The largest value %g exceeds the largest scoring system – up to %d",
+	errUnknownExample: "Unknown problem '%s', available: %s",
+	errRounding:       "Unknown rounding mode '%s', available: half-up, half-even",
+	errGradeFormat:    "Answer file %s does not match the calculation log format: %v",
+	errSessionFormat:  "Session file %s is corrupted, input will start from the beginning",
+	errSessionSave:    "Could not save the session: %v\n",
+	errStdinJSON:      "Invalid problem on standard input: %v",
+	errStdinShape:     "The problem must contain at least one alternative and an outcomes row for each (alternatives: %d, rows: %d)",
+	errStdinDuplicate: "Alternative '%s' is repeated",
+	errStdinRow:       "Alternative '%s': %d values, but there are %d states",
+	errPasteEmpty:     "The pasted block has no matrix: a column of alternative names, at least one column of values and at least one alternative are required",
+	errPasteDuplicate: "Alternative '%s' is repeated in the pasted block",
+	errPasteCell:      "Row %d, column %d of the pasted block: invalid number '%s'",
+
+	// Виключення домінованих альтернатив
+	"\nВиключення строго домінованих альтернатив (гірші за іншу альтернативу за кожного стану):": "\nEliminating strictly dominated alternatives (worse than another alternative in every state):",
+	"  Строго домінованих альтернатив немає":                                                     "  There are no strictly dominated alternatives",
+	"  %s виключено: домінують %s\n":                                                             "  %s eliminated: dominated by %s\n",
+	"  Залишилось альтернатив: %d (%s)\n":                                                        "  Alternatives remaining: %d (%s)\n",
+
+	// Що, якщо та команди після розрахунку
+	errWhatIfSyntax:                 "Usage: set <alternative> <state> <value>",
+	errWhatIfAlt:                    "Unknown alternative '%s'",
+	errWhatIfState:                  "Invalid state '%s': a number from 1 to %d is required (e.g. 3 or state3)",
+	errWhatIfValue:                  "Invalid value '%s': a number from 1 to %d is required",
+	"u(%s, стан %d): %.2f → %.2f\n": "u(%s, state %d): %.2f → %.2f\n",
+	"Максимум стану %d змінився (%.2f → %.2f): жаль перераховано для всіх альтернатив\n": "Maximum of state %d changed (%.2f → %.2f): regret recalculated for all alternatives\n",
+	"Перераховано %s: Севіджа %.4f, Лапласа %.4f\n":                                      "Recalculated %s: Savage %.4f, Laplace %.4f\n",
+	"  %s: ранжування без змін\n":                                                        "  %s: ranking unchanged\n",
+	promptREPL: "\nCommand (help – help, quit – exit): ",
+	replHelp: `Commands:
+  set <alternative> <state> <value>      change one matrix value, e.g.: set A2 state3 7.5
+  rerun [criterion]                      recalculate and print rankings (savage or laplace),
+                                         e.g.: rerun laplace
+  add alt "<name>"                       add an alternative and enter its utility values
+  add state                              add a state and enter the utility of every alternative
+  remove alt "<name>"                    remove an alternative
+  remove state <state>                   remove a state, e.g.: remove state 2
+                                         After adding or removing, the criteria are recalculated
+                                         and a changed order of the other alternatives is flagged as rank reversal
+  export <format> <file>                 save results: json, md, html, tex or xlsx
+  help                                   this help
+  quit                                   exit`,
+	errREPLCommand:      "Unknown command '%s', enter help for help",
+	errREPLQuote:        "Unclosed quotes in the command",
+	errREPLCriterion:    "Unknown criterion '%s', available: %s",
+	errREPLAddSyntax:    "Usage: add alt \"<name>\" or add state",
+	errREPLRemoveSyntax: "Usage: remove alt \"<name>\" or remove state <state>",
+	errREPLLastAlt:      "The problem must keep at least one alternative",
+	errREPLLastState:    "The problem must keep at least one state",
+	errREPLAltExists:    "Alternative '%s' already exists",
+	errREPLExport:       "Usage: export <format> <file>",
+	errREPLFormat:       "Unknown format '%s', available: json, md, html, tex, xlsx",
+	"Альтернативу '%s' додано\n":                                "Alternative '%s' added\n",
+	"Альтернативу '%s' видалено\n":                              "Alternative '%s' removed\n",
+	"Стан %d додано\n":                                          "State %d added\n",
+	"Стан %s видалено, наступні стани перенумеровано\n":         "State %s removed, the following states have been renumbered\n",
+	"\nВведіть значення корисності альтернатив при стані %d:\n": "\nEnter the utility of the alternatives in state %d:\n",
+	"    зміна порядку (rank reversal): %s":                     "    rank reversal: %s",
+
+	// Повноекранний редактор
+	tuiHelp:          "←↑↓→/Tab – move, Backspace – erase, Enter – calculate, Esc – exit",
+	tuiIncomplete:    "Fill in a row to see the criterion values",
+	errTUICell:       "Cell (%s, state %d): a number from 1 to %d is required",
+	errTUICancelled:  "Editing cancelled",
+	tuiSelectedValue: "Alternative '%s', state %d: %s",
+	"Критерії":       "Criteria",
+	"Найкраща за критерієм Севіджа: ": "Best by the Savage criterion: ",
+	"Найкраща за критерієм Лапласа: ": "Best by the Laplace criterion: ",
+}
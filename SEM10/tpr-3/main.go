@@ -2,7 +2,12 @@ package main
 
 import (
 	"bufio"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
 	"os"
 	"sort"
 	"strconv"
@@ -17,18 +22,39 @@ const (
 	promptStateValue       = "Введіть значення корисності для альтернативи '%s' при стані %d (від 1 до %d): "
 	promptMaxScore         = "Введіть максимальне значення бальної системи (наприклад, 10): "
 	promptCriterionResults = "\nРезультати за критерієм %s:\n"
+	promptBackHint         = "Щоб повернутися до попереднього питання й виправити відповідь, введіть '<' або back.\n"
+	promptResume           = "Знайдено незавершене введення від %s (альтернативи: %s; введено %d з %d значень). Продовжити? (т/н): "
+	promptPaste            = "Вставте блок клітинок з Excel або Google Sheets (назви альтернатив у першому стовпці, заголовок станів – за бажанням) і натисніть Enter на порожньому рядку:\n"
+	promptPasteConfirm     = "Матрицю розпізнано правильно? (Т/н): "
+
+	reportTitle = "Прийняття рішень в умовах невизначеності: критерії Севіджа та Лапласа"
 
 	// Error messages
-	errInvalidCount = "Некоректне число %s"
-	errInvalidScore = "Некоректне значення системи балів"
-	errInvalidValue = "Некоректне значення. Будь ласка, спробуйте ще раз."
-
-	// Table formats
-	headerFormat      = "%-20s"
-	stateHeaderFormat = "%-15s"
-	scoreFormat       = "%-15.2f"
-	resultRankFormat  = "%-5s %-20s %-15s\n"
-	resultItemFormat  = "%-5d %-20s %-15.4f\n"
+	errInvalidCount   = "Некоректне число %s"
+	errInvalidScore   = "Некоректне значення системи балів"
+	errScoreRange     = "Найбільше значення %g перевищує найбільшу систему балів – до %d"
+	errInvalidValue   = "Некоректне значення. Будь ласка, спробуйте ще раз."
+	errNoFont         = "Не знайдено шрифт із кирилицею для PDF, вкажіть його через -font"
+	errXLSXEmpty      = "Аркуш '%s' не містить матриці: потрібен рядок заголовків і хоча б одна альтернатива"
+	errXLSXDuplicate  = "Альтернатива '%s' повторюється в книзі Excel"
+	errXLSXCell       = "Аркуш '%s', клітинка %s: некоректне число '%s'"
+	errUnknownExample = "Невідома задача '%s', доступні: %s"
+	errRounding       = "Невідомий спосіб округлення '%s', доступні: half-up, half-even"
+	errGradeFormat    = "Файл відповідей %s не відповідає формату журналу обчислень: %v"
+	errSessionFormat  = "Файл сесії %s пошкоджено, введення почнеться спочатку"
+	errSessionSave    = "Не вдалося зберегти сесію: %v\n"
+	errStdinJSON      = "Некоректна задача на стандартному вході: %v"
+	errStdinShape     = "Задача має містити хоча б одну альтернативу й рядок outcomes для кожної (альтернатив: %d, рядків: %d)"
+	errStdinDuplicate = "Альтернатива '%s' повторюється"
+	errStdinRow       = "Альтернатива '%s': %d значень, а станів %d"
+	errPasteEmpty     = "Вставлений блок не містить матриці: потрібні стовпець назв альтернатив, хоча б один стовпець значень і хоча б одна альтернатива"
+	errPasteDuplicate = "Альтернатива '%s' повторюється у вставленому блоці"
+	errPasteCell      = "Рядок %d, стовпець %d вставленого блоку: некоректне число '%s'"
+
+	gradeMissing     = "значення для '%s' відсутнє"
+	gradeWrongValue  = "'%s': очікувалося %.4f, отримано %.4f"
+	gradeMissingRank = "позиція %d відсутня"
+	gradeWrongRank   = "позиція %d: '%s' не на своєму місці"
 )
 
 type (
@@ -41,6 +67,8 @@ type (
 		statesCount  int
 		maxScore     int
 		outcomes     map[string][]float64
+		// sessionPath – файл для збереження незавершеного введення (порожній – не зберігати)
+		sessionPath string
 	}
 
 	// AltValue використовується для сортування альтернатив
@@ -64,97 +92,177 @@ func (ir *inputReader) readString(prompt string) (string, error) {
 	return strings.TrimSpace(input), nil
 }
 
+// errBack повертається під час читання відповіді, якщо користувач попросив
+// повернутися до попереднього питання
+var errBack = errors.New("повернення до попереднього питання")
+
+// isBack перевіряє, чи є відповідь командою повернення до попереднього питання
+func isBack(input string) bool {
+	return input == "<" || strings.EqualFold(input, "back") || strings.EqualFold(input, "назад")
+}
+
+// readAnswer зчитує відповідь на питання введення задачі, розпізнаючи команду повернення
+func (ir *inputReader) readAnswer(prompt string) (string, error) {
+	input, err := ir.readString(prompt)
+	if err == nil && isBack(input) {
+		return "", errBack
+	}
+	return input, err
+}
+
 func (ir *inputReader) readInt(prompt string) (int, error) {
-	str, err := ir.readString(prompt)
+	str, err := ir.readAnswer(prompt)
 	if err != nil {
 		return 0, err
 	}
 	return strconv.Atoi(str)
 }
 
-func (ir *inputReader) readValidatedFloat(prompt string, min, max float64) float64 {
+// readPositive запитує додатне ціле число, доки не буде введено коректне;
+// invalid виводиться після кожної некоректної відповіді
+func (ir *inputReader) readPositive(prompt, invalid string) (int, error) {
+	for {
+		value, err := ir.readInt(prompt)
+		var numErr *strconv.NumError
+		switch {
+		case err == nil && value > 0:
+			return value, nil
+		case err != nil && !errors.As(err, &numErr):
+			return 0, err
+		}
+		fmt.Println(invalid)
+	}
+}
+
+// readValidatedFloat запитує число з відрізка [min, max], доки не буде введено
+// коректне; помилка повертається лише для команди повернення або кінця введення
+func (ir *inputReader) readValidatedFloat(prompt string, min, max float64) (float64, error) {
 	for {
-		str, err := ir.readString(prompt)
+		str, err := ir.readAnswer(prompt)
 		if err != nil {
-			continue
+			return 0, err
 		}
-		val, err := strconv.ParseFloat(str, 64)
+		val, err := parseFloat(str)
 		if err == nil && val >= min && val <= max {
-			return val
+			return val, nil
 		}
-		fmt.Println(errInvalidValue)
+		fmt.Println(tr(errInvalidValue))
 	}
 }
 
-func newUncertainDecisionSystem(ir *inputReader) (*UncertainDecisionSystem, error) {
-	altCount, err := ir.readInt(promptAltCount)
-	if err != nil || altCount <= 0 {
-		return nil, fmt.Errorf(errInvalidCount, "альтернатив")
-	}
+func newUncertainDecisionSystem() *UncertainDecisionSystem {
+	return &UncertainDecisionSystem{outcomes: make(map[string][]float64)}
+}
 
-	alts := make([]string, altCount)
-	for i := range altCount {
-		name, _ := ir.readString(fmt.Sprintf(promptAltName, i+1))
-		alts[i] = name
+// answered повертає кількість питань інтерактивного введення, на які вже є
+// відповіді: кількість альтернатив, їх назви, кількість станів, система балів
+// і введені значення матриці
+func (u *UncertainDecisionSystem) answered() int {
+	if u.maxScore == 0 {
+		return 0
 	}
-
-	stCount, err := ir.readInt(promptStateCount)
-	if err != nil || stCount <= 0 {
-		return nil, fmt.Errorf(errInvalidCount, "зовнішніх умов")
+	n := len(u.alternatives) + 3
+	for _, alt := range u.alternatives {
+		n += len(u.outcomes[alt])
 	}
+	return n
+}
 
-	maxScore, err := ir.readInt(promptMaxScore)
-	if err != nil || maxScore <= 0 {
-		return nil, fmt.Errorf(errInvalidScore)
-	}
+// ReadProblem інтерактивно запитує задачу: альтернативи, кількість станів, систему
+// балів і матрицю корисності. Введення починається з першого питання без відповіді
+// (після відновлення сесії), відповідь '<' або back повертає до попереднього питання,
+// а після кожного значення матриці стан зберігається у файл сесії.
+func (u *UncertainDecisionSystem) ReadProblem(ir *inputReader) error {
+	return u.readProblem(ir, false)
+}
 
-	return &UncertainDecisionSystem{
-		alternatives: alts,
-		statesCount:  stCount,
-		maxScore:     maxScore,
-		outcomes:     make(map[string][]float64),
-	}, nil
+// ReadHeader запитує лише альтернативи, кількість станів і систему балів,
+// залишаючи матрицю для заповнення в іншому режимі (наприклад, -tui)
+func (u *UncertainDecisionSystem) ReadHeader(ir *inputReader) error {
+	return u.readProblem(ir, true)
 }
 
-func (u *UncertainDecisionSystem) CollectOutcomes(ir *inputReader) {
-	for _, alt := range u.alternatives {
-		fmt.Printf("\nВведіть значення корисності для альтернативи '%s':\n", alt)
-		values := make([]float64, u.statesCount)
+func (u *UncertainDecisionSystem) readProblem(ir *inputReader, headerOnly bool) error {
+	for pos := u.answered(); ; {
+		n := len(u.alternatives)
+		cell := pos - n - 3
+		var err error
+
+		switch {
+		case pos == 0:
+			var count int
+			count, err = ir.readPositive(tr(promptAltCount), fmt.Sprintf(tr(errInvalidCount), tr("альтернатив")))
+			if err == nil && count != n {
+				u.alternatives = make([]string, count)
+			}
+		case pos <= n:
+			u.alternatives[pos-1], err = ir.readAnswer(fmt.Sprintf(tr(promptAltName), pos))
+		case pos == n+1:
+			u.statesCount, err = ir.readPositive(tr(promptStateCount), fmt.Sprintf(tr(errInvalidCount), tr("зовнішніх умов")))
+		case pos == n+2:
+			u.maxScore, err = ir.readPositive(tr(promptMaxScore), tr(errInvalidScore))
+			if err == nil {
+				for _, alt := range u.alternatives {
+					u.outcomes[alt] = nil
+				}
+				u.saveSession()
+			}
+		case headerOnly && cell == 0:
+			return nil
+		case cell < n*u.statesCount:
+			alt, j := u.alternatives[cell/u.statesCount], cell%u.statesCount
+			if j == 0 {
+				fmt.Printf(tr("\nВведіть значення корисності для альтернативи '%s':\n"), alt)
+			}
+			var value float64
+			prompt := fmt.Sprintf(tr(promptStateValue), alt, j+1, u.maxScore)
+			if value, err = ir.readValidatedFloat(prompt, 1, float64(u.maxScore)); err == nil {
+				u.outcomes[alt] = append(u.outcomes[alt][:j], value)
+				u.saveSession()
+			}
+		default:
+			u.removeSession()
+			return nil
+		}
 
-		for j := range u.statesCount {
-			prompt := fmt.Sprintf(promptStateValue, alt, j+1, u.maxScore)
-			values[j] = ir.readValidatedFloat(prompt, 1, float64(u.maxScore))
+		switch {
+		case err == errBack:
+			pos = max(pos-1, 0)
+			u.unanswer(pos)
+		case err != nil:
+			return err
+		default:
+			pos++
 		}
+	}
+}
 
-		u.outcomes[alt] = values
+// unanswer скасовує відповідь на питання pos, якщо це значення матриці
+func (u *UncertainDecisionSystem) unanswer(pos int) {
+	cell := pos - len(u.alternatives) - 3
+	if cell < 0 {
+		return
 	}
+	alt := u.alternatives[cell/u.statesCount]
+	u.outcomes[alt] = u.outcomes[alt][:cell%u.statesCount]
 }
 
 func (u *UncertainDecisionSystem) PrintOutcomesMatrix() {
-	fmt.Println("\nМатриця корисності:")
-	fmt.Printf(headerFormat, "Альтернатива")
-	for j := range u.statesCount {
-		fmt.Printf(stateHeaderFormat, fmt.Sprintf("Стан %d", j+1))
-	}
-	fmt.Println()
+	fmt.Println(tr("\nМатриця корисності:"))
 
-	for _, alt := range u.alternatives {
-		fmt.Printf(headerFormat, alt)
-		for _, outcome := range u.outcomes[alt] {
-			fmt.Printf(scoreFormat, outcome)
+	// Найкраще значення кожного стану (нульовий жаль) виділяється кольором
+	regrets := u.RegretMatrix()
+	RenderTable(os.Stdout, u.matrixTable("Матриця корисності", u.outcomes, payoff), func(row, col int, cell string) string {
+		if row >= 0 && col > 0 && regrets[u.alternatives[row]][col-1] == 0 {
+			return accent(cell)
 		}
-		fmt.Println()
-	}
+		return cell
+	})
 }
 
-// CalculateSavage розраховує критерій Севіджа:
-// Для кожного стану знаходиться максимальне значення, після чого обчислюється "жалю"
-// як різниця між максимальним значенням і значенням для альтернативи.
-// Для кожної альтернативи береться максимальне значення жалю (мінімакс).
-func (u *UncertainDecisionSystem) CalculateSavage() map[string]float64 {
+// StateMaxima повертає максимальне значення корисності для кожного стану
+func (u *UncertainDecisionSystem) StateMaxima() []float64 {
 	maxOutcomes := make([]float64, u.statesCount)
-
-	// Знаходимо максимальне значення для кожного стану
 	for j := range u.statesCount {
 		maxVal := 0.0
 		for _, alt := range u.alternatives {
@@ -165,82 +273,401 @@ func (u *UncertainDecisionSystem) CalculateSavage() map[string]float64 {
 		}
 		maxOutcomes[j] = maxVal
 	}
+	return maxOutcomes
+}
 
-	// Обчислюємо жалю для кожної альтернативи та знаходимо максимальне (найгірше)
-	savage := make(map[string]float64)
+// relativeRegret вмикає відносний жаль у відсотках від найкращого значення стану
+// (прапорець -relative-regret) – для станів з дуже різними масштабами корисності
+var relativeRegret bool
+
+// regret повертає жаль значення outcome за максимуму стану maxOutcome: різницю
+// max − u або, з -relative-regret, (max − u) / max · 100 %. Якщо максимум
+// стану нульовий, відсоток не визначений, і жаль залишається різницею.
+func regret(maxOutcome, outcome float64) float64 {
+	diff := sub(maxOutcome, outcome)
+	if !relativeRegret || maxOutcome == 0 {
+		return diff
+	}
+	if exact {
+		r := new(big.Rat).Sub(rat(maxOutcome), rat(outcome))
+		r.Quo(r, new(big.Rat).Abs(rat(maxOutcome)))
+		return ratFloat(r.Mul(r, big.NewRat(100, 1)))
+	}
+	return diff / math.Abs(maxOutcome) * 100
+}
+
+// regretTitle і savageLabel – підписи матриці жалю та значень критерію Севіджа
+func regretTitle() string {
+	if relativeRegret {
+		return "Матриця відносного жалю, %"
+	}
+	return "Матриця жалю"
+}
+
+func savageLabel() string {
+	if relativeRegret {
+		return "Макс. жалю, %"
+	}
+	return "Макс. жалю"
+}
+
+// RegretMatrix будує матрицю жалю: для кожного стану знаходиться максимальне значення,
+// після чого "жаль" обчислюється як різниця між ним і значенням для альтернативи
+// (або як відсоток від максимуму, див. regret).
+func (u *UncertainDecisionSystem) RegretMatrix() map[string][]float64 {
+	maxOutcomes := u.StateMaxima()
+
+	regrets := make(map[string][]float64)
 	for _, alt := range u.alternatives {
-		maxRegret := 0.0
+		regrets[alt] = make([]float64, u.statesCount)
 		for j, outcome := range u.outcomes[alt] {
-			regret := maxOutcomes[j] - outcome
-			if regret > maxRegret {
-				maxRegret = regret
-			}
+			regrets[alt][j] = regret(maxOutcomes[j], outcome)
 		}
-		savage[alt] = maxRegret
+	}
+	return regrets
+}
+
+// CalculateSavage розраховує критерій Севіджа:
+// для кожної альтернативи береться максимальне значення жалю (мінімакс).
+func (u *UncertainDecisionSystem) CalculateSavage() map[string]float64 {
+	savage := make(map[string]float64)
+	maxOutcomes := u.StateMaxima()
+	for _, alt := range u.alternatives {
+		savage[alt] = u.maxRegret(alt, maxOutcomes)
 	}
 	return savage
 }
 
+// maxRegret повертає найбільший жаль альтернативи за відомих максимумів станів
+func (u *UncertainDecisionSystem) maxRegret(alt string, maxOutcomes []float64) float64 {
+	maxRegret := 0.0
+	for j, outcome := range u.outcomes[alt] {
+		if r := regret(maxOutcomes[j], outcome); r > maxRegret {
+			maxRegret = r
+		}
+	}
+	return maxRegret
+}
+
 // CalculateLaplace розраховує критерій Лапласа для кожної альтернативи
 // як середнє значення по всіх станах (припускаючи, що всі стани рівноймовірні)
 func (u *UncertainDecisionSystem) CalculateLaplace() map[string]float64 {
 	laplace := make(map[string]float64)
 	for _, alt := range u.alternatives {
-		sum := 0.0
-		for _, outcome := range u.outcomes[alt] {
-			sum += outcome
-		}
-
-		avg := sum / float64(u.statesCount)
-		laplace[alt] = avg
+		laplace[alt] = u.mean(alt)
 	}
 	return laplace
 }
 
+// mean повертає середню корисність альтернативи за всіма станами
+func (u *UncertainDecisionSystem) mean(alt string) float64 {
+	if exact {
+		return ratFloat(meanRat(u.outcomes[alt]))
+	}
+	sum := 0.0
+	for _, outcome := range u.outcomes[alt] {
+		sum += outcome
+	}
+	return sum / float64(u.statesCount)
+}
+
+// Results будує звіт і журнал обчислень для поточних значень критеріїв
+func (u *UncertainDecisionSystem) Results(savage, laplace map[string]float64, variant string) (*Report, *Trace) {
+	sortedSev, sortedLaplace := sortAltValues(savage, true), sortAltValues(laplace, false)
+
+	trace := u.Trace()
+	trace.AddRanking("savage", sortedSev)
+	trace.AddRanking("laplace", sortedLaplace)
+
+	report := &Report{Title: tr(reportTitle), Variant: variant, DecimalSep: decimalSep}
+	report.Add(u.matrixTable("Матриця корисності", u.outcomes, payoff))
+	report.Add(u.matrixTable(regretTitle(), u.RegretMatrix(), regretPayoff))
+	report.Add(RankingTable("Севіджа", sortedSev, savageLabel(), regretPayoff))
+	report.Add(RankingTable("Лапласа", sortedLaplace, "Середня корисність", payoff))
+	report.Conclusions = []string{
+		fmt.Sprintf(tr("За критерієм Севіджа оптимальна альтернатива – %s (максимальний жаль %.4f)"),
+			sortedSev[0].alt, regretPayoff(sortedSev[0].value)),
+		fmt.Sprintf(tr("За критерієм Лапласа оптимальна альтернатива – %s (середня корисність %.4f)"),
+			sortedLaplace[0].alt, payoff(sortedLaplace[0].value)),
+	}
+	report.Analysis = Summary(sortedSev, sortedLaplace)
+	return report, trace
+}
+
 func sortAltValues(data map[string]float64, ascending bool) []AltValue {
 	arr := make([]AltValue, 0, len(data))
 	for alt, val := range data {
 		arr = append(arr, AltValue{alt, val})
 	}
 	// Для Севіджа (жалю) менше значення – краще; для Лапласа – більше значення – краще.
-	if ascending {
-		sort.Slice(arr, func(i, j int) bool {
-			return arr[i].value < arr[j].value
-		})
-	} else {
-		sort.Slice(arr, func(i, j int) bool {
-			return arr[i].value > arr[j].value
-		})
-	}
+	// Рівноцінні альтернативи впорядковуються за назвою, щоб ранжування не залежало
+	// від порядку обходу map і його можна було порівнювати між запусками.
+	sort.Slice(arr, func(i, j int) bool {
+		if arr[i].value != arr[j].value {
+			return (arr[i].value < arr[j].value) == ascending
+		}
+		return arr[i].alt < arr[j].alt
+	})
 	return arr
 }
 
-func PrintRanking(title string, altValues []AltValue, valueLabel string) {
-	fmt.Printf(promptCriterionResults, title)
-	fmt.Printf(resultRankFormat, "Ранг", "Альтернатива", valueLabel)
+func PrintRanking(title string, altValues []AltValue, valueLabel string, value func(float64) amount) {
+	fmt.Printf(tr(promptCriterionResults), tr(title))
+	RenderTable(os.Stdout, RankingTable(title, altValues, valueLabel, value), func(row, col int, cell string) string {
+		if row >= 0 && altValues[row].value == altValues[0].value {
+			return highlight(cell)
+		}
+		return cell
+	})
+
+	labels, values := splitAltValues(altValues)
+	fmt.Println()
+	PrintBarChart(os.Stdout, labels, values, "%.4f", value, func(i int, s string) string {
+		if values[i] == values[0] {
+			return highlight(s)
+		}
+		return s
+	})
+}
+
+// splitAltValues розділяє ранжування на назви альтернатив і значення
+func splitAltValues(altValues []AltValue) ([]string, []float64) {
+	labels := make([]string, len(altValues))
+	values := make([]float64, len(altValues))
+	for i, item := range altValues {
+		labels[i], values[i] = item.alt, item.value
+	}
+	return labels, values
+}
+
+// OutcomesSeries повертає корисність кожної альтернативи за станами для радарної діаграми
+func (u *UncertainDecisionSystem) OutcomesSeries() (axes []string, series []Series) {
+	for j := range u.statesCount {
+		axes = append(axes, fmt.Sprintf(tr("Стан %d"), j+1))
+	}
+	for _, alt := range u.alternatives {
+		series = append(series, Series{Name: alt, Y: u.outcomes[alt]})
+	}
+	return axes, series
+}
+
+// WriteRegretHeatmap записує матрицю жалю як теплову карту:
+// альтернативи – рядки, стани – стовпці, найбільший жаль кожної альтернативи виділено
+func (u *UncertainDecisionSystem) WriteRegretHeatmap(w io.Writer) error {
+	states := make([]string, u.statesCount)
+	for j := range states {
+		states[j] = fmt.Sprintf(tr("Стан %d"), j+1)
+	}
+	regrets := u.RegretMatrix()
+	values := make([][]float64, len(u.alternatives))
+	for i, alt := range u.alternatives {
+		values[i] = regrets[alt]
+	}
+	return WriteHeatmapSVG(w, tr(regretTitle()+" (критерій Севіджа)"), u.alternatives, states, values)
+}
+
+// charts повертає SVG-діаграми ранжувань, теплову карту матриці жалю і, якщо станів щонайменше три, радарну діаграму корисності
+func (u *UncertainDecisionSystem) charts(sortedSev, sortedLaplace []AltValue) []exportTarget {
+	barChart := func(title string, altValues []AltValue, value func(float64) amount) func(w io.Writer) error {
+		labels, values := splitAltValues(altValues)
+		return func(w io.Writer) error {
+			return WriteBarChartSVG(w, fmt.Sprintf(tr("Ранжування за критерієм %s"), tr(title)), labels, values, value)
+		}
+	}
+	charts := []exportTarget{
+		{"ranking-savage.svg", barChart("Севіджа", sortedSev, regretPayoff)},
+		{"ranking-laplace.svg", barChart("Лапласа", sortedLaplace, payoff)},
+		{"regret-heatmap.svg", u.WriteRegretHeatmap},
+	}
+	if u.statesCount >= 3 {
+		axes, series := u.OutcomesSeries()
+		charts = append(charts, exportTarget{"radar.svg", func(w io.Writer) error {
+			return WriteRadarChartSVG(w, tr("Корисність альтернатив за станами"), axes, series)
+		}})
+	}
+	return charts
+}
+
+// matrixTable перетворює матрицю значень альтернатив за станами на таблицю для звіту
+// (значення форматуються функцією value – payoff або regretPayoff)
+func (u *UncertainDecisionSystem) matrixTable(title string, values map[string][]float64, value func(float64) amount) Table {
+	t := Table{Title: tr(title), Header: []string{tr("Альтернатива")}}
+	for j := range u.statesCount {
+		t.Header = append(t.Header, fmt.Sprintf(tr("Стан %d"), j+1))
+	}
+	for _, alt := range u.alternatives {
+		row := []string{alt}
+		for _, v := range values[alt] {
+			row = append(row, fmt.Sprintf("%.2f", value(v)))
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t
+}
+
+func RankingTable(title string, altValues []AltValue, valueLabel string, value func(float64) amount) Table {
+	t := Table{
+		Title:  fmt.Sprintf(tr("Ранжування за критерієм %s"), tr(title)),
+		Header: []string{tr("Ранг"), tr("Альтернатива"), tr(valueLabel)},
+	}
 	for i, item := range altValues {
-		fmt.Printf(resultItemFormat, i+1, item.alt, item.value)
+		t.Rows = append(t.Rows, []string{fmt.Sprint(i + 1), item.alt, fmt.Sprintf("%.4f", value(item.value))})
 	}
+	return t
 }
 
 func main() {
+	reportPath := flag.String("report", "", "зберегти HTML-звіт у вказаний файл")
+	mdPath := flag.String("md", "", "зберегти звіт у форматі Markdown у вказаний файл")
+	texPath := flag.String("tex", "", "зберегти таблиці звіту у форматі LaTeX у вказаний файл")
+	pdfPath := flag.String("pdf", "", "зберегти звіт у форматі PDF у вказаний файл")
+	fontPath := flag.String("font", "", "TrueType-шрифт із кирилицею для PDF-звіту")
+	variant := flag.String("variant", "", "номер варіанту для титульної сторінки звіту")
+	exampleName := flag.String("example", "", "використати вбудовану задачу (list – перелік задач)")
+	xlsxPath := flag.String("xlsx", "", "зчитати матрицю корисності з книги Excel")
+	sheet := flag.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	xlsxOut := flag.String("xlsx-out", "", "записати результати на аркуш книги Excel")
+	tracePath := flag.String("trace", "", "зберегти хід обчислень у форматі JSON у вказаний файл")
+	gradePath := flag.String("grade", "", "перевірити відповіді студента (JSON у форматі -trace)")
+	tolerance := flag.Float64("tolerance", 0.01, "допустиме відхилення значень під час перевірки")
+	chartsDir := flag.String("charts", "", "зберегти SVG-діаграми у вказаний каталог")
+	noColor := flag.Bool("no-color", false, "вимкнути кольорове виділення у терміналі")
+	dominance := flag.Bool("dominance", false, "перед застосуванням критеріїв виключити строго доміновані альтернативи")
+	whatIf := flag.Bool("whatif", false, "після розрахунку змінювати окремі значення матриці й бачити зміни ранжувань")
+	sessionPath := flag.String("session", defaultSessionFile, "файл для автозбереження незавершеного введення (порожній рядок – вимкнути)")
+	repl := flag.Bool("repl", false, "після розрахунку приймати команди: перерахунок, додавання й видалення альтернатив і станів, експорт")
+	tuiMode := flag.Bool("tui", false, "редагувати матрицю в повноекранному режимі з живими значеннями критеріїв")
+	paste := flag.Bool("paste", false, "вставити матрицю, скопійовану з Excel або Google Sheets (клітинки через табуляцію)")
+	langFlag := flag.String("lang", "", "мова інтерфейсу: uk або en (за замовчуванням визначається з LANG)")
+	flag.IntVar(&precision, "precision", -1, "кількість знаків після коми в усіх таблицях і звітах (за замовчуванням 2 для матриць і 4 для критеріїв)")
+	rounding := flag.String("rounding", "half-up", "спосіб округлення: half-up (половина вгору) або half-even (банківське)")
+	localeFlag := flag.String("locale", "", "формат чисел у виводі: uk – десяткова кома, en – крапка (за замовчуванням з LC_NUMERIC/LANG)")
+	unitFlag := flag.String("unit", "", "одиниця виграшів у таблицях і звітах: UAH, USD, EUR, %, points або довільна позначка")
+	flag.BoolVar(&relativeRegret, "relative-regret", false, "обчислювати жаль у відсотках від найкращого значення стану, а не як різницю")
+	flag.BoolVar(&exact, "exact", false, "обчислювати жаль і середні значення в точних раціональних числах")
+	flag.BoolVar(&explain, "explain", false, "виводити проміжні обчислення покроково")
+	summary := flag.Bool("summary", false, "вивести текстове обґрунтування рекомендацій критеріїв (воно також входить у звіти)")
+	flag.BoolVar(&tableBorders, "box", false, "виводити таблиці в рамках із псевдографіки")
+	stdinJSON := flag.Bool("stdin-json", false, "зчитати задачу в JSON (як поле inputs журналу -trace) зі стандартного входу й вивести журнал обчислень у JSON без підказок")
+	flag.Parse()
+	setupColor(*noColor)
+	setupLang(*langFlag)
+	setupLocale(*localeFlag)
+	setupUnit(*unitFlag)
+	if err := setupRounding(*rounding); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if *stdinJSON {
+		if err := runStdinJSON(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	ir := newInputReader()
-	u, err := newUncertainDecisionSystem(ir)
+	var u *UncertainDecisionSystem
+	var err error
+	switch {
+	case *exampleName == "list":
+		PrintExamples()
+		return
+	case *exampleName != "":
+		u, err = loadExample(*exampleName)
+	case *xlsxPath != "":
+		u, err = loadXLSX(*xlsxPath, *sheet)
+	case *paste:
+		u, err = ReadPaste(ir)
+	case *tuiMode:
+		u = newUncertainDecisionSystem()
+		fmt.Print(tr(promptBackHint))
+		err = u.ReadHeader(ir)
+	default:
+		if u = resumeSession(ir, *sessionPath); u == nil {
+			u = newUncertainDecisionSystem()
+		}
+		u.sessionPath = *sessionPath
+		fmt.Print(tr(promptBackHint))
+		err = u.ReadProblem(ir)
+	}
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
 
-	u.CollectOutcomes(ir)
-	u.PrintOutcomesMatrix()
+	if *tuiMode {
+		ok, err := u.EditMatrixTUI()
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if !ok {
+			fmt.Println(tr(errTUICancelled))
+			return
+		}
+	}
+	if !*paste {
+		// Вставлену матрицю вже показано для підтвердження
+		u.PrintOutcomesMatrix()
+	}
+	if *dominance {
+		u.RemoveDominated()
+	}
 
 	// Розрахунок критерію Севіджа (мінімізація максимальної жалю)
+	if explain {
+		u.ExplainSavage()
+	}
 	savage := u.CalculateSavage()
 	sortedSev := sortAltValues(savage, true) // Нижче значення жалю – краще
-	PrintRanking("Севіджа", sortedSev, "Макс. жалю")
+	PrintRanking("Севіджа", sortedSev, savageLabel(), regretPayoff)
 
 	// Розрахунок критерію Лапласа (середнє значення корисності)
+	if explain {
+		u.ExplainLaplace()
+	}
 	laplace := u.CalculateLaplace()
 	sortedLaplace := sortAltValues(laplace, false) // Вище середнє значення – краще
-	PrintRanking("Лапласа", sortedLaplace, "Середня корисність")
+	PrintRanking("Лапласа", sortedLaplace, "Середня корисність", payoff)
+
+	report, trace := u.Results(savage, laplace, *variant)
+	if *summary {
+		printAnalysis(report)
+	}
+
+	if *gradePath != "" {
+		answer, err := loadTrace(*gradePath)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		PrintGrade(GradeTrace(trace, answer, *tolerance))
+	}
+
+	if *chartsDir != "" {
+		fmt.Println()
+		exportCharts(*chartsDir, u.charts(sortedSev, sortedLaplace))
+	}
+
+	exportReport([]exportTarget{
+		{*reportPath, report.WriteHTML},
+		{*mdPath, report.WriteMarkdown},
+		{*texPath, report.WriteLaTeX},
+		{*pdfPath, func(w io.Writer) error { return report.WritePDF(w, *fontPath) }},
+		{*tracePath, trace.WriteJSON},
+	})
+
+	if *xlsxOut != "" {
+		if err := report.SaveXLSX(*xlsxOut); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf(tr("\nРезультати записано на аркуш '%s' книги %s\n"), xlsxResultsSheet, *xlsxOut)
+	}
+
+	if *whatIf || *repl {
+		u.RunREPL(ir, savage, laplace, *variant)
+	}
 }
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// runStdinJSON виконує режим -stdin-json для конвеєрів: задача у форматі поля
+// inputs журналу -trace читається зі стандартного входу, а повний журнал
+// обчислень з ранжуваннями записується у w без підказок і таблиць
+func runStdinJSON(r io.Reader, w io.Writer) error {
+	var in traceInputs
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&in); err != nil {
+		return fmt.Errorf(tr(errStdinJSON), err)
+	}
+	u, err := in.system()
+	if err != nil {
+		return err
+	}
+	_, trace := u.Results(u.CalculateSavage(), u.CalculateLaplace(), "")
+	return trace.WriteJSON(w)
+}
+
+// system будує задачу з вхідних даних журналу; кількість станів і максимальний
+// бал, якщо їх не задано, визначаються з матриці, як під час зчитування з Excel
+func (in traceInputs) system() (*UncertainDecisionSystem, error) {
+	if len(in.Alternatives) == 0 || len(in.Outcomes) != len(in.Alternatives) {
+		return nil, fmt.Errorf(tr(errStdinShape), len(in.Alternatives), len(in.Outcomes))
+	}
+	if in.States == 0 {
+		in.States = len(in.Outcomes[0])
+	}
+
+	u := &UncertainDecisionSystem{
+		alternatives: in.Alternatives,
+		statesCount:  in.States,
+		maxScore:     in.MaxScore,
+		outcomes:     make(map[string][]float64),
+	}
+	maxVal := 0.0
+	for i, alt := range in.Alternatives {
+		if _, ok := u.outcomes[alt]; ok {
+			return nil, fmt.Errorf(tr(errStdinDuplicate), alt)
+		}
+		if len(in.Outcomes[i]) != in.States || in.States == 0 {
+			return nil, fmt.Errorf(tr(errStdinRow), alt, len(in.Outcomes[i]), in.States)
+		}
+		u.outcomes[alt] = in.Outcomes[i]
+		for _, v := range in.Outcomes[i] {
+			maxVal = math.Max(maxVal, v)
+		}
+	}
+	if u.maxScore == 0 {
+		u.maxScore = int(math.Ceil(maxVal))
+	}
+	return u, nil
+}
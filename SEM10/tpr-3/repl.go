@@ -0,0 +1,369 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	promptREPL = "\nКоманда (help – довідка, quit – вихід): "
+	replHelp   = `Команди:
+  set <альтернатива> <стан> <значення>   змінити одне значення матриці, наприклад: set A2 state3 7.5
+  rerun [критерій]                       перерахувати й вивести ранжування (savage або laplace),
+                                         наприклад: rerun laplace
+  add alt "<назва>"                      додати альтернативу та ввести її значення корисності
+  add state                              додати стан і ввести значення корисності всіх альтернатив
+  remove alt "<назва>"                   видалити альтернативу
+  remove state <стан>                    видалити стан, наприклад: remove state 2
+                                         Після додавання чи видалення критерії перераховуються,
+                                         а зміна порядку решти альтернатив позначається як rank reversal
+  export <формат> <файл>                 зберегти результати: json, md, html, tex або xlsx
+  help                                   ця довідка
+  quit                                   завершити роботу`
+
+	errREPLCommand      = "Невідома команда '%s', введіть help для довідки"
+	errREPLQuote        = "Незакриті лапки в команді"
+	errREPLCriterion    = "Невідомий критерій '%s', доступні: %s"
+	errREPLAddSyntax    = "Використання: add alt \"<назва>\" або add state"
+	errREPLRemoveSyntax = "Використання: remove alt \"<назва>\" або remove state <стан>"
+	errREPLLastAlt      = "У задачі має залишитися хоча б одна альтернатива"
+	errREPLLastState    = "У задачі має залишитися хоча б один стан"
+	errREPLAltExists    = "Альтернатива '%s' вже є в задачі"
+	errREPLExport       = "Використання: export <формат> <файл>"
+	errREPLFormat       = "Невідомий формат '%s', доступні: json, md, html, tex, xlsx"
+)
+
+// splitCommand розбиває команду на слова; слова в подвійних лапках
+// (наприклад, назви альтернатив із пробілами) залишаються цілими
+func splitCommand(line string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		quoted  bool
+		started bool
+	)
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			started = true
+		case unicode.IsSpace(r) && !quoted:
+			if started {
+				words = append(words, word.String())
+				word.Reset()
+				started = false
+			}
+		default:
+			word.WriteRune(r)
+			started = true
+		}
+	}
+	if quoted {
+		return nil, errors.New(tr(errREPLQuote))
+	}
+	if started {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// Rerun перераховує обидва критерії й виводить ранжування за вказаним
+// критерієм (savage або laplace) або за обома, якщо критерій не задано
+func (u *UncertainDecisionSystem) Rerun(savage, laplace map[string]float64, args []string) error {
+	showSavage, showLaplace := true, true
+	switch {
+	case len(args) == 0:
+	case len(args) == 1 && (strings.EqualFold(args[0], "savage") || strings.EqualFold(args[0], tr("Севіджа"))):
+		showLaplace = false
+	case len(args) == 1 && (strings.EqualFold(args[0], "laplace") || strings.EqualFold(args[0], tr("Лапласа"))):
+		showSavage = false
+	default:
+		return fmt.Errorf(tr(errREPLCriterion), strings.Join(args, " "), "savage, laplace")
+	}
+
+	u.recalculate(savage, laplace)
+	if showSavage {
+		PrintRanking("Севіджа", sortAltValues(savage, true), savageLabel(), regretPayoff)
+	}
+	if showLaplace {
+		PrintRanking("Лапласа", sortAltValues(laplace, false), "Середня корисність", payoff)
+	}
+	return nil
+}
+
+// recalculate оновлює значення обох критеріїв для всіх альтернатив
+func (u *UncertainDecisionSystem) recalculate(savage, laplace map[string]float64) {
+	maps.Copy(savage, u.CalculateSavage())
+	maps.Copy(laplace, u.CalculateLaplace())
+}
+
+// AddAlternative запитує значення корисності нової альтернативи для всіх станів
+// і додає її до задачі; '<' повертає до попереднього стану
+func (u *UncertainDecisionSystem) AddAlternative(ir *inputReader, name string) error {
+	if slices.Contains(u.alternatives, name) {
+		return fmt.Errorf(tr(errREPLAltExists), name)
+	}
+
+	fmt.Printf(tr("\nВведіть значення корисності для альтернативи '%s':\n"), name)
+	values := make([]float64, 0, u.statesCount)
+	for len(values) < u.statesCount {
+		prompt := fmt.Sprintf(tr(promptStateValue), name, len(values)+1, u.maxScore)
+		v, err := ir.readValidatedFloat(prompt, 1, float64(u.maxScore))
+		switch {
+		case err == errBack:
+			values = values[:max(len(values)-1, 0)]
+		case err != nil:
+			return err
+		default:
+			values = append(values, v)
+		}
+	}
+
+	u.alternatives = append(u.alternatives, name)
+	u.outcomes[name] = values
+	return nil
+}
+
+// AddState запитує значення корисності всіх альтернатив за новим станом
+// і додає його останнім; '<' повертає до попередньої альтернативи
+func (u *UncertainDecisionSystem) AddState(ir *inputReader) error {
+	j := u.statesCount + 1
+	fmt.Printf(tr("\nВведіть значення корисності альтернатив при стані %d:\n"), j)
+	values := make([]float64, 0, len(u.alternatives))
+	for len(values) < len(u.alternatives) {
+		prompt := fmt.Sprintf(tr(promptStateValue), u.alternatives[len(values)], j, u.maxScore)
+		v, err := ir.readValidatedFloat(prompt, 1, float64(u.maxScore))
+		switch {
+		case err == errBack:
+			values = values[:max(len(values)-1, 0)]
+		case err != nil:
+			return err
+		default:
+			values = append(values, v)
+		}
+	}
+
+	for i, alt := range u.alternatives {
+		u.outcomes[alt] = append(u.outcomes[alt], values[i])
+	}
+	u.statesCount++
+	return nil
+}
+
+// RemoveAlternative видаляє альтернативу разом з її значеннями критеріїв
+func (u *UncertainDecisionSystem) RemoveAlternative(savage, laplace map[string]float64, name string) error {
+	i := slices.Index(u.alternatives, name)
+	if i < 0 {
+		return fmt.Errorf(tr(errWhatIfAlt), name)
+	}
+	if len(u.alternatives) == 1 {
+		return errors.New(tr(errREPLLastAlt))
+	}
+	u.alternatives = slices.Delete(u.alternatives, i, i+1)
+	delete(u.outcomes, name)
+	delete(savage, name)
+	delete(laplace, name)
+	return nil
+}
+
+// RemoveState видаляє стан (номер у вигляді "2", "state2" або "стан2")
+func (u *UncertainDecisionSystem) RemoveState(token string) error {
+	j, err := u.parseState(token)
+	if err != nil {
+		return err
+	}
+	if u.statesCount == 1 {
+		return errors.New(tr(errREPLLastState))
+	}
+	for _, alt := range u.alternatives {
+		u.outcomes[alt] = slices.Delete(u.outcomes[alt], j, j+1)
+	}
+	u.statesCount--
+	return nil
+}
+
+// rankReversals повертає пари альтернатив, присутніх в обох ранжуваннях,
+// відносний порядок яких змінився
+func rankReversals(before, after []string) [][2]string {
+	pos := make(map[string]int, len(after))
+	for i, alt := range after {
+		pos[alt] = i
+	}
+	var common []string
+	for _, alt := range before {
+		if _, ok := pos[alt]; ok {
+			common = append(common, alt)
+		}
+	}
+	var out [][2]string
+	for i, a := range common {
+		for _, b := range common[i+1:] {
+			if pos[a] > pos[b] {
+				out = append(out, [2]string{a, b})
+			}
+		}
+	}
+	return out
+}
+
+// printReversals виводить зміну ранжування й пари альтернатив, що помінялися місцями
+func printReversals(name string, before, after []string) {
+	printRankingChange(name, before, after)
+	reversals := rankReversals(before, after)
+	if len(reversals) == 0 {
+		return
+	}
+	pairs := make([]string, len(reversals))
+	for i, p := range reversals {
+		pairs[i] = fmt.Sprintf("%s ≻ %s → %s ≻ %s", p[0], p[1], p[1], p[0])
+	}
+	fmt.Println(highlight(fmt.Sprintf(tr("    зміна порядку (rank reversal): %s"), strings.Join(pairs, "; "))))
+}
+
+// changeProblem застосовує зміну задачі (додавання чи видалення альтернативи
+// або стану), перераховує критерії й показує, як змінилися ранжування
+func (u *UncertainDecisionSystem) changeProblem(savage, laplace map[string]float64, change func() error) error {
+	sevBefore, _ := splitAltValues(sortAltValues(savage, true))
+	lapBefore, _ := splitAltValues(sortAltValues(laplace, false))
+	if err := change(); err != nil {
+		return err
+	}
+	u.recalculate(savage, laplace)
+	sevAfter, _ := splitAltValues(sortAltValues(savage, true))
+	lapAfter, _ := splitAltValues(sortAltValues(laplace, false))
+	printReversals("Севіджа", sevBefore, sevAfter)
+	printReversals("Лапласа", lapBefore, lapAfter)
+	return nil
+}
+
+// Add обробляє команду add: alt "<назва>" або state
+func (u *UncertainDecisionSystem) Add(ir *inputReader, savage, laplace map[string]float64, args []string) error {
+	switch {
+	case len(args) == 2 && args[0] == "alt" && args[1] != "":
+		if slices.Contains(u.alternatives, args[1]) {
+			return fmt.Errorf(tr(errREPLAltExists), args[1])
+		}
+		return u.changeProblem(savage, laplace, func() error {
+			if err := u.AddAlternative(ir, args[1]); err != nil {
+				return err
+			}
+			fmt.Printf(tr("Альтернативу '%s' додано\n"), args[1])
+			return nil
+		})
+	case len(args) == 1 && args[0] == "state":
+		return u.changeProblem(savage, laplace, func() error {
+			if err := u.AddState(ir); err != nil {
+				return err
+			}
+			fmt.Printf(tr("Стан %d додано\n"), u.statesCount)
+			return nil
+		})
+	}
+	return errors.New(tr(errREPLAddSyntax))
+}
+
+// Remove обробляє команду remove: alt "<назва>" або state <стан>
+func (u *UncertainDecisionSystem) Remove(savage, laplace map[string]float64, args []string) error {
+	if len(args) != 2 || args[1] == "" {
+		return errors.New(tr(errREPLRemoveSyntax))
+	}
+	switch args[0] {
+	case "alt":
+		return u.changeProblem(savage, laplace, func() error {
+			if err := u.RemoveAlternative(savage, laplace, args[1]); err != nil {
+				return err
+			}
+			fmt.Printf(tr("Альтернативу '%s' видалено\n"), args[1])
+			return nil
+		})
+	case "state":
+		return u.changeProblem(savage, laplace, func() error {
+			if err := u.RemoveState(args[1]); err != nil {
+				return err
+			}
+			fmt.Printf(tr("Стан %s видалено, наступні стани перенумеровано\n"), args[1])
+			return nil
+		})
+	}
+	return errors.New(tr(errREPLRemoveSyntax))
+}
+
+// Export зберігає поточні результати у файл вказаного формату
+func (u *UncertainDecisionSystem) Export(savage, laplace map[string]float64, variant string, args []string) error {
+	if len(args) != 2 {
+		return errors.New(tr(errREPLExport))
+	}
+	format, path := strings.ToLower(args[0]), args[1]
+	report, trace := u.Results(savage, laplace, variant)
+
+	var write func(w io.Writer) error
+	switch format {
+	case "json":
+		write = trace.WriteJSON
+	case "md":
+		write = report.WriteMarkdown
+	case "html":
+		write = report.WriteHTML
+	case "tex":
+		write = report.WriteLaTeX
+	case "xlsx":
+		if err := report.SaveXLSX(path); err != nil {
+			return err
+		}
+		fmt.Printf(tr("Результати записано на аркуш '%s' книги %s\n"), xlsxResultsSheet, path)
+		return nil
+	default:
+		return fmt.Errorf(tr(errREPLFormat), format)
+	}
+	if err := saveFile(path, write); err != nil {
+		return err
+	}
+	fmt.Printf(tr("Звіт збережено у файл %s\n"), path)
+	return nil
+}
+
+// RunREPL після основного розрахунку приймає команди, щоб виконати кілька
+// аналізів на тих самих даних без повторного введення задачі
+func (u *UncertainDecisionSystem) RunREPL(ir *inputReader, savage, laplace map[string]float64, variant string) {
+	for {
+		line, err := ir.readString(tr(promptREPL))
+		if err != nil {
+			return
+		}
+		words, err := splitCommand(line)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		if len(words) == 0 {
+			continue
+		}
+
+		switch cmd, args := words[0], words[1:]; cmd {
+		case "set":
+			err = u.SetOutcome(savage, laplace, args)
+		case "rerun":
+			err = u.Rerun(savage, laplace, args)
+		case "add":
+			err = u.Add(ir, savage, laplace, args)
+		case "remove":
+			err = u.Remove(savage, laplace, args)
+		case "export":
+			err = u.Export(savage, laplace, variant, args)
+		case "help":
+			fmt.Println(tr(replHelp))
+		case "quit", "exit":
+			return
+		default:
+			err = fmt.Errorf(tr(errREPLCommand), cmd)
+		}
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultSessionFile = ".tpr-3-session.json"
+
+// session – незавершене інтерактивне введення задачі: заголовок задачі
+// та вже введені рядки матриці корисності (останній може бути неповним)
+type session struct {
+	Saved        time.Time   `json:"saved"`
+	Alternatives []string    `json:"alternatives"`
+	States       int         `json:"states"`
+	MaxScore     int         `json:"max_score"`
+	Outcomes     [][]float64 `json:"outcomes"`
+}
+
+// filled повертає кількість уже введених значень матриці
+func (s *session) filled() int {
+	n := 0
+	for _, row := range s.Outcomes {
+		n += len(row)
+	}
+	return n
+}
+
+func (s *session) valid() bool {
+	if len(s.Alternatives) == 0 || s.States <= 0 || s.MaxScore <= 0 || len(s.Outcomes) > len(s.Alternatives) {
+		return false
+	}
+	for _, row := range s.Outcomes {
+		if len(row) > s.States {
+			return false
+		}
+	}
+	return true
+}
+
+// saveSession зберігає стан введення у файл сесії; файл спершу записується
+// поруч під тимчасовою назвою і лише потім замінює попередній, щоб
+// переривання під час запису не зіпсувало вже збережені дані
+func (u *UncertainDecisionSystem) saveSession() {
+	if u.sessionPath == "" {
+		return
+	}
+	s := session{
+		Saved:        time.Now(),
+		Alternatives: u.alternatives,
+		States:       u.statesCount,
+		MaxScore:     u.maxScore,
+	}
+	for _, alt := range u.alternatives {
+		row, ok := u.outcomes[alt]
+		if !ok {
+			break
+		}
+		s.Outcomes = append(s.Outcomes, row)
+	}
+
+	data, err := json.Marshal(s)
+	if err == nil {
+		tmp := u.sessionPath + ".tmp"
+		if err = os.WriteFile(tmp, data, 0o644); err == nil {
+			err = os.Rename(tmp, u.sessionPath)
+		}
+	}
+	if err != nil {
+		fmt.Printf(tr(errSessionSave), err)
+	}
+}
+
+// removeSession видаляє файл сесії після завершення введення
+func (u *UncertainDecisionSystem) removeSession() {
+	if u.sessionPath == "" {
+		return
+	}
+	if err := os.Remove(u.sessionPath); err != nil && !os.IsNotExist(err) {
+		fmt.Println(err)
+	}
+}
+
+func loadSession(path string) (*session, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s session
+	if err := json.Unmarshal(data, &s); err != nil || !s.valid() {
+		return nil, fmt.Errorf(tr(errSessionFormat), path)
+	}
+	return &s, nil
+}
+
+// resumeSession пропонує продовжити незавершене введення з файлу сесії.
+// Повертає nil, якщо сесії немає або користувач відмовився.
+func resumeSession(ir *inputReader, path string) *UncertainDecisionSystem {
+	if path == "" {
+		return nil
+	}
+	s, err := loadSession(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			fmt.Println(err)
+		}
+		return nil
+	}
+
+	answer, _ := ir.readString(fmt.Sprintf(tr(promptResume),
+		s.Saved.Format("02.01.2006 15:04"), strings.Join(s.Alternatives, ", "),
+		s.filled(), len(s.Alternatives)*s.States))
+	switch strings.ToLower(answer) {
+	case "т", "так", "y", "yes":
+	default:
+		return nil
+	}
+
+	u := &UncertainDecisionSystem{
+		alternatives: s.Alternatives,
+		statesCount:  s.States,
+		maxScore:     s.MaxScore,
+		outcomes:     make(map[string][]float64),
+	}
+	for i, row := range s.Outcomes {
+		u.outcomes[s.Alternatives[i]] = row
+	}
+	return u
+}
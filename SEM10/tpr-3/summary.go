@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Шаблони обґрунтування рекомендацій; значення підставляються з обчислених критеріїв
+const (
+	summarySavage  = "За критерієм Севіджа оптимальною є альтернатива %s, оскільки її максимальний жаль – найбільша втрата порівняно з найкращим рішенням за котрогось зі станів – становить %.2f і є найменшим серед альтернатив. Так обирає особа, що прагне мінімізувати можливе розчарування."
+	summaryLaplace = "За критерієм Лапласа оптимальною є альтернатива %s: за рівноймовірних станів її середня корисність %.2f найбільша. Критерій доречний, коли немає підстав вважати якийсь стан імовірнішим."
+	summaryTie     = "За критерієм %s рівноцінні альтернативи %s зі значенням %.2f."
+	summaryRunner  = " Найближча альтернатива %s поступається на %.2f."
+	summaryAgree   = "Обидва критерії рекомендують альтернативу %s, тож вибір стійкий до того, чи зважати на можливий жаль, чи на середній результат."
+	summaryDiffer  = "Критерії дають різні рекомендації (Севіджа – %s; Лапласа – %s): перший мінімізує найбільший жаль, другий – максимізує середній результат, тому вибір залежить від того, що важливіше для особи, що приймає рішення."
+)
+
+// printAnalysis виводить розділ аналізу результатів звіту в термінал
+func printAnalysis(r *Report) {
+	fmt.Printf("\n%s:\n", r.AnalysisTitle())
+	for _, p := range r.Analysis {
+		fmt.Println(p)
+	}
+}
+
+// Summary будує текстове обґрунтування рекомендацій критеріїв Севіджа й Лапласа
+// та висновок щодо їх узгодженості – заготовку розділу аналізу результатів у звіті.
+// Ранжування відсортовано від найкращої альтернативи.
+func Summary(sortedSev, sortedLaplace []AltValue) []string {
+	var out []string
+	var picks []string
+	for _, c := range []struct {
+		title, text string
+		sorted      []AltValue
+		value       func(float64) amount
+	}{
+		{"Севіджа", summarySavage, sortedSev, regretPayoff},
+		{"Лапласа", summaryLaplace, sortedLaplace, payoff},
+	} {
+		top := c.sorted[0].value
+		var best []string
+		for _, a := range c.sorted {
+			if a.value == top {
+				best = append(best, a.alt)
+			}
+		}
+		name := strings.Join(best, ", ")
+		picks = append(picks, name)
+
+		s := fmt.Sprintf(tr(c.text), name, c.value(top))
+		if len(best) > 1 {
+			s = fmt.Sprintf(tr(summaryTie), tr(c.title), name, c.value(top))
+		}
+		if len(best) < len(c.sorted) {
+			runner := c.sorted[len(best)]
+			diff := runner.value - top
+			if diff < 0 {
+				diff = -diff
+			}
+			s += fmt.Sprintf(tr(summaryRunner), runner.alt, c.value(diff))
+		}
+		out = append(out, s)
+	}
+
+	if picks[0] == picks[1] {
+		return append(out, fmt.Sprintf(tr(summaryAgree), picks[0]))
+	}
+	return append(out, fmt.Sprintf(tr(summaryDiffer), picks[0], picks[1]))
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// tableBorders вмикає рамки з символів псевдографіки Unicode (прапорець -box)
+var tableBorders bool
+
+// cellStyle дозволяє виділити клітинку (row == -1 – заголовок) після вирівнювання,
+// щоб керуючі послідовності кольору не впливали на ширину стовпців
+type cellStyle func(row, col int, cell string) string
+
+// padCell доповнює текст пробілами до заданої ширини на екрані: ширина
+// вимірюється у знакомісцях терміналу, а не в байтах чи рунах, тому
+// комбіновані та широкі символи не зсувають стовпці
+func padCell(s string, width int, right bool) string {
+	gap := strings.Repeat(" ", max(0, width-runewidth.StringWidth(s)))
+	if right {
+		return gap + s
+	}
+	return s + gap
+}
+
+// RenderTable виводить таблицю з вирівнюванням стовпців за шириною на екрані;
+// числові стовпці вирівнюються праворуч
+func RenderTable(w io.Writer, t Table, style cellStyle) {
+	widths := make([]int, len(t.Header))
+	right := make([]bool, len(t.Header))
+	for j, h := range t.Header {
+		widths[j] = runewidth.StringWidth(h)
+		for _, row := range t.Rows {
+			if j < len(row) {
+				widths[j] = max(widths[j], runewidth.StringWidth(row[j]))
+			}
+		}
+		right[j] = t.numericColumn(j)
+	}
+
+	line := func(left, mid, end string) {
+		if !tableBorders {
+			return
+		}
+		parts := make([]string, len(widths))
+		for j, width := range widths {
+			parts[j] = strings.Repeat("─", width+2)
+		}
+		fmt.Fprintln(w, left+strings.Join(parts, mid)+end)
+	}
+
+	printRow := func(row int, cells []string) {
+		parts := make([]string, len(widths))
+		for j := range widths {
+			cell := ""
+			if j < len(cells) {
+				cell = cells[j]
+			}
+			parts[j] = padCell(cell, widths[j], right[j])
+			if style != nil {
+				parts[j] = style(row, j, parts[j])
+			}
+		}
+		if tableBorders {
+			fmt.Fprintln(w, "│ "+strings.Join(parts, " │ ")+" │")
+		} else {
+			fmt.Fprintln(w, strings.TrimRight(strings.Join(parts, "   "), " "))
+		}
+	}
+
+	line("┌", "┬", "┐")
+	printRow(-1, t.Header)
+	line("├", "┼", "┤")
+	for i, row := range t.Rows {
+		printRow(i, row)
+	}
+	line("└", "┴", "┘")
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Структури журналу обчислень для експорту в JSON: вхідні дані, кроки
+// з формулами та операндами кожної підстановки і підсумкові ранжування
+type (
+	Trace struct {
+		Task     string         `json:"task"`
+		Inputs   traceInputs    `json:"inputs"`
+		Steps    []traceStep    `json:"steps"`
+		Rankings []traceRanking `json:"rankings"`
+	}
+
+	traceInputs struct {
+		Alternatives []string    `json:"alternatives"`
+		States       int         `json:"states"`
+		MaxScore     int         `json:"max_score"`
+		Outcomes     [][]float64 `json:"outcomes"`
+	}
+
+	traceStep struct {
+		Name    string       `json:"name"`
+		Formula string       `json:"formula"`
+		Entries []traceEntry `json:"entries"`
+	}
+
+	// traceEntry – одна підстановка; State (з 1) задається для кроків за станами
+	traceEntry struct {
+		Alternative string    `json:"alternative,omitempty"`
+		State       int       `json:"state,omitempty"`
+		Expression  string    `json:"expression"`
+		Operands    []float64 `json:"operands"`
+		Result      float64   `json:"result"`
+	}
+
+	traceRanking struct {
+		Criterion string    `json:"criterion"`
+		Order     []string  `json:"order"`
+		Values    []float64 `json:"values"`
+		Best      []string  `json:"best"`
+	}
+)
+
+// key ідентифікує підстановку в межах кроку для порівняння відповідей
+func (e traceEntry) key() string {
+	switch {
+	case e.Alternative == "":
+		return fmt.Sprintf("стан %d", e.State)
+	case e.State > 0:
+		return fmt.Sprintf("%s, стан %d", e.Alternative, e.State)
+	}
+	return e.Alternative
+}
+
+// Trace збирає повний хід розрахунку критеріїв Севіджа і Лапласа:
+// максимуми станів, матрицю жалю, максимальний жаль і середню корисність
+func (u *UncertainDecisionSystem) Trace() *Trace {
+	t := &Trace{
+		Task: reportTitle,
+		Inputs: traceInputs{
+			Alternatives: u.alternatives,
+			States:       u.statesCount,
+			MaxScore:     u.maxScore,
+		},
+	}
+	for _, alt := range u.alternatives {
+		t.Inputs.Outcomes = append(t.Inputs.Outcomes, u.outcomes[alt])
+	}
+
+	maxima := traceStep{Name: "state_maxima", Formula: "max_a u(a, j)"}
+	maxOutcomes := u.StateMaxima()
+	for j, m := range maxOutcomes {
+		column := u.stateColumn(j)
+		maxima.Entries = append(maxima.Entries, traceEntry{
+			State:      j + 1,
+			Expression: fmt.Sprintf("max(%s)", joinValues(column)),
+			Operands:   column,
+			Result:     m,
+		})
+	}
+
+	regret := traceStep{Name: "regret", Formula: "r(a, j) = max_a u(a, j) − u(a, j)"}
+	regretExpr := func(maxOutcome, outcome float64) string { return fmt.Sprintf("%.2f − %.2f", maxOutcome, outcome) }
+	if relativeRegret {
+		regret.Formula = "r(a, j) = (max_a u(a, j) − u(a, j)) / max_a u(a, j) · 100"
+		regretExpr = func(maxOutcome, outcome float64) string {
+			return fmt.Sprintf("(%.2f − %.2f) / %.2f · 100", maxOutcome, outcome, maxOutcome)
+		}
+	}
+	savage := traceStep{Name: "savage", Formula: "S(a) = max_j r(a, j)"}
+	laplace := traceStep{Name: "laplace", Formula: "L(a) = Σ_j u(a, j) / n"}
+	regrets := u.RegretMatrix()
+	savageValues := u.CalculateSavage()
+	laplaceValues := u.CalculateLaplace()
+	for _, alt := range u.alternatives {
+		for j, outcome := range u.outcomes[alt] {
+			regret.Entries = append(regret.Entries, traceEntry{
+				Alternative: alt,
+				State:       j + 1,
+				Expression:  regretExpr(maxOutcomes[j], outcome),
+				Operands:    []float64{maxOutcomes[j], outcome},
+				Result:      regrets[alt][j],
+			})
+		}
+		savage.Entries = append(savage.Entries, traceEntry{
+			Alternative: alt,
+			Expression:  fmt.Sprintf("max(%s)", joinValues(regrets[alt])),
+			Operands:    regrets[alt],
+			Result:      savageValues[alt],
+		})
+		laplace.Entries = append(laplace.Entries, traceEntry{
+			Alternative: alt,
+			Expression:  fmt.Sprintf("(%s) / %d", joinValues(u.outcomes[alt]), u.statesCount),
+			Operands:    u.outcomes[alt],
+			Result:      laplaceValues[alt],
+		})
+	}
+	t.Steps = []traceStep{maxima, regret, savage, laplace}
+	return t
+}
+
+// AddRanking додає до журналу ранжування за критерієм; найкращими вважаються
+// всі альтернативи зі значенням, рівним першому
+func (t *Trace) AddRanking(criterion string, altValues []AltValue) {
+	labels, values := splitAltValues(altValues)
+	r := traceRanking{Criterion: criterion, Order: labels, Values: values}
+	for i, v := range values {
+		if v == values[0] {
+			r.Best = append(r.Best, labels[i])
+		}
+	}
+	t.Rankings = append(t.Rankings, r)
+}
+
+func (t *Trace) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
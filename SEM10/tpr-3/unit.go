@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unit – одиниця виміру виграшів (прапорець -unit): UAH, USD, EUR, %, points
+// або довільна позначка; порожній рядок – числа без одиниці й роздільника тисяч
+var unit string
+
+// currencySymbols – символи валют, що ставляться перед числом в англійському
+// форматі та після числа в українському
+var currencySymbols = map[string]string{"UAH": "₴", "USD": "$", "EUR": "€"}
+
+// nbsp – нерозривний пробіл між групами розрядів і перед одиницею, щоб
+// значення не розривалося в HTML і PDF
+const nbsp = "\u00a0"
+
+// setupUnit запам'ятовує одиницю виграшів; назви валют і points не залежать від регістру
+func setupUnit(flagValue string) {
+	unit = strings.TrimSpace(flagValue)
+	switch strings.ToUpper(unit) {
+	case "UAH", "ГРН", "₴":
+		unit = "UAH"
+	case "USD", "$":
+		unit = "USD"
+	case "EUR", "€":
+		unit = "EUR"
+	case "POINTS", "PTS", "БАЛИ":
+		unit = "points"
+	}
+}
+
+// unitSymbol повертає позначку одиниці u у виведених числах
+func unitSymbol(u string) string {
+	if s, ok := currencySymbols[u]; ok {
+		return s
+	}
+	if u == "points" {
+		return tr("бал.")
+	}
+	return u
+}
+
+// groupSep повертає роздільник тисяч: нерозривний пробіл для десяткової коми, кома – для крапки
+func groupSep() string {
+	if decimalSep == "," {
+		return nbsp
+	}
+	return ","
+}
+
+// groupThousands розділяє цілу частину числа s (з десятковою крапкою) на групи
+// по три розряди й замінює десяткову крапку роздільником локалі
+func groupThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, frac, hasFrac := strings.Cut(s, ".")
+	var b strings.Builder
+	for i, d := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(groupSep())
+		}
+		b.WriteRune(d)
+	}
+	if hasFrac {
+		b.WriteString(decimalSep + frac)
+	}
+	return sign + b.String()
+}
+
+// withUnit додає до числа s позначку одиниці u: символ валюти стоїть перед
+// числом в англійському форматі (-$1,200.00) і після нього в українському (1 200,00 $)
+func withUnit(s, u string) string {
+	symbol := unitSymbol(u)
+	_, currency := currencySymbols[u]
+	switch {
+	case currency && decimalSep == ".":
+		if rest, ok := strings.CutPrefix(s, "-"); ok {
+			return "-" + symbol + rest
+		}
+		return symbol + s
+	case u == "%" && decimalSep == ".":
+		return s + "%"
+	default:
+		return s + nbsp + symbol
+	}
+}
+
+// amount – значення у вимірі виграшу: форматується як num, але з -unit
+// отримує роздільник тисяч і позначку одиниці
+type amount struct {
+	value float64
+	unit  string
+}
+
+// payoff позначає значення корисності, середньої корисності або абсолютного жалю
+func payoff(v float64) amount {
+	return amount{v, unit}
+}
+
+// regretPayoff позначає значення жалю: з -relative-regret це відсотки, а не одиниці виграшу
+func regretPayoff(v float64) amount {
+	if relativeRegret && unit != "" {
+		return amount{v, "%"}
+	}
+	return payoff(v)
+}
+
+func (a amount) Format(f fmt.State, verb rune) {
+	if a.unit == "" {
+		num(a.value).Format(f, verb)
+		return
+	}
+	padFormatted(f, withUnit(groupThousands(roundDecimal(a.value, formatPrecision(f))), a.unit))
+}
+
+// parseAmount розбирає число, виведене через amount: відкидає позначку
+// одиниці та роздільники тисяч, тому стовпці з одиницями лишаються числовими
+func parseAmount(s string) (float64, error) {
+	if unit != "" {
+		r := strings.NewReplacer(unitSymbol(unit), "", unitSymbol("%"), "", nbsp, "")
+		s = r.Replace(s)
+		if decimalSep == "." {
+			s = strings.ReplaceAll(s, ",", "")
+		}
+	}
+	return parseFloat(s)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	errWhatIfSyntax = "Використання: set <альтернатива> <стан> <значення>"
+	errWhatIfAlt    = "Невідома альтернатива '%s'"
+	errWhatIfState  = "Некоректний стан '%s': потрібен номер від 1 до %d (наприклад, 3 або state3)"
+	errWhatIfValue  = "Некоректне значення '%s': потрібне число від 1 до %d"
+)
+
+// parseState приймає номер стану у вигляді "3", "state3" або "стан3" і повертає індекс з нуля
+func (u *UncertainDecisionSystem) parseState(token string) (int, error) {
+	digits := strings.TrimLeftFunc(token, func(r rune) bool { return !unicode.IsDigit(r) })
+	j, err := strconv.Atoi(digits)
+	if err != nil || j < 1 || j > u.statesCount {
+		return 0, fmt.Errorf(tr(errWhatIfState), token, u.statesCount)
+	}
+	return j - 1, nil
+}
+
+// SetOutcome змінює значення корисності альтернативи при стані та перераховує лише
+// залежні значення: середнє (Лаплас) – тільки для цієї альтернативи; максимальний жаль
+// (Севідж) – для всіх альтернатив, якщо змінився максимум стовпця, інакше теж лише для неї
+func (u *UncertainDecisionSystem) SetOutcome(savage, laplace map[string]float64, args []string) error {
+	if len(args) < 3 {
+		return errors.New(tr(errWhatIfSyntax))
+	}
+	// Назва альтернативи може містити пробіли, тому стан і значення беруться з кінця
+	alt := strings.Join(args[:len(args)-2], " ")
+	if !slices.Contains(u.alternatives, alt) {
+		return fmt.Errorf(tr(errWhatIfAlt), alt)
+	}
+	j, err := u.parseState(args[len(args)-2])
+	if err != nil {
+		return err
+	}
+	raw := args[len(args)-1]
+	value, err := parseFloat(raw)
+	if err != nil || value < 1 || value > float64(u.maxScore) {
+		return fmt.Errorf(tr(errWhatIfValue), raw, u.maxScore)
+	}
+
+	sevBefore, _ := splitAltValues(sortAltValues(savage, true))
+	lapBefore, _ := splitAltValues(sortAltValues(laplace, false))
+	oldMax := u.StateMaxima()[j]
+
+	old := u.outcomes[alt][j]
+	u.outcomes[alt][j] = value
+	fmt.Printf(tr("u(%s, стан %d): %.2f → %.2f\n"), alt, j+1, payoff(old), payoff(value))
+
+	laplace[alt] = u.mean(alt)
+	maxOutcomes := u.StateMaxima()
+	if maxOutcomes[j] != oldMax {
+		fmt.Printf(tr("Максимум стану %d змінився (%.2f → %.2f): жаль перераховано для всіх альтернатив\n"),
+			j+1, payoff(oldMax), payoff(maxOutcomes[j]))
+		for _, a := range u.alternatives {
+			savage[a] = u.maxRegret(a, maxOutcomes)
+		}
+	} else {
+		savage[alt] = u.maxRegret(alt, maxOutcomes)
+	}
+	fmt.Printf(tr("Перераховано %s: Севіджа %.4f, Лапласа %.4f\n"), alt, regretPayoff(savage[alt]), payoff(laplace[alt]))
+
+	sevAfter, _ := splitAltValues(sortAltValues(savage, true))
+	lapAfter, _ := splitAltValues(sortAltValues(laplace, false))
+	printRankingChange("Севіджа", sevBefore, sevAfter)
+	printRankingChange("Лапласа", lapBefore, lapAfter)
+	return nil
+}
+
+func printRankingChange(name string, before, after []string) {
+	name = tr(name)
+	if slices.Equal(before, after) {
+		fmt.Printf(tr("  %s: ранжування без змін\n"), name)
+		return
+	}
+	fmt.Println(highlight(fmt.Sprintf("  %s: %s → %s",
+		name, strings.Join(before, " ≻ "), strings.Join(after, " ≻ "))))
+}
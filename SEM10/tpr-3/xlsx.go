@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const xlsxResultsSheet = "Результати"
+
+// loadXLSX зчитує матрицю корисності з аркуша Excel: перший рядок – заголовки станів,
+// далі в кожному рядку назва альтернативи та її значення за станами.
+// Якщо аркуш не вказано, використовується перший аркуш книги.
+func loadXLSX(path, sheet string) (*UncertainDecisionSystem, error) {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 || len(rows[0]) < 2 {
+		return nil, fmt.Errorf(tr(errXLSXEmpty), sheet)
+	}
+
+	u := &UncertainDecisionSystem{
+		statesCount: len(rows[0]) - 1,
+		outcomes:    make(map[string][]float64),
+	}
+	maxVal := 0.0
+	for i, row := range rows[1:] {
+		if len(row) == 0 || row[0] == "" {
+			continue
+		}
+		alt := row[0]
+		if _, ok := u.outcomes[alt]; ok {
+			return nil, fmt.Errorf(tr(errXLSXDuplicate), alt)
+		}
+
+		values := make([]float64, u.statesCount)
+		for j := range values {
+			cell := ""
+			if j+1 < len(row) {
+				cell = row[j+1]
+			}
+			v, err := parseFloat(cell)
+			if err != nil {
+				name, _ := excelize.CoordinatesToCellName(j+2, i+2)
+				return nil, fmt.Errorf(tr(errXLSXCell), sheet, name, cell)
+			}
+			values[j] = v
+			maxVal = math.Max(maxVal, v)
+		}
+
+		u.alternatives = append(u.alternatives, alt)
+		u.outcomes[alt] = values
+	}
+	if len(u.alternatives) == 0 {
+		return nil, fmt.Errorf(tr(errXLSXEmpty), sheet)
+	}
+
+	if u.maxScore, err = scoreScale(maxVal); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// SaveXLSX записує таблиці звіту на аркуш результатів. Якщо файл уже існує
+// (наприклад, це книга з вхідними даними), аркуш результатів у ньому замінюється,
+// а решта аркушів залишається без змін.
+func (r *Report) SaveXLSX(path string) error {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		f = excelize.NewFile()
+	}
+	defer f.Close()
+
+	if idx, _ := f.GetSheetIndex(xlsxResultsSheet); idx >= 0 {
+		if err := f.DeleteSheet(xlsxResultsSheet); err != nil {
+			return err
+		}
+	}
+	idx, err := f.NewSheet(xlsxResultsSheet)
+	if err != nil {
+		return err
+	}
+	f.SetActiveSheet(idx)
+	// Порожній аркуш за замовчуванням нової книги не потрібен
+	if sheet := "Sheet1"; f.GetSheetName(0) == sheet && len(f.GetSheetList()) > 1 {
+		if rows, _ := f.GetRows(sheet); len(rows) == 0 {
+			f.DeleteSheet(sheet)
+		}
+	}
+
+	styles := make(map[string]int)
+	line := 1
+	for _, t := range r.Tables {
+		if err := f.SetCellValue(xlsxResultsSheet, fmt.Sprintf("A%d", line), t.Title); err != nil {
+			return err
+		}
+		line++
+
+		rows := append([][]string{t.Header}, t.Rows...)
+		for _, row := range rows {
+			for j, cell := range row {
+				name, _ := excelize.CoordinatesToCellName(j+1, line)
+				var value any = cell
+				if v, err := parseAmount(cell); err == nil {
+					value = v
+					if err := setAmountStyle(f, styles, name, cell); err != nil {
+						return err
+					}
+				}
+				if err := f.SetCellValue(xlsxResultsSheet, name, value); err != nil {
+					return err
+				}
+			}
+			line++
+		}
+		line++
+	}
+
+	return f.SaveAs(path)
+}
+
+// setAmountStyle задає клітинці name числовий формат Excel з одиницею -unit і
+// тією ж кількістю знаків після коми, що й у тексті cell, щоб книга показувала
+// значення так само, як звіт, а клітинка лишалася числом. Стилі однакових
+// форматів повторно використовуються через styles.
+func setAmountStyle(f *excelize.File, styles map[string]int, name, cell string) error {
+	u := unit
+	switch {
+	case u == "":
+		return nil
+	case strings.Contains(cell, unitSymbol(u)):
+	case strings.Contains(cell, "%"):
+		u = "%"
+	default:
+		return nil
+	}
+	format := "#,##0"
+	if _, frac, ok := strings.Cut(cell, decimalSep); ok {
+		digits := strings.IndexFunc(frac, func(r rune) bool { return r < '0' || r > '9' })
+		if digits < 0 {
+			digits = len(frac)
+		}
+		if digits > 0 {
+			format += "." + strings.Repeat("0", digits)
+		}
+	}
+	// позначка в лапках, бо крапка в «бал.» інакше стала б десятковою;
+	// розташування таке саме, як у withUnit
+	symbol := `"` + unitSymbol(u) + `"`
+	_, currency := currencySymbols[u]
+	switch {
+	case currency && decimalSep == ".":
+		format = symbol + format
+	case u == "%" && decimalSep == ".":
+		format += symbol
+	default:
+		format += " " + symbol
+	}
+
+	id, ok := styles[format]
+	if !ok {
+		var err error
+		if id, err = f.NewStyle(&excelize.Style{CustomNumFmt: &format}); err != nil {
+			return err
+		}
+		styles[format] = id
+	}
+	return f.SetCellStyle(xlsxResultsSheet, name, name, id)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const barChartWidth = 40
+
+// barEighths – часткові блоки для дробової частини стовпця з точністю до 1/8 символу
+var barEighths = []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// bar повертає горизонтальний стовпець довжиною cells знакомісць
+func bar(cells float64) string {
+	eighths := int(math.Round(cells * 8))
+	return strings.Repeat("█", eighths/8) + barEighths[eighths%8]
+}
+
+// PrintBarChart виводить горизонтальну діаграму значень: довжина стовпців
+// пропорційна значенням, відлік ведеться від нуля (або від мінімуму, якщо є
+// від'ємні значення), тому різниця між альтернативами видно одразу.
+// Значення підписуються за форматом valueFormat; style дозволяє виділити
+// стовпець i, наприклад переможця.
+func PrintBarChart(w io.Writer, labels []string, values []float64, valueFormat string, style func(i int, s string) string) {
+	if len(values) == 0 {
+		return
+	}
+
+	lo, hi := 0.0, values[0]
+	labelWidth := 0
+	for i, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+		labelWidth = max(labelWidth, runewidth.StringWidth(labels[i]))
+	}
+	scale := 0.0
+	if hi > lo {
+		scale = barChartWidth / (hi - lo)
+	}
+
+	for i, v := range values {
+		b := padCell(bar((v-lo)*scale), barChartWidth, false)
+		if style != nil {
+			b = style(i, b)
+		}
+		fmt.Fprintf(w, "%s │%s "+valueFormat+"\n", padCell(labels[i], labelWidth, false), b, v)
+	}
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultSubmissionsFile = ".tpr-4-submissions.json"
+
+type (
+	// collection – збір ранжувань від експертів через вебформу: кожен експерт
+	// отримує окреме посилання з токеном, відповіді зберігаються у файл
+	collection struct {
+		Alternatives []string             `json:"alternatives"`
+		Experts      []string             `json:"experts"`
+		Tokens       map[string]string    `json:"tokens"`          // Tokens[токен] = експерт
+		Ranks        map[string][]int     `json:"ranks"`           // Ranks[експерт] – ранги в порядку альтернатив
+		Submitted    map[string]time.Time `json:"submitted"`       // Submitted[експерт] – час останньої відповіді
+		Chats        map[string]string    `json:"chats,omitempty"` // Chats[чат Telegram] = експерт
+
+		mu   sync.Mutex
+		path string
+		done chan struct{}
+	}
+
+	// formPage – дані сторінки форми ранжування для одного експерта
+	formPage struct {
+		Expert string
+		Alts   []string
+		Ranks  []int
+		Places []int
+		Error  string
+		Saved  bool
+	}
+)
+
+// splitList розбирає перелік назв через кому й перевіряє, що назви не повторюються
+func splitList(s string) ([]string, error) {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if slices.Contains(out, item) {
+			return nil, fmt.Errorf(tr(errCollectDuplicate), item)
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func newToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// openCollection відновлює збір із файлу відповідей, якщо він належить тій самій
+// задачі (альтернативи й експерти збігаються), інакше починає новий
+func openCollection(path string, alts, experts []string) (*collection, error) {
+	c := &collection{path: path, done: make(chan struct{})}
+	data, err := os.ReadFile(path)
+	if err == nil && json.Unmarshal(data, c) == nil &&
+		slices.Equal(c.Alternatives, alts) && slices.Equal(c.Experts, experts) {
+		fmt.Printf(tr("Продовжується збір відповідей з файлу %s\n"), path)
+	} else {
+		*c = collection{path: path, done: c.done, Alternatives: alts, Experts: experts,
+			Tokens: make(map[string]string), Ranks: make(map[string][]int), Submitted: make(map[string]time.Time)}
+		for _, e := range experts {
+			c.Tokens[newToken()] = e
+		}
+		if err := c.save(); err != nil {
+			return nil, err
+		}
+	}
+	if c.complete() {
+		close(c.done)
+	}
+	return c, nil
+}
+
+// save записує стан збору у файл через тимчасовий, як і файл сесії
+func (c *collection) save() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+func (c *collection) complete() bool {
+	return len(c.Ranks) == len(c.Experts)
+}
+
+// tokenOf повертає токен посилання експерта
+func (c *collection) tokenOf(expert string) string {
+	for t, e := range c.Tokens {
+		if e == expert {
+			return t
+		}
+	}
+	return ""
+}
+
+// paretoSystem перетворює зібрані відповіді на задачу для аналізу
+func (c *collection) paretoSystem() *ParetoSystem {
+	p := newParetoSystem()
+	p.alts, p.experts = c.Alternatives, c.Experts
+	for _, e := range c.Experts {
+		p.rankings[e] = make(map[string]int)
+		for i, a := range c.Alternatives {
+			p.rankings[e][a] = c.Ranks[e][i]
+		}
+	}
+	return p
+}
+
+var collectTemplates = template.Must(template.New("").Funcs(template.FuncMap{"tr": tr}).Parse(`
+{{define "head"}}<!DOCTYPE html>
+<html lang="uk">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{tr "Ранжування альтернатив"}}</title>
+<style>
+body { font-family: "Segoe UI", Arial, sans-serif; margin: 2em auto; max-width: 640px; padding: 0 1em; color: #222; }
+table { border-collapse: collapse; margin: .5em 0; }
+th, td { border: 1px solid #bbc; padding: .35em .8em; text-align: left; }
+th { background: #e8eaf2; }
+.error { color: #b00; }
+.saved { color: #262; }
+</style>
+</head>
+<body>
+{{end}}
+
+{{define "form"}}{{template "head"}}
+<h1>{{tr "Ранжування альтернатив"}}</h1>
+<p>{{tr "Експерт"}}: <b>{{.Expert}}</b></p>
+<p>{{tr "Поставте кожній альтернативі ранг: 1 – найкраща."}}</p>
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{if .Saved}}<p class="saved">{{tr "Дякуємо! Відповідь збережено. До завершення збору її можна змінити на цій сторінці."}}</p>{{end}}
+<form method="post">
+<table>
+<tr><th>{{tr "Альтернатива"}}</th><th>{{tr "Ранг"}}</th></tr>
+{{range $i, $a := .Alts}}<tr><td>{{$a}}</td><td><select name="rank{{$i}}">
+{{$cur := index $.Ranks $i}}{{range $.Places}}<option value="{{.}}"{{if eq . $cur}} selected{{end}}>{{.}}</option>{{end}}
+</select></td></tr>
+{{end}}</table>
+<p><button type="submit">{{tr "Надіслати"}}</button></p>
+</form>
+</body>
+</html>
+{{end}}
+
+{{define "status"}}{{template "head"}}
+<h1>{{tr "Збір ранжувань"}}</h1>
+<table>
+<tr><th>{{tr "Експерт"}}</th><th>{{tr "Відповідь"}}</th></tr>
+{{range .}}<tr><td>{{.Expert}}</td><td>{{if .Saved}}✓{{else}}—{{end}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+{{end}}
+`))
+
+// handleForm показує форму ранжування експерту за токеном із посилання
+// і зберігає надіслані ранги
+func (c *collection) handleForm(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expert, ok := c.Tokens[r.PathValue("token")]
+	if !ok {
+		http.Error(w, tr(errCollectToken), http.StatusNotFound)
+		return
+	}
+	n := len(c.Alternatives)
+	page := formPage{Expert: expert, Alts: c.Alternatives, Ranks: c.Ranks[expert], Places: make([]int, n)}
+	for i := range page.Places {
+		page.Places[i] = i + 1
+	}
+
+	if r.Method == http.MethodPost {
+		ranks, err := parseRanks(r, c.Alternatives)
+		switch {
+		case err != nil:
+			page.Error = err.Error()
+		case c.complete():
+			page.Error = tr(errCollectClosed)
+		default:
+			c.Ranks[expert], c.Submitted[expert] = ranks, time.Now()
+			if err := c.save(); err != nil {
+				fmt.Printf(tr(errSessionSave), err)
+			}
+			page.Ranks, page.Saved = ranks, true
+			fmt.Printf(tr("Отримано ранжування від експерта %s (%d з %d)\n"), expert, len(c.Ranks), len(c.Experts))
+			if c.complete() {
+				close(c.done)
+			}
+		}
+	}
+	if page.Ranks == nil {
+		page.Ranks = make([]int, n)
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	collectTemplates.ExecuteTemplate(w, "form", page)
+}
+
+// parseRanks зчитує ранги з полів форми rank0…rank{n-1}
+func parseRanks(r *http.Request, alts []string) ([]int, error) {
+	ranks := make([]int, len(alts))
+	for i, a := range alts {
+		v, err := strconv.Atoi(r.FormValue(fmt.Sprintf("rank%d", i)))
+		if err != nil || v < 1 || v > len(alts) {
+			return nil, fmt.Errorf(tr(errCollectRank), a, len(alts))
+		}
+		ranks[i] = v
+	}
+	return ranks, nil
+}
+
+// handleStatus показує, хто з експертів уже відповів (без посилань)
+func (c *collection) handleStatus(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	rows := make([]formPage, len(c.Experts))
+	for i, e := range c.Experts {
+		_, saved := c.Ranks[e]
+		rows[i] = formPage{Expert: e, Saved: saved}
+	}
+	c.mu.Unlock()
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	collectTemplates.ExecuteTemplate(w, "status", rows)
+}
+
+// collectRankings запускає вебсервер збору ранжувань, виводить посилання для
+// кожного експерта і повертає задачу, щойно відповіли всі експерти
+func collectRankings(addr, baseURL, storePath string, alts, experts []string) (*ParetoSystem, error) {
+	if len(alts) < 2 || len(experts) == 0 {
+		return nil, errors.New(tr(errCollectList))
+	}
+	c, err := openCollection(storePath, alts, experts)
+	if err != nil {
+		return nil, err
+	}
+
+	if baseURL == "" {
+		baseURL = "http://" + addr
+		if strings.HasPrefix(addr, ":") {
+			baseURL = "http://localhost" + addr
+		}
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	fmt.Printf(tr("\nЗбір ранжувань: %s (стан відповідей)\nПосилання для експертів:\n"), baseURL)
+	for _, e := range experts {
+		mark := ""
+		if _, ok := c.Ranks[e]; ok {
+			mark = tr(" (відповідь отримано)")
+		}
+		fmt.Printf("  %s: %s/e/%s%s\n", e, baseURL, c.tokenOf(e), mark)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /e/{token}", c.handleForm)
+	mux.HandleFunc("POST /e/{token}", c.handleForm)
+	mux.HandleFunc("GET /{$}", c.handleStatus)
+	srv := &http.Server{Addr: addr, Handler: mux}
+
+	errc := make(chan error, 1)
+	go func() { errc <- srv.ListenAndServe() }()
+	select {
+	case err := <-errc:
+		return nil, err
+	case <-c.done:
+	}
+
+	// Сервер зупиняється після того, як останній експерт отримав відповідь
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	srv.Shutdown(ctx)
+	fmt.Println(tr("\nУсі експерти відповіли, збір завершено"))
+	return c.paretoSystem(), nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// BordaScores повертає бали Борда кожної альтернативи: від кожного експерта
+// альтернатива з рангом r отримує n − r балів, де n – кількість альтернатив
+func (p *ParetoSystem) BordaScores() map[string]int {
+	scores := make(map[string]int, len(p.alts))
+	for _, e := range p.experts {
+		for _, a := range p.alts {
+			scores[a] += len(p.alts) - p.rankings[e][a]
+		}
+	}
+	return scores
+}
+
+// Concordance повертає коефіцієнт конкордації Кендалла W = 12·S / (m²·(n³ − n)),
+// де S – сума квадратів відхилень сум рангів альтернатив від їх середнього:
+// 1 – повна згода експертів, 0 – відсутність згоди
+func (p *ParetoSystem) Concordance() float64 {
+	n, m := float64(len(p.alts)), float64(len(p.experts))
+	if n < 2 || m == 0 {
+		return 1
+	}
+	mean := m * (n + 1) / 2
+	s := 0.0
+	for _, a := range p.alts {
+		sum := 0.0
+		for _, e := range p.experts {
+			sum += float64(p.rankings[e][a])
+		}
+		s += (sum - mean) * (sum - mean)
+	}
+	return 12 * s / (m * m * (n*n*n - n))
+}
+
+// BordaTable повертає альтернативи, впорядковані за спаданням балів Борда
+func (p *ParetoSystem) BordaTable() Table {
+	scores := p.BordaScores()
+	alts := append([]string(nil), p.alts...)
+	sort.SliceStable(alts, func(i, j int) bool { return scores[alts[i]] > scores[alts[j]] })
+
+	t := Table{Title: tr("Ранжування за правилом Борда"), Header: []string{"№", tr("Альтернатива"), tr("Бали")}}
+	for i, a := range alts {
+		t.Rows = append(t.Rows, []string{fmt.Sprint(i + 1), a, fmt.Sprint(scores[a])})
+	}
+	return t
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// example – вбудована задача з підручника для демонстрації без введення даних
+type example struct {
+	description  string
+	alternatives []string
+	experts      []string
+	ranks        [][]int // рядок – альтернатива, стовпець – ранг від експерта
+}
+
+var examples = map[string]example{
+	"farming": {
+		description:  "агрономи ранжують культури для посіву",
+		alternatives: []string{"Пшениця", "Кукурудза", "Соняшник", "Ячмінь"},
+		experts:      []string{"Агроном", "Економіст", "Технолог"},
+		ranks: [][]int{
+			{1, 2, 1},
+			{2, 1, 3},
+			{3, 3, 2},
+			{4, 4, 4},
+		},
+	},
+	"investment": {
+		description:  "експерти ранжують інвестиційні проєкти за прибутковістю, ризиком і терміном окупності",
+		alternatives: []string{"Проєкт А", "Проєкт Б", "Проєкт В", "Проєкт Г", "Проєкт Д"},
+		experts:      []string{"Прибутковість", "Ризик", "Окупність"},
+		ranks: [][]int{
+			{1, 4, 2},
+			{2, 2, 1},
+			{3, 3, 3},
+			{5, 1, 4},
+			{4, 5, 5},
+		},
+	},
+	"hiring": {
+		description:  "члени комісії ранжують кандидатів на посаду",
+		alternatives: []string{"Коваленко", "Шевчук", "Бондар", "Мельник"},
+		experts:      []string{"Керівник", "HR", "Тімлід"},
+		ranks: [][]int{
+			{2, 1, 2},
+			{1, 3, 1},
+			{3, 2, 4},
+			{4, 4, 3},
+		},
+	},
+}
+
+func exampleNames() []string {
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// PrintExamples виводить перелік вбудованих задач
+func PrintExamples() {
+	fmt.Println(tr("Вбудовані задачі (-example <назва>):"))
+	for _, name := range exampleNames() {
+		fmt.Printf("  %-12s %s\n", name, tr(examples[name].description))
+	}
+}
+
+// loadExample створює систему з вбудованої задачі
+func loadExample(name string) (*ParetoSystem, error) {
+	e, ok := examples[name]
+	if !ok {
+		return nil, fmt.Errorf(tr(errUnknownExample), name, strings.Join(exampleNames(), ", "))
+	}
+	p := &ParetoSystem{
+		alts:      e.alternatives,
+		experts:   e.experts,
+		rankings:  make(map[string]map[string]int),
+		dominance: make(map[string]map[string]bool),
+	}
+	for k, expert := range e.experts {
+		p.rankings[expert] = make(map[string]int)
+		for i, alt := range e.alternatives {
+			p.rankings[expert][alt] = e.ranks[i][k]
+		}
+	}
+	fmt.Printf(tr("Задача '%s': %s\n"), name, tr(e.description))
+	return p, nil
+}
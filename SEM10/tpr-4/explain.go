@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// explain вмикає покроковий вивід проміжних обчислень (прапорець -explain)
+var explain bool
+
+func explainf(format string, args ...any) {
+	if explain {
+		fmt.Printf(tr(format), args...)
+	}
+}
+
+// ExplainDominance виводить порівняння рангів кожної пари альтернатив усіма експертами
+// та висновок про домінування за Парето
+func (p *ParetoSystem) ExplainDominance() {
+	explainf("\nКрок 1. Попарне порівняння: a домінує над b, якщо жоден експерт не ставить a нижче b\n")
+	explainf("        і хоча б один ставить a вище (менший ранг – краще)\n")
+	for _, a1 := range p.alts {
+		for _, a2 := range p.alts {
+			if a1 == a2 {
+				continue
+			}
+			comparisons := make([]string, len(p.experts))
+			for k, e := range p.experts {
+				r1, r2 := p.rankings[e][a1], p.rankings[e][a2]
+				sign := "="
+				if r1 < r2 {
+					sign = "<"
+				} else if r1 > r2 {
+					sign = ">"
+				}
+				comparisons[k] = fmt.Sprintf("%s: %d %s %d", e, r1, sign, r2)
+			}
+			verdict := tr("не домінує")
+			if p.dominance[a1][a2] {
+				verdict = tr("домінує")
+			}
+			explainf("  %s проти %s: %s → %s\n", a1, a2, strings.Join(comparisons, "; "), verdict)
+		}
+	}
+
+	explainf("\nКрок 2. Множина Парето – альтернативи, над якими не домінує жодна інша\n")
+	for _, a := range p.alts {
+		var dominators []string
+		for _, b := range p.alts {
+			if p.dominance[b][a] {
+				dominators = append(dominators, b)
+			}
+		}
+		if len(dominators) == 0 {
+			explainf("  %s: не домінується → входить до множини Парето\n", a)
+		} else {
+			explainf("  %s: домінується (%s) → виключається\n", a, strings.Join(dominators, ", "))
+		}
+	}
+}
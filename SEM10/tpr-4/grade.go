@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// stepScore – результат перевірки одного кроку розв'язку
+type stepScore struct {
+	name     string
+	correct  int
+	total    int
+	mistakes []string
+}
+
+// loadTrace зчитує відповіді студента у форматі журналу обчислень (-trace)
+func loadTrace(path string) (*Trace, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var t Trace
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf(tr(errGradeFormat), path, err)
+	}
+	return &t, nil
+}
+
+// GradeTrace порівнює відповіді з еталонним розв'язком: кожна пара альтернатив
+// оцінюється за висновком про домінування, а кожна альтернатива – за тим,
+// чи правильно визначено її належність до множини Парето
+func GradeTrace(reference, answer *Trace) []stepScore {
+	dominance := stepScore{name: "dominance", total: len(reference.Comparisons)}
+	given := make(map[[2]string]bool)
+	for _, c := range answer.Comparisons {
+		given[[2]string{c.A, c.B}] = c.Dominates
+	}
+	for _, c := range reference.Comparisons {
+		d, ok := given[[2]string{c.A, c.B}]
+		switch {
+		case !ok:
+			dominance.mistakes = append(dominance.mistakes, fmt.Sprintf(tr(gradeMissingPair), c.A, c.B))
+		case d != c.Dominates:
+			dominance.mistakes = append(dominance.mistakes, fmt.Sprintf(tr(gradeWrongPair), c.A, c.B))
+		default:
+			dominance.correct++
+		}
+	}
+
+	pareto := stepScore{name: "pareto", total: len(reference.Inputs.Alternatives)}
+	inSet := func(set []string) map[string]bool {
+		m := make(map[string]bool)
+		for _, a := range set {
+			m[a] = true
+		}
+		return m
+	}
+	want, got := inSet(reference.Pareto), inSet(answer.Pareto)
+	for _, a := range reference.Inputs.Alternatives {
+		switch {
+		case want[a] && !got[a]:
+			pareto.mistakes = append(pareto.mistakes, fmt.Sprintf(tr(gradeParetoMissing), a))
+		case !want[a] && got[a]:
+			pareto.mistakes = append(pareto.mistakes, fmt.Sprintf(tr(gradeParetoExtra), a))
+		default:
+			pareto.correct++
+		}
+	}
+	return []stepScore{dominance, pareto}
+}
+
+// PrintGrade виводить бали за кожен крок, перелік помилок і загальну оцінку у відсотках
+func PrintGrade(scores []stepScore) {
+	fmt.Println(tr("\nРезультати перевірки розв'язку:"))
+	t := Table{Header: []string{tr("Крок"), tr("Правильно"), tr("Всього"), tr("Бал, %")}}
+	correct, total := 0, 0
+	for _, s := range scores {
+		t.Rows = append(t.Rows, []string{s.name, fmt.Sprint(s.correct), fmt.Sprint(s.total), percent(s.correct, s.total)})
+		correct += s.correct
+		total += s.total
+	}
+	RenderTable(os.Stdout, t, func(row, col int, cell string) string {
+		if row >= 0 && scores[row].correct == scores[row].total {
+			return highlight(cell)
+		}
+		return cell
+	})
+
+	for _, s := range scores {
+		for _, m := range s.mistakes {
+			fmt.Printf("  %s: %s\n", s.name, m)
+		}
+	}
+	fmt.Printf(tr("\nЗагальна оцінка: %d з %d (%s%%)\n"), correct, total, percent(correct, total))
+}
+
+func percent(part, total int) string {
+	if total == 0 {
+		return "100.0"
+	}
+	return fmt.Sprintf("%.1f", 100*float64(part)/float64(total))
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"os"
+	"strings"
+)
+
+// lang – мова інтерфейсу: "uk" (за замовчуванням) або "en"
+var lang = "uk"
+
+// setupLang обирає мову з прапорця -lang, а якщо його не задано – зі змінної
+// середовища LANG; українська лишається мовою за замовчуванням для C/POSIX
+func setupLang(flagValue string) {
+	if flagValue == "" {
+		flagValue = os.Getenv("LANG")
+		if flagValue == "" || flagValue == "C" || flagValue == "POSIX" || strings.HasPrefix(flagValue, "C.") {
+			flagValue = "uk"
+		}
+	}
+	lang = "en"
+	if strings.HasPrefix(strings.ToLower(flagValue), "uk") {
+		lang = "uk"
+	}
+}
+
+// tr повертає переклад повідомлення обраною мовою. Ключем каталогу є
+// український текст, тому повідомлення без перекладу виводяться як є.
+func tr(s string) string {
+	if lang == "en" {
+		if t, ok := catalogEN[s]; ok {
+			return t
+		}
+	}
+	return s
+}
+
+// catalogEN – англійські переклади підказок, заголовків таблиць і повідомлень про помилки
+var catalogEN = map[string]string{
+	// Введення задачі
+	promptAltCount:    "Enter the number of alternatives: ",
+	promptAltName:     "Enter the name of alternative %d: ",
+	promptExpertCount: "Enter the number of experts: ",
+	promptExpertName:  "Enter the name of expert %d: ",
+	promptRank:        "Rank of alternative '%s' by expert '%s' (1…%d): ",
+	promptBackHint:    "To return to the previous question and correct the answer, enter '<' or back.\n",
+	promptResume:      "Found unfinished input from %s (experts: %s; %d of %d ranks entered). Continue? (y/n): ",
+	"Невірне число, спробуйте ще раз.":       "Invalid number, please try again.",
+	"Ведіть число від 1 до %d.\n":            "Enter a number from 1 to %d.\n",
+	"\n--- Ранжування від експерта %s ---\n": "\n--- Ranking by expert %s ---\n",
+
+	// Результати
+	reportTitle: "Pareto set from expert rankings",
+	"\nТаблиця ранжувань (рядок – альтернатива, стовпці – експерти):": "\nRanking table (row – alternative, columns – experts):",
+	"\nМатриця домінування (1 – рядок домінує над стовпцем):":         "\nDominance matrix (1 – row dominates column):",
+	"\nКількість альтернатив, над якими домінує альтернатива:":        "\nNumber of alternatives dominated by each alternative:",
+	"\nМножина Парето оптимальних альтернатив:":                       "\nPareto set of optimal alternatives:",
+	"Таблиця ранжувань": "Ranking table",
+	"Матриця домінування (1 – рядок домінує над стовпцем)":  "Dominance matrix (1 – row dominates column)",
+	"Множина Парето оптимальних альтернатив":                "Pareto set of optimal alternatives",
+	"Кількість альтернатив, над якими домінує альтернатива": "Number of alternatives dominated by each alternative",
+	"Ранжування альтернатив експертами":                     "Rankings of alternatives by experts",
+	"Альтернатива":                                   "Alternative",
+	"Парето-оптимальні альтернативи: %s":             "Pareto-optimal alternatives: %s",
+	"\nЗвіт збережено у файл %s\n":                   "\nReport saved to %s\n",
+	"Діаграму збережено у файл %s\n":                 "Chart saved to %s\n",
+	"\nРезультати записано на аркуш '%s' книги %s\n": "\nResults written to sheet '%s' of workbook %s\n",
+	"Висновки":           "Conclusions",
+	"Аналіз результатів": "Analysis of the results",
+	summarySingle:        "Alternative %s is the only Pareto-optimal one: no other alternative dominates it, and it dominates %d of the %d other alternatives, so it can be recommended without extra assumptions about the experts' importance.",
+	summaryMultiple:      "The Pareto set contains alternatives %s: none of them is dominated by another alternative, so the final choice needs further reasoning, such as comparing rank sums.",
+	summaryExcluded:      "Alternative %s is excluded because it is dominated by %s: no expert ranks it higher, and at least one ranks it lower.",
+	summaryAllIn:         "No alternative dominates another: the experts' opinions differ too much to narrow the choice by Pareto.",
+	"Теорія прийняття рішень": "Decision theory",
+	"Варіант ": "Variant ",
+
+	// Покрокові пояснення
+	"\nКрок 1. Попарне порівняння: a домінує над b, якщо жоден експерт не ставить a нижче b\n": "\nStep 1. Pairwise comparison: a dominates b if no expert ranks a below b\n",
+	"        і хоча б один ставить a вище (менший ранг – краще)\n":                             "        and at least one ranks a above b (a lower rank is better)\n",
+	"  %s проти %s: %s → %s\n": "  %s vs %s: %s → %s\n",
+	"домінує":                  "dominates",
+	"не домінує":               "does not dominate",
+	"\nКрок 2. Множина Парето – альтернативи, над якими не домінує жодна інша\n": "\nStep 2. Pareto set – alternatives not dominated by any other\n",
+	"  %s: не домінується → входить до множини Парето\n":                         "  %s: not dominated → belongs to the Pareto set\n",
+	"  %s: домінується (%s) → виключається\n":                                    "  %s: dominated (%s) → excluded\n",
+
+	// Вбудовані задачі
+	"Вбудовані задачі (-example <назва>):":  "Built-in problems (-example <name>):",
+	"Задача '%s': %s\n":                     "Problem '%s': %s\n",
+	"агрономи ранжують культури для посіву": "agronomists rank crops for sowing",
+	"експерти ранжують інвестиційні проєкти за прибутковістю, ризиком і терміном окупності": "experts rank investment projects by profitability, risk and payback period",
+	"члени комісії ранжують кандидатів на посаду":                                           "committee members rank candidates for a position",
+
+	// Перевірка розв'язків
+	"\nРезультати перевірки розв'язку:": "\nSolution check results:",
+	"Крок":      "Step",
+	"Правильно": "Correct",
+	"Всього":    "Total",
+	"Бал, %":    "Score, %",
+	"\nЗагальна оцінка: %d з %d (%s%%)\n": "\nOverall score: %d of %d (%s%%)\n",
+	gradeMissingPair:   "pair (%s, %s) is missing",
+	gradeWrongPair:     "pair (%s, %s): wrong dominance conclusion",
+	gradeParetoMissing: "'%s' must belong to the Pareto set",
+	gradeParetoExtra:   "'%s' does not belong to the Pareto set",
+
+	// Помилки
+	errNoFont:         "No font with Cyrillic glyphs found for PDF, specify one with -font",
+	errXLSXEmpty:      "Sheet '%s' has no rankings: an expert row and at least one alternative are required",
+	errXLSXDuplicate:  "Alternative '%s' is repeated in the Excel workbook",
+	errXLSXCell:       "Sheet '%s', cell %s: invalid rank '%s' (an integer from 1 to %d is required)",
+	errUnknownExample: "Unknown problem '%s', available: %s",
+	errGradeFormat:    "Answer file %s does not match the calculation log format: %v",
+	errSessionFormat:  "Session file %s is corrupted, input will start from the beginning",
+	errSessionSave:    "Could not save the session: %v\n",
+	errStdinJSON:      "Invalid rankings on standard input: %v",
+	errInputClosed:    "Input ended before all data was received",
+	errStdinShape:     "Experts, alternatives and a ranks row for each alternative are required (experts: %d, alternatives: %d, rows: %d)",
+	errStdinDuplicate: "Alternative '%s' is repeated",
+	errStdinExpert:    "Expert '%s' is repeated",
+	errStdinRow:       "Alternative '%s': %d ranks, but there are %d experts",
+	errStdinRank:      "Alternative '%s', expert '%s': invalid rank %d (an integer from 1 to %d is required)",
+
+	// Збір ранжувань через вебформу
+	errSurveyMapping:    "Column mapping file %s: %v",
+	errSurveyColumn:     "The CSV has no column '%s'",
+	errSurveyEmpty:      "File %s contains no responses: a header row, at least two alternatives and one expert are required",
+	errSurveyExpert:     "Row %d: expert '%s' answered again",
+	errSurveyRank:       "Row %d, column '%s': invalid answer '%s' (a rank from 1 to %d is required)",
+	"Експерт %d":        "Expert %d",
+	errCollectList:      "To collect rankings, specify at least two alternatives (-alternatives) and the experts (-experts) separated by commas",
+	errCollectDuplicate: "'%s' is repeated in the list",
+	errCollectToken:     "The link is invalid",
+	errCollectRank:      "The rank of alternative '%s' must be an integer from 1 to %d",
+	errCollectClosed:    "Collection of answers is already finished",
+	"Продовжується збір відповідей з файлу %s\n":                         "Resuming collection of answers from %s\n",
+	"\nЗбір ранжувань: %s (стан відповідей)\nПосилання для експертів:\n": "\nCollecting rankings: %s (answer status)\nLinks for experts:\n",
+	" (відповідь отримано)":                                              " (answer received)",
+	"Отримано ранжування від експерта %s (%d з %d)\n":                    "Received ranking from expert %s (%d of %d)\n",
+	"\nУсі експерти відповіли, збір завершено":                           "\nAll experts have answered, collection finished",
+	"Ранжування альтернатив":                                             "Ranking of alternatives",
+	"Експерт": "Expert",
+	"Поставте кожній альтернативі ранг: 1 – найкраща.":                                    "Give each alternative a rank: 1 is the best.",
+	"Дякуємо! Відповідь збережено. До завершення збору її можна змінити на цій сторінці.": "Thank you! Your answer has been saved. You can change it on this page until the collection is finished.",
+	"Ранг":           "Rank",
+	"Надіслати":      "Submit",
+	"Збір ранжувань": "Collecting rankings",
+	"Відповідь":      "Answer",
+	"Ранжування за правилом Борда": "Borda count ranking",
+	"Бали": "Points",
+	"Коефіцієнт конкордації Кендалла W = %.3f": "Kendall's coefficient of concordance W = %.3f",
+
+	// Telegram-бот
+	errTelegramToken:    "For -telegram mode, set the bot token in the TPR_TELEGRAM_TOKEN environment variable",
+	errTelegramAPI:      "Telegram: method %s failed: %s",
+	errTelegramTaken:    "This link has already been used in another chat",
+	errTelegramLink:     "Open the bot with your personal link to begin ranking",
+	errTelegramChoice:   "Invalid alternative choice",
+	promptTelegramStart: "Send /start to begin ranking",
+	promptTelegramWho:   "Welcome, %s! Please rank the alternatives: %s.",
+	promptTelegramPlace: "%s, choose the alternative for place %d of %d (1 is the best):",
+	promptTelegramDone:  "Thank you! Your ranking: %s.\nTo change it before the collection is finished, send /start.",
+	"Експерт %s зареєструвався в боті\n":                        "Expert %s registered in the bot\n",
+	"\nБот @%s чекає на ранжування\nПосилання для експертів:\n": "\nBot @%s is waiting for rankings\nLinks for experts:\n",
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"tprtest"
+)
+
+// fuzzInput повертає читача введення з даних фазера; після них іде коректне значення valid,
+// щоб цикл повторного запиту завершився до кінця введення (на якому програма виходить)
+func fuzzInput(data, valid string) *inputReader {
+	return &inputReader{r: tprtest.Input(data, valid)}
+}
+
+func FuzzReadNumbers(f *testing.F) {
+	for _, s := range []string{"3", "0\n-1\n2", "abc", "<", "back", "9999999999999999999999", " 4 ", "\n\n"} {
+		f.Add(s)
+	}
+	tprtest.Silence(f)
+	f.Fuzz(func(t *testing.T, data string) {
+		// Коректна перша відповідь має бути прийнята без змін
+		want, err := strconv.Atoi(tprtest.FirstAnswer(data))
+		if err != nil {
+			want = 0
+		}
+
+		n, back := fuzzInput(data, "2").readInt("")
+		if !back && n <= 0 {
+			t.Fatalf("readInt повернув %d", n)
+		}
+		if want > 0 && (back || n != want) {
+			t.Fatalf("readInt повернув %d замість %d", n, want)
+		}
+
+		n, back = fuzzInput(data, "2").readRank("", 5)
+		if !back && (n < 1 || n > 5) {
+			t.Fatalf("readRank повернув %d поза [1, 5]", n)
+		}
+		if want >= 1 && want <= 5 && (back || n != want) {
+			t.Fatalf("readRank повернув %d замість %d", n, want)
+		}
+	})
+}
+
+func FuzzStdinJSON(f *testing.F) {
+	f.Add(`{"experts": ["E1", "E2"], "alternatives": ["A", "B", "C"], "ranks": [[1, 2], [2, 1], [3, 3]]}`)
+	f.Add(`{"experts": ["E1", "E1"], "alternatives": ["A", "B"], "ranks": [[1, 2], [2, 1]]}`)
+	f.Add(`{"experts": ["E1", "E1"], "alternatives": ["A", "A"], "ranks": [[1, 0], [2]]}`)
+	f.Add(`{"experts": [], "alternatives": ["A"], "ranks": [[]]}`)
+	f.Fuzz(func(t *testing.T, data string) {
+		var out bytes.Buffer
+		if err := runStdinJSON(strings.NewReader(data), &out); err != nil {
+			return
+		}
+
+		// Журнал, прочитаний назад, має містити ті самі ранжування, що й вхід
+		var in traceInputs
+		if err := json.Unmarshal([]byte(data), &in); err != nil {
+			t.Fatalf("прийнято некоректний JSON: %v", err)
+		}
+		var trace Trace
+		if err := json.Unmarshal(out.Bytes(), &trace); err != nil {
+			t.Fatalf("журнал не є коректним JSON: %v", err)
+		}
+		if !reflect.DeepEqual(trace.Inputs, in) {
+			t.Fatalf("ранжування в журналі %+v відрізняються від вхідних %+v", trace.Inputs, in)
+		}
+
+		n := len(in.Alternatives)
+		if len(trace.Comparisons) != n*(n-1) {
+			t.Fatalf("%d порівнянь для %d альтернатив", len(trace.Comparisons), n)
+		}
+		if len(trace.Pareto) == 0 {
+			t.Fatal("порожня множина Парето")
+		}
+		for _, d := range trace.Dominated {
+			if slices.Contains(trace.Pareto, d.Alternative) {
+				t.Fatalf("домінована альтернатива %q у множині Парето", d.Alternative)
+			}
+		}
+		if len(trace.Pareto)+len(trace.Dominated) != n {
+			t.Fatalf("множина Парето %v і домінованих %d не покривають %d альтернатив", trace.Pareto, len(trace.Dominated), n)
+		}
+	})
+}
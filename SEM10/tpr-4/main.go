@@ -2,7 +2,9 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strconv"
@@ -15,10 +17,50 @@ const (
 	promptExpertCount = "Введіть кількість експертів: "
 	promptExpertName  = "Введіть ім'я експерта %d: "
 	promptRank        = "Ранг для альтернативи '%s' від експерта '%s' (1…%d): "
-
-	colAltFormat    = "%-15s"
-	colExpertFormat = "%-8s"
-	colRankFormat   = "%-8d"
+	promptBackHint    = "Щоб повернутися до попереднього питання й виправити відповідь, введіть '<' або back.\n"
+	promptResume      = "Знайдено незавершене введення від %s (експерти: %s; введено %d з %d рангів). Продовжити? (т/н): "
+
+	reportTitle         = "Множина Парето за ранжуваннями експертів"
+	errNoFont           = "Не знайдено шрифт із кирилицею для PDF, вкажіть його через -font"
+	errXLSXEmpty        = "Аркуш '%s' не містить ранжувань: потрібен рядок експертів і хоча б одна альтернатива"
+	errXLSXDuplicate    = "Альтернатива '%s' повторюється в книзі Excel"
+	errXLSXCell         = "Аркуш '%s', клітинка %s: некоректний ранг '%s' (потрібне ціле число від 1 до %d)"
+	errUnknownExample   = "Невідома задача '%s', доступні: %s"
+	errGradeFormat      = "Файл відповідей %s не відповідає формату журналу обчислень: %v"
+	errSessionFormat    = "Файл сесії %s пошкоджено, введення почнеться спочатку"
+	errSessionSave      = "Не вдалося зберегти сесію: %v\n"
+	errStdinJSON        = "Некоректні ранжування на стандартному вході: %v"
+	errInputClosed      = "Введення завершилося раніше, ніж було отримано всі дані"
+	errStdinShape       = "Потрібні експерти, альтернативи й рядок ranks для кожної альтернативи (експертів: %d, альтернатив: %d, рядків: %d)"
+	errStdinDuplicate   = "Альтернатива '%s' повторюється"
+	errStdinExpert      = "Експерт '%s' повторюється"
+	errStdinRow         = "Альтернатива '%s': %d рангів, а експертів %d"
+	errStdinRank        = "Альтернатива '%s', експерт '%s': некоректний ранг %d (потрібне ціле число від 1 до %d)"
+	errSurveyMapping    = "Файл відповідності стовпців %s: %v"
+	errSurveyColumn     = "У CSV немає стовпця '%s'"
+	errSurveyEmpty      = "Файл %s не містить відповідей: потрібен рядок заголовків, щонайменше дві альтернативи та один експерт"
+	errSurveyExpert     = "Рядок %d: експерт '%s' відповів повторно"
+	errSurveyRank       = "Рядок %d, стовпець '%s': некоректна відповідь '%s' (потрібен ранг від 1 до %d)"
+	errCollectList      = "Для збору ранжувань вкажіть щонайменше дві альтернативи (-alternatives) та експертів (-experts) через кому"
+	errCollectDuplicate = "'%s' повторюється у переліку"
+	errCollectToken     = "Посилання недійсне"
+	errCollectRank      = "Ранг альтернативи '%s' має бути цілим числом від 1 до %d"
+	errCollectClosed    = "Збір відповідей уже завершено"
+	errTelegramToken    = "Для режиму -telegram задайте токен бота у змінній середовища TPR_TELEGRAM_TOKEN"
+	errTelegramAPI      = "Telegram: помилка методу %s: %s"
+	errTelegramTaken    = "Це посилання вже використано в іншому чаті"
+	errTelegramLink     = "Відкрийте бота за своїм особистим посиланням, щоб почати ранжування"
+	errTelegramChoice   = "Некоректний вибір альтернативи"
+
+	promptTelegramStart = "Надішліть /start, щоб почати ранжування"
+	promptTelegramWho   = "Вітаємо, %s! Потрібно ранжувати альтернативи: %s."
+	promptTelegramPlace = "%s, оберіть альтернативу на місце %d з %d (1 – найкраща):"
+	promptTelegramDone  = "Дякуємо! Ваше ранжування: %s.\nЩоб змінити його до завершення збору, надішліть /start."
+
+	gradeMissingPair   = "пара (%s, %s) відсутня"
+	gradeWrongPair     = "пара (%s, %s): неправильний висновок про домінування"
+	gradeParetoMissing = "'%s' має входити до множини Парето"
+	gradeParetoExtra   = "'%s' не входить до множини Парето"
 )
 
 type (
@@ -31,6 +73,8 @@ type (
 		experts   []string
 		rankings  map[string]map[string]int  // rankings[expert][alt] = rank
 		dominance map[string]map[string]bool // dominance[a][b] = true якщо a домінує над b
+		// sessionPath – файл для збереження незавершеного введення (порожній – не зберігати)
+		sessionPath string
 	}
 )
 
@@ -40,83 +84,167 @@ func newInputReader() *inputReader {
 
 func (ir *inputReader) readString(prompt string) string {
 	fmt.Print(prompt)
-	s, _ := ir.r.ReadString('\n')
+	s, err := ir.r.ReadString('\n')
+	if err != nil && s == "" {
+		inputClosed()
+	}
 	return strings.TrimSpace(s)
 }
 
-func (ir *inputReader) readInt(prompt string) int {
+// inputClosed завершує програму, якщо стандартний вхід закрито посеред введення:
+// інакше цикл повторного запиту чекав би на коректне значення безкінечно
+func inputClosed() {
+	fmt.Println("\n" + tr(errInputClosed))
+	os.Exit(1)
+}
+
+// isBack перевіряє, чи є відповідь командою повернення до попереднього питання
+func isBack(s string) bool {
+	return s == "<" || strings.EqualFold(s, "back") || strings.EqualFold(s, "назад")
+}
+
+// readAnswer зчитує відповідь; back = true, якщо користувач попросив
+// повернутися до попереднього питання
+func (ir *inputReader) readAnswer(prompt string) (s string, back bool) {
+	s = ir.readString(prompt)
+	if isBack(s) {
+		return "", true
+	}
+	return s, false
+}
+
+func (ir *inputReader) readInt(prompt string) (int, bool) {
 	for {
-		s := ir.readString(prompt)
+		s, back := ir.readAnswer(prompt)
+		if back {
+			return 0, true
+		}
 		if v, err := strconv.Atoi(s); err == nil && v > 0 {
-			return v
+			return v, false
 		}
-		fmt.Println("Невірне число, спробуйте ще раз.")
+		fmt.Println(tr("Невірне число, спробуйте ще раз."))
 	}
 }
 
-func (ir *inputReader) readRank(prompt string, max int) int {
+func (ir *inputReader) readRank(prompt string, max int) (int, bool) {
 	for {
-		s := ir.readString(prompt)
+		s, back := ir.readAnswer(prompt)
+		if back {
+			return 0, true
+		}
 		if v, err := strconv.Atoi(s); err == nil && v >= 1 && v <= max {
-			return v
+			return v, false
 		}
-		fmt.Printf("Ведіть число від 1 до %d.\n", max)
+		fmt.Printf(tr("Ведіть число від 1 до %d.\n"), max)
 	}
 }
 
-func newParetoSystem(ir *inputReader) *ParetoSystem {
-	// Зчитуємо альтернативи
-	n := ir.readInt(promptAltCount)
-	alts := make([]string, n)
-	for i := range n {
-		alts[i] = ir.readString(fmt.Sprintf(promptAltName, i+1))
-	}
-
-	// Зчитуємо експертів
-	n = ir.readInt(promptExpertCount)
-	experts := make([]string, n)
-	for i := range n {
-		experts[i] = ir.readString(fmt.Sprintf(promptExpertName, i+1))
-	}
-
+func newParetoSystem() *ParetoSystem {
 	return &ParetoSystem{
-		alts:      alts,
-		experts:   experts,
 		rankings:  make(map[string]map[string]int),
 		dominance: make(map[string]map[string]bool),
 	}
 }
 
-func (p *ParetoSystem) CollectRankings(ir *inputReader) {
-	count := len(p.alts)
-
+// answered повертає кількість питань введення, на які вже є відповіді:
+// кількість і назви альтернатив, кількість та імена експертів і введені ранги
+func (p *ParetoSystem) answered() int {
+	if len(p.experts) == 0 {
+		return 0
+	}
+	n := len(p.alts) + len(p.experts) + 2
 	for _, e := range p.experts {
-		p.rankings[e] = make(map[string]int)
-		fmt.Printf("\n--- Ранжування від експерта %s ---\n", e)
+		n += len(p.rankings[e])
+	}
+	return n
+}
+
+// ReadProblem інтерактивно запитує альтернативи, експертів і ранжування.
+// Введення починається з першого питання без відповіді (після відновлення сесії),
+// відповідь '<' або back повертає до попереднього питання, а після кожного
+// рангу стан зберігається у файл сесії.
+func (p *ParetoSystem) ReadProblem(ir *inputReader) {
+	for pos := p.answered(); ; {
+		n, m := len(p.alts), len(p.experts)
+		cell := pos - n - m - 2
+		var back bool
+
+		switch {
+		case pos == 0:
+			var count int
+			if count, back = ir.readInt(tr(promptAltCount)); !back && count != n {
+				p.alts = make([]string, count)
+			}
+		case pos <= n:
+			p.alts[pos-1], back = ir.readAnswer(fmt.Sprintf(tr(promptAltName), pos))
+		case pos == n+1:
+			var count int
+			if count, back = ir.readInt(tr(promptExpertCount)); !back && count != m {
+				p.experts = make([]string, count)
+			}
+		case pos <= n+m+1:
+			i := pos - n - 2
+			p.experts[i], back = ir.readAnswer(fmt.Sprintf(tr(promptExpertName), i+1))
+			if !back && i == m-1 {
+				for _, e := range p.experts {
+					p.rankings[e] = make(map[string]int)
+				}
+				p.saveSession()
+			}
+		case cell < n*m:
+			e, a := p.experts[cell/n], p.alts[cell%n]
+			if cell%n == 0 {
+				fmt.Printf(tr("\n--- Ранжування від експерта %s ---\n"), e)
+			}
+			var rank int
+			if rank, back = ir.readRank(fmt.Sprintf(tr(promptRank), a, e, n), n); !back {
+				p.rankings[e][a] = rank
+				p.saveSession()
+			}
+		default:
+			p.removeSession()
+			return
+		}
 
-		for _, a := range p.alts {
-			p.rankings[e][a] = ir.readRank(
-				fmt.Sprintf(promptRank, a, e, count), count)
+		if back {
+			pos = max(pos-1, 0)
+			p.unanswer(pos)
+		} else {
+			pos++
 		}
 	}
 }
 
+// unanswer скасовує відповідь на питання pos, якщо це ранг
+func (p *ParetoSystem) unanswer(pos int) {
+	n := len(p.alts)
+	cell := pos - n - len(p.experts) - 2
+	if cell < 0 {
+		return
+	}
+	delete(p.rankings[p.experts[cell/n]], p.alts[cell%n])
+}
+
 func (p *ParetoSystem) PrintRankingTable() {
-	fmt.Println("\nТаблиця ранжувань (рядок – альтернатива, стовпці – експерти):")
+	fmt.Println(tr("\nТаблиця ранжувань (рядок – альтернатива, стовпці – експерти):"))
 
-	fmt.Printf(colAltFormat, "Альтернатива")
-	for _, e := range p.experts {
-		fmt.Printf(colExpertFormat, e)
+	// Парето-оптимальні альтернативи та перші місця у ранжуваннях виділяються кольором
+	optimal := make(map[string]bool)
+	for _, a := range p.ParetoSet() {
+		optimal[a] = true
 	}
-	fmt.Println()
 
-	for _, a := range p.alts {
-		fmt.Printf(colAltFormat, a)
-		for _, e := range p.experts {
-			fmt.Printf(colRankFormat, p.rankings[e][a])
+	RenderTable(os.Stdout, p.RankingTable(), func(row, col int, cell string) string {
+		switch {
+		case row < 0:
+			return cell
+		case col == 0 && optimal[p.alts[row]]:
+			return highlight(cell)
+		case col > 0 && p.rankings[p.experts[col-1]][p.alts[row]] == 1:
+			return accent(cell)
 		}
-		fmt.Println()
-	}
+		return cell
+	})
 }
 
 func (p *ParetoSystem) BuildDominance() {
@@ -155,27 +283,71 @@ func (p *ParetoSystem) BuildDominance() {
 }
 
 func (p *ParetoSystem) PrintDominanceMatrix() {
-	fmt.Println("\nМатриця домінування (1 – рядок домінує над стовпцем):")
+	fmt.Println(tr("\nМатриця домінування (1 – рядок домінує над стовпцем):"))
 
-	fmt.Printf(colAltFormat, "")
-	for _, a := range p.alts {
-		fmt.Printf("%-8s", a)
+	RenderTable(os.Stdout, p.DominanceTable(), func(row, col int, cell string) string {
+		if row >= 0 && col > 0 && p.dominance[p.alts[row]][p.alts[col-1]] {
+			return accent(cell)
+		}
+		return cell
+	})
+
+	// Діаграма кількості альтернатив, над якими домінує кожна альтернатива
+	optimal := make(map[string]bool)
+	for _, a := range p.ParetoSet() {
+		optimal[a] = true
 	}
-	fmt.Println()
+	labels, values := p.DominanceCounts()
+	fmt.Println(tr("\nКількість альтернатив, над якими домінує альтернатива:"))
+	PrintBarChart(os.Stdout, labels, values, "%.0f", func(i int, s string) string {
+		if optimal[labels[i]] {
+			return highlight(s)
+		}
+		return s
+	})
+}
 
-	for _, a1 := range p.alts {
-		fmt.Printf(colAltFormat, a1)
-		for _, a2 := range p.alts {
-			if a1 == a2 {
-				fmt.Printf("%-8s", "-")
-			} else if p.dominance[a1][a2] {
-				fmt.Printf("%-8d", 1)
-			} else {
-				fmt.Printf("%-8d", 0)
-			}
+// DominanceCounts повертає альтернативи, впорядковані за спаданням кількості
+// альтернатив, над якими вони домінують, разом із цією кількістю
+func (p *ParetoSystem) DominanceCounts() ([]string, []float64) {
+	labels := append([]string(nil), p.alts...)
+	sort.SliceStable(labels, func(i, j int) bool {
+		return len(p.dominance[labels[i]]) > len(p.dominance[labels[j]])
+	})
+	values := make([]float64, len(labels))
+	for i, a := range labels {
+		values[i] = float64(len(p.dominance[a]))
+	}
+	return labels, values
+}
+
+// RankSeries повертає ранжування кожної альтернативи для радарної діаграми;
+// ранги обертаються (n+1-r), щоб краще місце відповідало більшому радіусу
+func (p *ParetoSystem) RankSeries() []Series {
+	series := make([]Series, len(p.alts))
+	for i, a := range p.alts {
+		series[i].Name = a
+		for _, e := range p.experts {
+			series[i].Y = append(series[i].Y, float64(len(p.alts)+1-p.rankings[e][a]))
 		}
-		fmt.Println()
 	}
+	return series
+}
+
+// charts повертає SVG-діаграми домінування і, якщо експертів щонайменше три, радарну діаграму ранжувань
+func (p *ParetoSystem) charts() []exportTarget {
+	labels, values := p.DominanceCounts()
+	charts := []exportTarget{
+		{"dominance.svg", func(w io.Writer) error {
+			return WriteBarChartSVG(w, tr("Кількість альтернатив, над якими домінує альтернатива"), labels, values)
+		}},
+	}
+	if len(p.experts) >= 3 {
+		charts = append(charts, exportTarget{"radar.svg", func(w io.Writer) error {
+			return WriteRadarChartSVG(w, tr("Ранжування альтернатив експертами"), p.experts, p.RankSeries())
+		}})
+	}
+	return charts
 }
 
 func (p *ParetoSystem) ParetoSet() []string {
@@ -199,19 +371,189 @@ func (p *ParetoSystem) ParetoSet() []string {
 	return out
 }
 
-func main() {
-	ir := newInputReader()
-	ps := newParetoSystem(ir)
+func (p *ParetoSystem) RankingTable() Table {
+	t := Table{Title: tr("Таблиця ранжувань"), Header: append([]string{tr("Альтернатива")}, p.experts...)}
+	for _, a := range p.alts {
+		row := []string{a}
+		for _, e := range p.experts {
+			row = append(row, fmt.Sprint(p.rankings[e][a]))
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t
+}
 
-	ps.CollectRankings(ir)
-	ps.PrintRankingTable()
+func (p *ParetoSystem) DominanceTable() Table {
+	t := Table{
+		Title:  tr("Матриця домінування (1 – рядок домінує над стовпцем)"),
+		Header: append([]string{""}, p.alts...),
+	}
+	for _, a1 := range p.alts {
+		row := []string{a1}
+		for _, a2 := range p.alts {
+			switch {
+			case a1 == a2:
+				row = append(row, "-")
+			case p.dominance[a1][a2]:
+				row = append(row, "1")
+			default:
+				row = append(row, "0")
+			}
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t
+}
+
+func ParetoTable(pareto []string) Table {
+	t := Table{Title: tr("Множина Парето оптимальних альтернатив"), Header: []string{"№", tr("Альтернатива")}}
+	for i, a := range pareto {
+		t.Rows = append(t.Rows, []string{fmt.Sprint(i + 1), a})
+	}
+	return t
+}
 
+func main() {
+	reportPath := flag.String("report", "", "зберегти HTML-звіт у вказаний файл")
+	mdPath := flag.String("md", "", "зберегти звіт у форматі Markdown у вказаний файл")
+	texPath := flag.String("tex", "", "зберегти таблиці звіту у форматі LaTeX у вказаний файл")
+	pdfPath := flag.String("pdf", "", "зберегти звіт у форматі PDF у вказаний файл")
+	fontPath := flag.String("font", "", "TrueType-шрифт із кирилицею для PDF-звіту")
+	variant := flag.String("variant", "", "номер варіанту для титульної сторінки звіту")
+	exampleName := flag.String("example", "", "використати вбудовану задачу (list – перелік задач)")
+	xlsxPath := flag.String("xlsx", "", "зчитати ранжування експертів з книги Excel")
+	csvPath := flag.String("csv", "", "імпортувати ранжування з CSV відповідей опитування (Google Forms: рядок – експерт, стовпець – альтернатива)")
+	csvMap := flag.String("csv-map", "", "JSON-файл відповідності стовпців CSV: expert, alternatives (назва → заголовок), skip")
+	sheet := flag.String("sheet", "", "аркуш книги Excel з ранжуваннями (за замовчуванням перший)")
+	xlsxOut := flag.String("xlsx-out", "", "записати результати на аркуш книги Excel")
+	tracePath := flag.String("trace", "", "зберегти хід обчислень у форматі JSON у вказаний файл")
+	gradePath := flag.String("grade", "", "перевірити відповіді студента (JSON у форматі -trace)")
+	chartsDir := flag.String("charts", "", "зберегти SVG-діаграми у вказаний каталог")
+	noColor := flag.Bool("no-color", false, "вимкнути кольорове виділення у терміналі")
+	sessionPath := flag.String("session", defaultSessionFile, "файл для автозбереження незавершеного введення (порожній рядок – вимкнути)")
+	collectAddr := flag.String("collect", "", "зібрати ранжування через вебформу: адреса сервера, наприклад :8081")
+	collectAlts := flag.String("alternatives", "", "альтернативи для режимів -collect і -telegram через кому")
+	collectExperts := flag.String("experts", "", "експерти для режимів -collect і -telegram через кому")
+	collectURL := flag.String("collect-url", "", "зовнішня адреса сервера для посилань експертам (за замовчуванням http://<адреса -collect>)")
+	telegramMode := flag.Bool("telegram", false, "зібрати ранжування через Telegram-бота (токен – у змінній TPR_TELEGRAM_TOKEN)")
+	submissionsPath := flag.String("submissions", defaultSubmissionsFile, "файл, у якому зберігаються відповіді експертів у режимах -collect і -telegram")
+	langFlag := flag.String("lang", "", "мова інтерфейсу: uk або en (за замовчуванням визначається з LANG)")
+	flag.BoolVar(&explain, "explain", false, "виводити проміжні обчислення покроково")
+	summary := flag.Bool("summary", false, "вивести текстове обґрунтування множини Парето (воно також входить у звіти)")
+	flag.BoolVar(&tableBorders, "box", false, "виводити таблиці в рамках із псевдографіки")
+	stdinJSON := flag.Bool("stdin-json", false, "зчитати ранжування в JSON (як поле inputs журналу -trace) зі стандартного входу й вивести журнал обчислень у JSON без підказок")
+	flag.Parse()
+	setupColor(*noColor)
+	setupLang(*langFlag)
+
+	if *stdinJSON {
+		if err := runStdinJSON(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	ir := newInputReader()
+	var ps *ParetoSystem
+	var err error
+	switch {
+	case *exampleName == "list":
+		PrintExamples()
+		return
+	case *exampleName != "":
+		ps, err = loadExample(*exampleName)
+	case *xlsxPath != "":
+		ps, err = loadXLSX(*xlsxPath, *sheet)
+	case *csvPath != "":
+		ps, err = loadSurvey(*csvPath, *csvMap)
+	case *collectAddr != "" || *telegramMode:
+		var alts, experts []string
+		if alts, err = splitList(*collectAlts); err == nil {
+			experts, err = splitList(*collectExperts)
+		}
+		switch {
+		case err != nil:
+		case *telegramMode:
+			ps, err = collectTelegram(os.Getenv("TPR_TELEGRAM_TOKEN"), *submissionsPath, alts, experts)
+		default:
+			ps, err = collectRankings(*collectAddr, *collectURL, *submissionsPath, alts, experts)
+		}
+	default:
+		if ps = resumeSession(ir, *sessionPath); ps == nil {
+			ps = newParetoSystem()
+		}
+		ps.sessionPath = *sessionPath
+		fmt.Print(tr(promptBackHint))
+		ps.ReadProblem(ir)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	ps.BuildDominance()
+	ps.PrintRankingTable()
+	if explain {
+		ps.ExplainDominance()
+	}
 	ps.PrintDominanceMatrix()
 
 	pareto := ps.ParetoSet()
-	fmt.Println("\nМножина Парето оптимальних альтернатив:")
+	fmt.Println(tr("\nМножина Парето оптимальних альтернатив:"))
 	for i, a := range pareto {
-		fmt.Printf("%d) %s\n", i+1, a)
+		fmt.Printf("%d) %s\n", i+1, highlight(a))
+	}
+
+	report := &Report{Title: tr(reportTitle), Variant: *variant}
+	report.Add(ps.RankingTable())
+	report.Add(ps.DominanceTable())
+	report.Add(ParetoTable(pareto))
+	report.Conclusions = []string{
+		fmt.Sprintf(tr("Парето-оптимальні альтернативи: %s"), strings.Join(pareto, ", ")),
+	}
+	report.Analysis = ps.Summary(pareto)
+	if *summary {
+		printAnalysis(report)
+	}
+
+	// Для зібраних онлайн відповідей додатково оцінюється узгодженість експертів
+	if *collectAddr != "" || *telegramMode {
+		borda := ps.BordaTable()
+		fmt.Println()
+		RenderTable(os.Stdout, borda, nil)
+		w := fmt.Sprintf(tr("Коефіцієнт конкордації Кендалла W = %.3f"), ps.Concordance())
+		fmt.Println("\n" + w)
+		report.Add(borda)
+		report.Conclusions = append(report.Conclusions, w)
+	}
+
+	if *gradePath != "" {
+		answer, err := loadTrace(*gradePath)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		PrintGrade(GradeTrace(ps.Trace(), answer))
+	}
+
+	if *chartsDir != "" {
+		fmt.Println()
+		exportCharts(*chartsDir, ps.charts())
+	}
+
+	exportReport([]exportTarget{
+		{*reportPath, report.WriteHTML},
+		{*mdPath, report.WriteMarkdown},
+		{*texPath, report.WriteLaTeX},
+		{*pdfPath, func(w io.Writer) error { return report.WritePDF(w, *fontPath) }},
+		{*tracePath, ps.Trace().WriteJSON},
+	})
+
+	if *xlsxOut != "" {
+		if err := report.SaveXLSX(*xlsxOut); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf(tr("\nРезультати записано на аркуш '%s' книги %s\n"), xlsxResultsSheet, *xlsxOut)
 	}
 }
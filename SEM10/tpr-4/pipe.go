@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// runStdinJSON – режим -stdin-json: ранжування експертів у форматі поля inputs
+// журналу -trace (ranks – рядок рангів для кожної альтернативи в порядку
+// experts) читаються зі стандартного входу, а журнал з порівняннями пар і
+// множиною Парето записується у w у форматі JSON без підказок і таблиць
+func runStdinJSON(r io.Reader, w io.Writer) error {
+	var in traceInputs
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&in); err != nil {
+		return fmt.Errorf(tr(errStdinJSON), err)
+	}
+	p, err := in.system()
+	if err != nil {
+		return err
+	}
+	p.BuildDominance()
+	return p.Trace().WriteJSON(w)
+}
+
+// system перевіряє ранжування так само, як під час зчитування книги Excel:
+// кожен ранг – ціле число від 1 до кількості альтернатив
+func (in traceInputs) system() (*ParetoSystem, error) {
+	if len(in.Experts) == 0 || len(in.Alternatives) == 0 || len(in.Ranks) != len(in.Alternatives) {
+		return nil, fmt.Errorf(tr(errStdinShape), len(in.Experts), len(in.Alternatives), len(in.Ranks))
+	}
+
+	p := &ParetoSystem{
+		alts:      in.Alternatives,
+		experts:   in.Experts,
+		rankings:  make(map[string]map[string]int),
+		dominance: make(map[string]map[string]bool),
+	}
+	for _, e := range p.experts {
+		if _, ok := p.rankings[e]; ok {
+			return nil, fmt.Errorf(tr(errStdinExpert), e)
+		}
+		p.rankings[e] = make(map[string]int)
+	}
+	for i, a := range p.alts {
+		if _, ok := p.rankings[p.experts[0]][a]; ok {
+			return nil, fmt.Errorf(tr(errStdinDuplicate), a)
+		}
+		if len(in.Ranks[i]) != len(p.experts) {
+			return nil, fmt.Errorf(tr(errStdinRow), a, len(in.Ranks[i]), len(p.experts))
+		}
+		for k, e := range p.experts {
+			rank := in.Ranks[i][k]
+			if rank < 1 || rank > len(p.alts) {
+				return nil, fmt.Errorf(tr(errStdinRank), a, e, rank, len(p.alts))
+			}
+			p.rankings[e][a] = rank
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Table – таблиця результатів у вигляді рядків тексту для експорту у звіт
+	Table struct {
+		Title  string
+		Header []string
+		Rows   [][]string
+	}
+
+	// Report накопичує таблиці, що виводяться під час розрахунку
+	Report struct {
+		Title       string
+		Variant     string
+		Tables      []Table
+		Conclusions []string
+		// Analysis – абзаци текстового обґрунтування рекомендацій (розділ «Аналіз результатів»)
+		Analysis []string
+	}
+
+	// exportTarget – файл, у який звіт записується заданою функцією
+	exportTarget struct {
+		path  string
+		write func(w io.Writer) error
+	}
+)
+
+func (r *Report) Add(t Table) {
+	r.Tables = append(r.Tables, t)
+}
+
+// AnalysisTitle повертає заголовок розділу з обґрунтуванням рекомендацій обраною мовою
+func (r *Report) AnalysisTitle() string {
+	return tr("Аналіз результатів")
+}
+
+// exportReport записує звіт у всі вказані файли; цілі з порожнім шляхом пропускаються
+func exportReport(targets []exportTarget) {
+	for _, t := range targets {
+		if t.path == "" {
+			continue
+		}
+		if err := saveFile(t.path, t.write); err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf(tr("\nЗвіт збережено у файл %s\n"), t.path)
+	}
+}
+
+func saveFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+// WriteHTML записує звіт як самодостатню HTML-сторінку із вбудованими стилями
+// та сортуванням таблиць за натисканням на заголовок стовпця
+func (r *Report) WriteHTML(w io.Writer) error {
+	return htmlReport.Execute(w, r)
+}
+
+// WriteMarkdown записує звіт у форматі GitHub-flavored Markdown;
+// стовпці, що містять лише числа, вирівнюються праворуч
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", r.Title)
+	for _, t := range r.Tables {
+		fmt.Fprintf(&b, "\n## %s\n\n", t.Title)
+
+		cells := make([]string, len(t.Header))
+		for j, h := range t.Header {
+			cells[j] = markdownEscape(h)
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+
+		for j := range t.Header {
+			cells[j] = "---"
+			if t.numericColumn(j) {
+				cells[j] = "---:"
+			}
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+
+		for _, row := range t.Rows {
+			for j := range cells {
+				cells[j] = ""
+				if j < len(row) {
+					cells[j] = markdownEscape(row[j])
+				}
+			}
+			fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+		}
+	}
+	if len(r.Analysis) > 0 {
+		fmt.Fprintf(&b, "\n## %s\n\n%s\n", r.AnalysisTitle(), strings.Join(r.Analysis, "\n\n"))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// numericColumn перевіряє, чи всі значення стовпця j є числами
+func (t Table) numericColumn(j int) bool {
+	if len(t.Rows) == 0 {
+		return false
+	}
+	for _, row := range t.Rows {
+		if j >= len(row) {
+			return false
+		}
+		if _, err := strconv.ParseFloat(row[j], 64); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// latexReplacer екранує спеціальні символи LaTeX; лапки замінюються,
+// оскільки в ukrainian babel символ " є активним скороченням
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`, `%`, `\%`, `$`, `\$`, `#`, `\#`, `_`, `\_`,
+	`{`, `\{`, `}`, `\}`, `~`, `\textasciitilde{}`, `^`, `\textasciicircum{}`,
+	`|`, `\textbar{}`, `"`, `''`,
+)
+
+// WriteLaTeX записує таблиці звіту як середовища tabular для включення
+// в документ через \input; кирилиця потребує пакетів, наведених у коментарі
+func (r *Report) WriteLaTeX(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("% Преамбула документа:\n")
+	b.WriteString("%   \\usepackage[T2A]{fontenc}\n")
+	b.WriteString("%   \\usepackage[utf8]{inputenc}\n")
+	b.WriteString("%   \\usepackage[ukrainian]{babel}\n")
+	fmt.Fprintf(&b, "%% %s\n", latexReplacer.Replace(r.Title))
+
+	for _, t := range r.Tables {
+		spec := make([]string, len(t.Header))
+		cells := make([]string, len(t.Header))
+		for j, h := range t.Header {
+			spec[j] = "l"
+			if t.numericColumn(j) {
+				spec[j] = "r"
+			}
+			cells[j] = latexReplacer.Replace(h)
+		}
+
+		b.WriteString("\n\\begin{table}[ht]\n\\centering\n")
+		fmt.Fprintf(&b, "\\caption{%s}\n", latexReplacer.Replace(t.Title))
+		fmt.Fprintf(&b, "\\begin{tabular}{|%s|}\n\\hline\n", strings.Join(spec, "|"))
+		fmt.Fprintf(&b, "%s \\\\\n\\hline\n", strings.Join(cells, " & "))
+		for _, row := range t.Rows {
+			for j := range cells {
+				cells[j] = ""
+				if j < len(row) {
+					cells[j] = latexReplacer.Replace(row[j])
+				}
+			}
+			fmt.Fprintf(&b, "%s \\\\\n", strings.Join(cells, " & "))
+		}
+		b.WriteString("\\hline\n\\end{tabular}\n\\end{table}\n")
+	}
+	if len(r.Analysis) > 0 {
+		fmt.Fprintf(&b, "\n\\subsection*{%s}\n", latexReplacer.Replace(r.AnalysisTitle()))
+		for _, p := range r.Analysis {
+			fmt.Fprintf(&b, "\n%s\n", latexReplacer.Replace(p))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var htmlReport = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="uk">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: "Segoe UI", Arial, sans-serif; margin: 2em auto; max-width: 960px; color: #222; }
+h1 { font-size: 1.6em; border-bottom: 2px solid #446; padding-bottom: .3em; }
+h2 { font-size: 1.2em; margin-top: 1.6em; }
+table { border-collapse: collapse; margin: .5em 0; }
+th, td { border: 1px solid #bbc; padding: .35em .8em; text-align: left; }
+th { background: #e8eaf2; cursor: pointer; user-select: none; }
+th.asc::after { content: " ▲"; }
+th.desc::after { content: " ▼"; }
+tr:nth-child(even) td { background: #f7f8fb; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Tables}}
+<h2>{{.Title}}</h2>
+<table class="sortable">
+<thead><tr>{{range .Header}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+{{end}}
+{{if .Analysis}}<h2>{{.AnalysisTitle}}</h2>
+{{range .Analysis}}<p>{{.}}</p>
+{{end}}{{end}}<script>
+document.querySelectorAll("table.sortable").forEach(function (table) {
+  table.querySelectorAll("th").forEach(function (th, col) {
+    th.addEventListener("click", function () {
+      var asc = !th.classList.contains("asc");
+      table.querySelectorAll("th").forEach(function (h) { h.classList.remove("asc", "desc"); });
+      th.classList.add(asc ? "asc" : "desc");
+
+      var body = table.tBodies[0];
+      var rows = Array.from(body.rows);
+      rows.sort(function (a, b) {
+        var x = a.cells[col].textContent, y = b.cells[col].textContent;
+        var nx = parseFloat(x), ny = parseFloat(y);
+        var cmp = isNaN(nx) || isNaN(ny) ? x.localeCompare(y, "uk") : nx - ny;
+        return asc ? cmp : -cmp;
+      });
+      rows.forEach(function (row) { body.appendChild(row); });
+    });
+  });
+});
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultSessionFile = ".tpr-4-session.json"
+
+// session – незавершене інтерактивне введення: альтернативи, експерти
+// та вже введені ранжування (останнє може бути неповним)
+type session struct {
+	Saved        time.Time `json:"saved"`
+	Alternatives []string  `json:"alternatives"`
+	Experts      []string  `json:"experts"`
+	Ranks        [][]int   `json:"ranks"` // Ranks[експерт][альтернатива]
+}
+
+// filled повертає кількість уже введених рангів
+func (s *session) filled() int {
+	n := 0
+	for _, row := range s.Ranks {
+		n += len(row)
+	}
+	return n
+}
+
+func (s *session) valid() bool {
+	if len(s.Alternatives) == 0 || len(s.Experts) == 0 || len(s.Ranks) > len(s.Experts) {
+		return false
+	}
+	for _, row := range s.Ranks {
+		if len(row) > len(s.Alternatives) {
+			return false
+		}
+		for _, r := range row {
+			if r < 1 || r > len(s.Alternatives) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// saveSession зберігає стан введення у файл сесії; файл спершу записується
+// поруч під тимчасовою назвою і лише потім замінює попередній, щоб
+// переривання під час запису не зіпсувало вже збережені дані
+func (p *ParetoSystem) saveSession() {
+	if p.sessionPath == "" {
+		return
+	}
+	s := session{Saved: time.Now(), Alternatives: p.alts, Experts: p.experts}
+	for _, e := range p.experts {
+		ranks, ok := p.rankings[e]
+		if !ok {
+			break
+		}
+		row := []int{}
+		for _, a := range p.alts {
+			r, ok := ranks[a]
+			if !ok {
+				break
+			}
+			row = append(row, r)
+		}
+		s.Ranks = append(s.Ranks, row)
+	}
+
+	data, err := json.Marshal(s)
+	if err == nil {
+		tmp := p.sessionPath + ".tmp"
+		if err = os.WriteFile(tmp, data, 0o644); err == nil {
+			err = os.Rename(tmp, p.sessionPath)
+		}
+	}
+	if err != nil {
+		fmt.Printf(tr(errSessionSave), err)
+	}
+}
+
+// removeSession видаляє файл сесії після завершення введення
+func (p *ParetoSystem) removeSession() {
+	if p.sessionPath == "" {
+		return
+	}
+	if err := os.Remove(p.sessionPath); err != nil && !os.IsNotExist(err) {
+		fmt.Println(err)
+	}
+}
+
+func loadSession(path string) (*session, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s session
+	if err := json.Unmarshal(data, &s); err != nil || !s.valid() {
+		return nil, fmt.Errorf(tr(errSessionFormat), path)
+	}
+	return &s, nil
+}
+
+// resumeSession пропонує продовжити незавершене введення з файлу сесії.
+// Повертає nil, якщо сесії немає або користувач відмовився.
+func resumeSession(ir *inputReader, path string) *ParetoSystem {
+	if path == "" {
+		return nil
+	}
+	s, err := loadSession(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			fmt.Println(err)
+		}
+		return nil
+	}
+
+	answer := ir.readString(fmt.Sprintf(tr(promptResume),
+		s.Saved.Format("02.01.2006 15:04"), strings.Join(s.Experts, ", "),
+		s.filled(), len(s.Alternatives)*len(s.Experts)))
+	switch strings.ToLower(answer) {
+	case "т", "так", "y", "yes":
+	default:
+		return nil
+	}
+
+	p := &ParetoSystem{
+		alts:      s.Alternatives,
+		experts:   s.Experts,
+		rankings:  make(map[string]map[string]int),
+		dominance: make(map[string]map[string]bool),
+	}
+	for _, e := range s.Experts {
+		p.rankings[e] = make(map[string]int)
+	}
+	for i, row := range s.Ranks {
+		for j, r := range row {
+			p.rankings[s.Experts[i]][s.Alternatives[j]] = r
+		}
+	}
+	return p
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Шаблони обґрунтування множини Парето; значення підставляються з матриці домінування
+const (
+	summarySingle   = "Єдиною Парето-оптимальною є альтернатива %s: над нею не домінує жодна інша, а сама вона домінує над %d з %d інших альтернатив, тому її можна рекомендувати без додаткових припущень щодо важливості експертів."
+	summaryMultiple = "До множини Парето входять альтернативи %s: над жодною з них не домінує інша альтернатива, тож для остаточного вибору потрібні додаткові міркування, наприклад порівняння сум рангів."
+	summaryExcluded = "Альтернативу %s виключено, оскільки над нею домінує %s: жоден експерт не ставить її вище, а хоча б один ставить нижче."
+	summaryAllIn    = "Жодна альтернатива не домінує над іншою: думки експертів надто розходяться, щоб звузити вибір за Парето."
+)
+
+// printAnalysis виводить розділ аналізу результатів звіту в термінал
+func printAnalysis(r *Report) {
+	fmt.Printf("\n%s:\n", r.AnalysisTitle())
+	for _, s := range r.Analysis {
+		fmt.Println(s)
+	}
+}
+
+// Summary будує текстове обґрунтування множини Парето – заготовку розділу
+// аналізу результатів у звіті: чому альтернативи увійшли до неї чи були виключені
+func (p *ParetoSystem) Summary(pareto []string) []string {
+	if len(pareto) == len(p.alts) {
+		if len(p.alts) == 1 {
+			return []string{fmt.Sprintf(tr(summarySingle), pareto[0], 0, 0)}
+		}
+		return []string{tr(summaryAllIn)}
+	}
+
+	var out []string
+	if len(pareto) == 1 {
+		out = append(out, fmt.Sprintf(tr(summarySingle), pareto[0], len(p.dominance[pareto[0]]), len(p.alts)-1))
+	} else {
+		out = append(out, fmt.Sprintf(tr(summaryMultiple), strings.Join(pareto, ", ")))
+	}
+
+	var excluded []string
+	for _, a := range p.alts {
+		var dominators []string
+		for _, b := range p.alts {
+			if p.dominance[b][a] {
+				dominators = append(dominators, b)
+			}
+		}
+		if len(dominators) > 0 {
+			excluded = append(excluded, fmt.Sprintf(tr(summaryExcluded), a, strings.Join(dominators, ", ")))
+		}
+	}
+	return append(out, strings.Join(excluded, " "))
+}
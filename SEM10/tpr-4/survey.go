@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type (
+	// surveyMapping – відповідність стовпців CSV з відповідями опитування
+	// (Google Forms тощо) ранжуванням: рядок – експерт, стовпець – альтернатива
+	surveyMapping struct {
+		// Expert – заголовок стовпця з іменем експерта; якщо не задано, шукається
+		// стандартний стовпець форми, інакше експерти нумеруються
+		Expert string `json:"expert"`
+		// Alternatives – назва альтернативи → заголовок стовпця з її рангом;
+		// якщо не задано, альтернативами вважаються всі інші стовпці
+		Alternatives map[string]string `json:"alternatives"`
+		// Skip – заголовки стовпців, які не є ні експертом, ні альтернативою
+		Skip []string `json:"skip"`
+	}
+
+	// surveyColumn – стовпець CSV з рангами однієї альтернативи
+	surveyColumn struct {
+		alt    string
+		index  int
+		header string
+	}
+)
+
+var (
+	// surveyExpertHeaders – типові заголовки стовпця з іменем респондента
+	surveyExpertHeaders = []string{"ім'я", "ім’я", "name", "експерт", "expert", "електронна адреса", "email address", "username"}
+	// surveySkipHeaders – службові стовпці Google Forms
+	surveySkipHeaders = []string{"позначка часу", "timestamp", "бали", "score"}
+	// surveyBracket виділяє назву рядка сітки Google Forms: "Ранжуйте проєкти [Проєкт 1]"
+	surveyBracket = regexp.MustCompile(`\[([^\]]+)\]\s*$`)
+	// surveyRank виділяє ранг з відповіді: "2", "2-е місце", "2nd"
+	surveyRank = regexp.MustCompile(`^\s*(\d+)`)
+)
+
+func loadSurveyMapping(path string) (*surveyMapping, error) {
+	m := &surveyMapping{}
+	if path == "" {
+		return m, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf(tr(errSurveyMapping), path, err)
+	}
+	return m, nil
+}
+
+// columns визначає стовпець експерта і стовпці альтернатив (у порядку файлу)
+func (m *surveyMapping) columns(header []string) (expert int, alts []surveyColumn, err error) {
+	expert = -1
+	find := func(name string) int {
+		return slices.IndexFunc(header, func(h string) bool { return strings.EqualFold(strings.TrimSpace(h), name) })
+	}
+
+	if m.Expert != "" {
+		if expert = find(m.Expert); expert < 0 {
+			return 0, nil, fmt.Errorf(tr(errSurveyColumn), m.Expert)
+		}
+	} else {
+		for _, name := range surveyExpertHeaders {
+			if expert = find(name); expert >= 0 {
+				break
+			}
+		}
+	}
+
+	if len(m.Alternatives) > 0 {
+		for alt, column := range m.Alternatives {
+			j := find(column)
+			if j < 0 {
+				return 0, nil, fmt.Errorf(tr(errSurveyColumn), column)
+			}
+			alts = append(alts, surveyColumn{alt: alt, index: j, header: header[j]})
+		}
+		slices.SortFunc(alts, func(a, b surveyColumn) int { return a.index - b.index })
+		return expert, alts, nil
+	}
+
+	for j, h := range header {
+		h = strings.TrimSpace(h)
+		lower := strings.ToLower(h)
+		if j == expert || h == "" || slices.Contains(surveySkipHeaders, lower) ||
+			slices.ContainsFunc(m.Skip, func(s string) bool { return strings.EqualFold(s, h) }) {
+			continue
+		}
+		alt := h
+		if sub := surveyBracket.FindStringSubmatch(h); sub != nil {
+			alt = strings.TrimSpace(sub[1])
+		}
+		alts = append(alts, surveyColumn{alt: alt, index: j, header: h})
+	}
+	return expert, alts, nil
+}
+
+// loadSurvey імпортує ранжування з CSV відповідей опитування (один рядок – один
+// експерт, один стовпець – одна альтернатива). Усі некоректні відповіді
+// (порожні, не числа, ранги поза 1…n) повідомляються разом із номером рядка.
+func loadSurvey(path, mappingPath string) (*ParetoSystem, error) {
+	mapping, err := loadSurveyMapping(mappingPath)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// Excel додає BOM на початку файлу UTF-8, який інакше потрапить у перший заголовок
+	br := bufio.NewReader(f)
+	if bom, _, err := br.ReadRune(); err == nil && bom != '\ufeff' {
+		br.UnreadRune()
+	}
+	r := csv.NewReader(br)
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf(tr(errSurveyEmpty), path)
+	}
+
+	expert, columns, err := mapping.columns(records[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) < 2 {
+		return nil, fmt.Errorf(tr(errSurveyEmpty), path)
+	}
+
+	p := newParetoSystem()
+	for _, c := range columns {
+		if slices.Contains(p.alts, c.alt) {
+			return nil, fmt.Errorf(tr(errXLSXDuplicate), c.alt)
+		}
+		p.alts = append(p.alts, c.alt)
+	}
+
+	n := len(p.alts)
+	var errs []error
+	for i, record := range records[1:] {
+		line := i + 2
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		name := fmt.Sprintf(tr("Експерт %d"), len(p.experts)+1)
+		if expert >= 0 && expert < len(record) && strings.TrimSpace(record[expert]) != "" {
+			name = strings.TrimSpace(record[expert])
+		}
+		if _, ok := p.rankings[name]; ok {
+			errs = append(errs, fmt.Errorf(tr(errSurveyExpert), line, name))
+			continue
+		}
+
+		ranks := make(map[string]int, n)
+		for _, c := range columns {
+			answer := ""
+			if c.index < len(record) {
+				answer = record[c.index]
+			}
+			sub := surveyRank.FindStringSubmatch(answer)
+			rank := 0
+			if sub != nil {
+				rank, _ = strconv.Atoi(sub[1])
+			}
+			if rank < 1 || rank > n {
+				errs = append(errs, fmt.Errorf(tr(errSurveyRank), line, c.header, answer, n))
+				continue
+			}
+			ranks[c.alt] = rank
+		}
+		p.experts = append(p.experts, name)
+		p.rankings[name] = ranks
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if len(p.experts) == 0 {
+		return nil, fmt.Errorf(tr(errSurveyEmpty), path)
+	}
+	return p, nil
+}
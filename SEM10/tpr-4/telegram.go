@@ -0,0 +1,325 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// telegramDefaultAPI – адреса Bot API; змінна TPR_TELEGRAM_API дозволяє
+	// використати власний сервер Bot API
+	telegramDefaultAPI = "https://api.telegram.org"
+	// telegramPollTimeout – тривалість довгого опитування getUpdates, с
+	telegramPollTimeout = 30
+)
+
+type (
+	// telegramBot – мінімальний клієнт Telegram Bot API: довге опитування
+	// оновлень, повідомлення з вбудованою клавіатурою та відповіді на натискання
+	telegramBot struct {
+		api    string
+		client *http.Client
+		offset int64
+	}
+
+	tgUpdate struct {
+		UpdateID      int64       `json:"update_id"`
+		Message       *tgMessage  `json:"message"`
+		CallbackQuery *tgCallback `json:"callback_query"`
+	}
+
+	tgMessage struct {
+		MessageID int64  `json:"message_id"`
+		Chat      tgChat `json:"chat"`
+		Text      string `json:"text"`
+	}
+
+	tgChat struct {
+		ID int64 `json:"id"`
+	}
+
+	tgCallback struct {
+		ID      string     `json:"id"`
+		Message *tgMessage `json:"message"`
+		Data    string     `json:"data"`
+	}
+
+	tgButton struct {
+		Text string `json:"text"`
+		Data string `json:"callback_data"`
+	}
+
+	tgKeyboard struct {
+		Rows [][]tgButton `json:"inline_keyboard"`
+	}
+
+	// tgConversation – стан розмови з експертом: альтернативи, які він уже
+	// поставив на місця 1, 2, …
+	tgConversation struct {
+		expert string
+		order  []int
+	}
+)
+
+func newTelegramBot(token string) *telegramBot {
+	api := os.Getenv("TPR_TELEGRAM_API")
+	if api == "" {
+		api = telegramDefaultAPI
+	}
+	return &telegramBot{
+		api:    strings.TrimSuffix(api, "/") + "/bot" + token + "/",
+		client: &http.Client{Timeout: (telegramPollTimeout + 10) * time.Second},
+	}
+}
+
+// call викликає метод Bot API з параметрами у форматі JSON і розбирає поле result
+func (b *telegramBot) call(method string, params, result any) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.Post(b.api+method, "application/json", bytes.NewReader(body))
+	if err != nil {
+		// *url.Error містить адресу запиту разом із токеном бота, тому
+		// повідомлення будується лише з назви методу й причини
+		var uerr *url.Error
+		if errors.As(err, &uerr) {
+			err = uerr.Err
+		}
+		return fmt.Errorf(tr(errTelegramAPI), method, err)
+	}
+	defer resp.Body.Close()
+
+	var reply struct {
+		OK          bool            `json:"ok"`
+		Description string          `json:"description"`
+		Result      json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return err
+	}
+	if !reply.OK {
+		return fmt.Errorf(tr(errTelegramAPI), method, reply.Description)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(reply.Result, result)
+}
+
+func (b *telegramBot) updates() ([]tgUpdate, error) {
+	var updates []tgUpdate
+	err := b.call("getUpdates", map[string]any{
+		"offset":          b.offset,
+		"timeout":         telegramPollTimeout,
+		"allowed_updates": []string{"message", "callback_query"},
+	}, &updates)
+	for _, u := range updates {
+		b.offset = max(b.offset, u.UpdateID+1)
+	}
+	return updates, err
+}
+
+func (b *telegramBot) send(chat int64, text string, keyboard *tgKeyboard) error {
+	params := map[string]any{"chat_id": chat, "text": text}
+	if keyboard != nil {
+		params["reply_markup"] = keyboard
+	}
+	return b.call("sendMessage", params, nil)
+}
+
+// edit замінює текст і клавіатуру повідомлення, щоб розмова не розросталася
+func (b *telegramBot) edit(msg *tgMessage, text string, keyboard *tgKeyboard) error {
+	params := map[string]any{"chat_id": msg.Chat.ID, "message_id": msg.MessageID, "text": text}
+	if keyboard != nil {
+		params["reply_markup"] = keyboard
+	}
+	return b.call("editMessageText", params, nil)
+}
+
+// keyboard розташовує кнопки по одній у рядку; дані кнопки – prefix і номер
+func keyboard(prefix string, labels []string, indexes []int) *tgKeyboard {
+	k := &tgKeyboard{}
+	for _, i := range indexes {
+		k.Rows = append(k.Rows, []tgButton{{Text: labels[i], Data: prefix + strconv.Itoa(i)}})
+	}
+	return k
+}
+
+// chatOf повертає чат Telegram, прив'язаний до експерта
+func (c *collection) chatOf(expert string) (string, bool) {
+	for chat, e := range c.Chats {
+		if e == expert {
+			return chat, true
+		}
+	}
+	return "", false
+}
+
+// bind прив'язує чат до експерта за токеном з особистого посилання t.me/<бот>?start=<токен>.
+// Токен, уже використаний в іншому чаті, повторно не приймається.
+func (c *collection) bind(chat, token string) (string, error) {
+	expert, ok := c.Tokens[token]
+	if !ok {
+		return "", errors.New(tr(errTelegramLink))
+	}
+	if bound, ok := c.chatOf(expert); ok && bound != chat {
+		return "", errors.New(tr(errTelegramTaken))
+	}
+	if c.Chats[chat] == expert {
+		return expert, nil
+	}
+	c.Chats[chat] = expert
+	if err := c.save(); err != nil {
+		fmt.Printf(tr(errSessionSave), err)
+	}
+	fmt.Printf(tr("Експерт %s зареєструвався в боті\n"), expert)
+	return expert, nil
+}
+
+// rankPrompt повертає питання про наступне місце та кнопки альтернатив, які ще не мають місця
+func (c *collection) rankPrompt(conv *tgConversation) (string, *tgKeyboard) {
+	var left []int
+	for i := range c.Alternatives {
+		if !slices.Contains(conv.order, i) {
+			left = append(left, i)
+		}
+	}
+	return fmt.Sprintf(tr(promptTelegramPlace), conv.expert, len(conv.order)+1, len(c.Alternatives)),
+		keyboard("a", c.Alternatives, left)
+}
+
+// handleTelegram обробляє одне оновлення: /start [токен] або вибір альтернативи
+func (c *collection) handleTelegram(b *telegramBot, u tgUpdate, convs map[int64]*tgConversation) error {
+	if m := u.Message; m != nil {
+		chat := strconv.FormatInt(m.Chat.ID, 10)
+		expert, ok := c.Chats[chat]
+		command, token, _ := strings.Cut(strings.TrimSpace(m.Text), " ")
+		switch {
+		case command != "/start" && ok:
+			return b.send(m.Chat.ID, tr(promptTelegramStart), nil)
+		case command != "/start":
+			return b.send(m.Chat.ID, tr(errTelegramLink), nil)
+		case token != "":
+			var err error
+			if expert, err = c.bind(chat, strings.TrimSpace(token)); err != nil {
+				return b.send(m.Chat.ID, err.Error(), nil)
+			}
+		case !ok:
+			return b.send(m.Chat.ID, tr(errTelegramLink), nil)
+		}
+		convs[m.Chat.ID] = &tgConversation{expert: expert}
+		text, k := c.rankPrompt(convs[m.Chat.ID])
+		return b.send(m.Chat.ID, fmt.Sprintf(tr(promptTelegramWho), expert, strings.Join(c.Alternatives, ", "))+"\n\n"+text, k)
+	}
+
+	q := u.CallbackQuery
+	if q == nil || q.Message == nil {
+		return nil
+	}
+	// дані кнопки надсилає клієнт, тому індекс перевіряється до використання:
+	// підроблене «a-1» чи «a99» отримує відповідь з помилкою
+	data, ok := strings.CutPrefix(q.Data, "a")
+	n, err := strconv.ParseUint(data, 10, 0)
+	if !ok || err != nil || n >= uint64(len(c.Alternatives)) {
+		return b.call("answerCallbackQuery", map[string]any{
+			"callback_query_id": q.ID, "text": tr(errTelegramChoice), "show_alert": true,
+		}, nil)
+	}
+	b.call("answerCallbackQuery", map[string]any{"callback_query_id": q.ID}, nil)
+	chatID, i := q.Message.Chat.ID, int(n)
+
+	conv := convs[chatID]
+	if conv == nil || slices.Contains(conv.order, i) {
+		return b.edit(q.Message, tr(promptTelegramStart), nil)
+	}
+	conv.order = append(conv.order, i)
+	if len(conv.order) == len(c.Alternatives) {
+		delete(convs, chatID)
+		if c.complete() {
+			return b.edit(q.Message, tr(errCollectClosed), nil)
+		}
+		ranks := make([]int, len(c.Alternatives))
+		names := make([]string, len(conv.order))
+		for place, alt := range conv.order {
+			ranks[alt] = place + 1
+			names[place] = c.Alternatives[alt]
+		}
+		c.Ranks[conv.expert], c.Submitted[conv.expert] = ranks, time.Now()
+		if err := c.save(); err != nil {
+			fmt.Printf(tr(errSessionSave), err)
+		}
+		fmt.Printf(tr("Отримано ранжування від експерта %s (%d з %d)\n"), conv.expert, len(c.Ranks), len(c.Experts))
+		if c.complete() {
+			close(c.done)
+		}
+		return b.edit(q.Message, fmt.Sprintf(tr(promptTelegramDone), strings.Join(names, " ≻ ")), nil)
+	}
+
+	text, k := c.rankPrompt(convs[chatID])
+	return b.edit(q.Message, text, k)
+}
+
+// collectTelegram збирає ранжування через Telegram-бота: експерт відкриває бота за
+// особистим посиланням t.me/<бот>?start=<токен> (токени ті самі, що й у режимі -collect),
+// що прив'язує його чат до експерта, і по черзі ставить альтернативи на місця 1…n.
+// Відповіді зберігаються в тому самому файлі, що й у режимі -collect.
+func collectTelegram(token, storePath string, alts, experts []string) (*ParetoSystem, error) {
+	if token == "" {
+		return nil, errors.New(tr(errTelegramToken))
+	}
+	if len(alts) < 2 || len(experts) == 0 {
+		return nil, errors.New(tr(errCollectList))
+	}
+	c, err := openCollection(storePath, alts, experts)
+	if err != nil {
+		return nil, err
+	}
+	if c.Chats == nil {
+		c.Chats = make(map[string]string)
+	}
+
+	b := newTelegramBot(token)
+	var me struct {
+		Username string `json:"username"`
+	}
+	if err := b.call("getMe", map[string]any{}, &me); err != nil {
+		return nil, err
+	}
+	fmt.Printf(tr("\nБот @%s чекає на ранжування\nПосилання для експертів:\n"), me.Username)
+	for _, e := range experts {
+		mark := ""
+		if _, ok := c.Ranks[e]; ok {
+			mark = tr(" (відповідь отримано)")
+		}
+		fmt.Printf("  %s: https://t.me/%s?start=%s%s\n", e, me.Username, c.tokenOf(e), mark)
+	}
+
+	convs := make(map[int64]*tgConversation)
+	for !c.complete() {
+		updates, err := b.updates()
+		if err != nil {
+			fmt.Println(err)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		for _, u := range updates {
+			if err := c.handleTelegram(b, u, convs); err != nil {
+				fmt.Println(err)
+			}
+		}
+	}
+	// Підтверджуємо останні оновлення, щоб бот не отримав їх повторно
+	b.call("getUpdates", map[string]any{"offset": b.offset, "timeout": 0}, nil)
+	fmt.Println(tr("\nУсі експерти відповіли, збір завершено"))
+	return c.paretoSystem(), nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestTelegramCallbackData перевіряє, що підроблені дані кнопки не призводять до
+// паніки, а отримують відповідь з помилкою й не змінюють стан розмови
+func TestTelegramCallbackData(t *testing.T) {
+	var answers []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]any
+		json.NewDecoder(r.Body).Decode(&params)
+		if r.URL.Path == "/bottest/answerCallbackQuery" {
+			answers = append(answers, params)
+		}
+		w.Write([]byte(`{"ok":true,"result":{}}`))
+	}))
+	defer srv.Close()
+	t.Setenv("TPR_TELEGRAM_API", srv.URL)
+	b := newTelegramBot("test")
+
+	c := &collection{
+		Alternatives: []string{"A", "B"}, Experts: []string{"E"},
+		Ranks: map[string][]int{}, Submitted: map[string]time.Time{}, done: make(chan struct{}),
+	}
+	msg := &tgMessage{MessageID: 1, Chat: tgChat{ID: 7}}
+	for _, data := range []string{"a-1", "a2", "a", "b0", "a+1", "a18446744073709551616", ""} {
+		convs := map[int64]*tgConversation{7: {expert: "E", order: []int{0}}}
+		answers = nil
+		if err := c.handleTelegram(b, tgUpdate{CallbackQuery: &tgCallback{ID: "q", Message: msg, Data: data}}, convs); err != nil {
+			t.Fatalf("%q: %v", data, err)
+		}
+		if len(answers) != 1 || answers[0]["text"] != tr(errTelegramChoice) {
+			t.Errorf("%q: відповідь %v, потрібна помилка вибору", data, answers)
+		}
+		if order := convs[7].order; len(order) != 1 || len(c.Ranks) != 0 {
+			t.Errorf("%q: змінено стан розмови %v, ранги %v", data, order, c.Ranks)
+		}
+	}
+
+	// Коректний вибір завершує ранжування
+	convs := map[int64]*tgConversation{7: {expert: "E", order: []int{0}}}
+	if err := c.handleTelegram(b, tgUpdate{CallbackQuery: &tgCallback{ID: "q", Message: msg, Data: "a1"}}, convs); err != nil {
+		t.Fatal(err)
+	}
+	if r := c.Ranks["E"]; len(r) != 2 || r[0] != 1 || r[1] != 2 {
+		t.Errorf("ранги %v, потрібно [1 2]", r)
+	}
+}
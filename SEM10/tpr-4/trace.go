@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Структури журналу обчислень для експорту в JSON: ранжування експертів,
+// попарні порівняння з рішенням про домінування та множина Парето
+type (
+	Trace struct {
+		Task        string            `json:"task"`
+		Inputs      traceInputs       `json:"inputs"`
+		Comparisons []traceComparison `json:"comparisons"`
+		Dominated   []traceDominated  `json:"dominated"`
+		Pareto      []string          `json:"pareto"`
+	}
+
+	// traceInputs – ранги альтернатив: Ranks[i][k] – ранг альтернативи i в експерта k
+	traceInputs struct {
+		Experts      []string `json:"experts"`
+		Alternatives []string `json:"alternatives"`
+		Ranks        [][]int  `json:"ranks"`
+	}
+
+	traceComparison struct {
+		A         string       `json:"a"`
+		B         string       `json:"b"`
+		Ranks     []traceRanks `json:"ranks"`
+		Dominates bool         `json:"dominates"`
+	}
+
+	traceRanks struct {
+		Expert string `json:"expert"`
+		A      int    `json:"a"`
+		B      int    `json:"b"`
+	}
+
+	traceDominated struct {
+		Alternative string   `json:"alternative"`
+		By          []string `json:"by"`
+	}
+)
+
+// Trace збирає повний хід побудови відношення домінування та множини Парето
+func (p *ParetoSystem) Trace() *Trace {
+	t := &Trace{
+		Task:   reportTitle,
+		Inputs: traceInputs{Experts: p.experts, Alternatives: p.alts},
+		Pareto: p.ParetoSet(),
+	}
+	for _, a := range p.alts {
+		ranks := make([]int, len(p.experts))
+		for k, e := range p.experts {
+			ranks[k] = p.rankings[e][a]
+		}
+		t.Inputs.Ranks = append(t.Inputs.Ranks, ranks)
+	}
+
+	for _, a1 := range p.alts {
+		var by []string
+		for _, a2 := range p.alts {
+			if a1 == a2 {
+				continue
+			}
+			c := traceComparison{A: a1, B: a2, Dominates: p.dominance[a1][a2]}
+			for _, e := range p.experts {
+				c.Ranks = append(c.Ranks, traceRanks{e, p.rankings[e][a1], p.rankings[e][a2]})
+			}
+			t.Comparisons = append(t.Comparisons, c)
+
+			if p.dominance[a2][a1] {
+				by = append(by, a2)
+			}
+		}
+		if len(by) > 0 {
+			t.Dominated = append(t.Dominated, traceDominated{a1, by})
+		}
+	}
+	return t
+}
+
+func (t *Trace) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const xlsxResultsSheet = "Результати"
+
+// loadXLSX зчитує ранжування експертів з аркуша Excel: перший рядок – імена експертів,
+// далі в кожному рядку назва альтернативи та її ранги від кожного експерта (1…n).
+// Якщо аркуш не вказано, використовується перший аркуш книги.
+func loadXLSX(path, sheet string) (*ParetoSystem, error) {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 || len(rows[0]) < 2 {
+		return nil, fmt.Errorf(tr(errXLSXEmpty), sheet)
+	}
+
+	p := &ParetoSystem{
+		experts:   rows[0][1:],
+		rankings:  make(map[string]map[string]int),
+		dominance: make(map[string]map[string]bool),
+	}
+	for _, e := range p.experts {
+		p.rankings[e] = make(map[string]int)
+	}
+
+	type cellRef struct {
+		name, value string
+	}
+	var cells [][]cellRef
+	for i, row := range rows[1:] {
+		if len(row) == 0 || row[0] == "" {
+			continue
+		}
+		refs := make([]cellRef, len(p.experts))
+		for j := range refs {
+			refs[j].name, _ = excelize.CoordinatesToCellName(j+2, i+2)
+			if j+1 < len(row) {
+				refs[j].value = row[j+1]
+			}
+		}
+		p.alts = append(p.alts, row[0])
+		cells = append(cells, refs)
+	}
+	if len(p.alts) == 0 {
+		return nil, fmt.Errorf(tr(errXLSXEmpty), sheet)
+	}
+
+	// Ранги перевіряються після зчитування всіх альтернатив, оскільки їх кількість задає межу
+	for i, a := range p.alts {
+		if _, ok := p.rankings[p.experts[0]][a]; ok {
+			return nil, fmt.Errorf(tr(errXLSXDuplicate), a)
+		}
+		for j, e := range p.experts {
+			rank, err := strconv.Atoi(cells[i][j].value)
+			if err != nil || rank < 1 || rank > len(p.alts) {
+				return nil, fmt.Errorf(tr(errXLSXCell), sheet, cells[i][j].name, cells[i][j].value, len(p.alts))
+			}
+			p.rankings[e][a] = rank
+		}
+	}
+	return p, nil
+}
+
+// SaveXLSX записує таблиці звіту на аркуш результатів. Якщо файл уже існує
+// (наприклад, це книга з вхідними даними), аркуш результатів у ньому замінюється,
+// а решта аркушів залишається без змін.
+func (r *Report) SaveXLSX(path string) error {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		f = excelize.NewFile()
+	}
+	defer f.Close()
+
+	if idx, _ := f.GetSheetIndex(xlsxResultsSheet); idx >= 0 {
+		if err := f.DeleteSheet(xlsxResultsSheet); err != nil {
+			return err
+		}
+	}
+	idx, err := f.NewSheet(xlsxResultsSheet)
+	if err != nil {
+		return err
+	}
+	f.SetActiveSheet(idx)
+	// Порожній аркуш за замовчуванням нової книги не потрібен
+	if sheet := "Sheet1"; f.GetSheetName(0) == sheet && len(f.GetSheetList()) > 1 {
+		if rows, _ := f.GetRows(sheet); len(rows) == 0 {
+			f.DeleteSheet(sheet)
+		}
+	}
+
+	line := 1
+	for _, t := range r.Tables {
+		if err := f.SetCellValue(xlsxResultsSheet, fmt.Sprintf("A%d", line), t.Title); err != nil {
+			return err
+		}
+		line++
+
+		rows := append([][]string{t.Header}, t.Rows...)
+		for _, row := range rows {
+			for j, cell := range row {
+				name, _ := excelize.CoordinatesToCellName(j+1, line)
+				var value any = cell
+				if v, err := strconv.ParseFloat(cell, 64); err == nil {
+					value = v
+				}
+				if err := f.SetCellValue(xlsxResultsSheet, name, value); err != nil {
+					return err
+				}
+			}
+			line++
+		}
+		line++
+	}
+
+	return f.SaveAs(path)
+}
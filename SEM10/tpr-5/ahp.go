@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	aggregateJudgments = iota + 1
+	aggregatePriorities
+)
+
+// randomIndex – випадкові індекси узгодженості Сааті для n = 1…10
+var randomIndex = []float64{0, 0, 0.58, 0.90, 1.12, 1.24, 1.32, 1.41, 1.45, 1.49}
+
+// readRatio зчитує оцінку шкали Сааті у вигляді числа або дробу (наприклад, 1/3)
+func (ir *inputReader) readRatio(prompt string) float64 {
+	for {
+		str, err := ir.readString(prompt)
+		if err != nil {
+			inputClosed()
+		}
+
+		num, den, isFraction := strings.Cut(str, "/")
+		v, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+		if err == nil && isFraction {
+			var d float64
+			d, err = strconv.ParseFloat(strings.TrimSpace(den), 64)
+			// Ділення на нуль дає нескінченність або NaN, які не проходять перевірку шкали
+			if err == nil {
+				v /= d
+			}
+		}
+		if err == nil && v >= 1.0/9-1e-9 && v <= 9 {
+			return v
+		}
+		fmt.Println(errInvalidValue)
+	}
+}
+
+// readPairwiseMatrix зчитує верхній трикутник оберненосиметричної матриці парних
+// порівнянь елементів names (критеріїв або вузлів ієрархії критеріїв)
+func (ir *inputReader) readPairwiseMatrix(names []string) [][]float64 {
+	n := len(names)
+	a := make([][]float64, n)
+	for i := range n {
+		a[i] = make([]float64, n)
+		a[i][i] = 1
+	}
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			a[i][j] = ir.readRatio(fmt.Sprintf(promptPairwise, names[i], names[j]))
+			a[j][i] = 1 / a[i][j]
+		}
+	}
+	return a
+}
+
+// AHPPriorities знаходить вектор пріоритетів як головний власний вектор матриці
+// парних порівнянь (рушієм -engine) та її максимальне власне значення λmax
+func AHPPriorities(a [][]float64) (weights []float64, lambdaMax float64) {
+	n := len(a)
+	weights = numeric.PrincipalEigenvector(a)
+
+	for i := range n {
+		aw := 0.0
+		for j := range n {
+			aw += a[i][j] * weights[j]
+		}
+		lambdaMax += aw / weights[i]
+	}
+	return weights, lambdaMax / float64(n)
+}
+
+// AHPConsistencyRatio розраховує відношення узгодженості CR = CI / RI,
+// де CI = (λmax - n) / (n - 1); значення до 0.1 вважаються прийнятними
+func AHPConsistencyRatio(lambdaMax float64, n int) float64 {
+	if n < 3 || n > len(randomIndex) {
+		return 0
+	}
+	ci := (lambdaMax - float64(n)) / float64(n-1)
+	return ci / randomIndex[n-1]
+}
+
+// AggregateJudgments об'єднує матриці експертів поелементним середнім геометричним (AIJ);
+// результат залишається оберненосиметричним
+func AggregateJudgments(matrices [][][]float64) [][]float64 {
+	n := len(matrices[0])
+	group := make([][]float64, n)
+	for i := range n {
+		group[i] = make([]float64, n)
+		for j := range n {
+			logSum := 0.0
+			for _, a := range matrices {
+				logSum += math.Log(a[i][j])
+			}
+			group[i][j] = math.Exp(logSum / float64(len(matrices)))
+		}
+	}
+	return group
+}
+
+// AggregatePriorities об'єднує вектори пріоритетів експертів нормованим
+// середнім геометричним (AIP)
+func AggregatePriorities(priorities [][]float64) []float64 {
+	n := len(priorities[0])
+	group := make([]float64, n)
+	sum := 0.0
+	for j := range n {
+		logSum := 0.0
+		for _, w := range priorities {
+			logSum += math.Log(w[j])
+		}
+		group[j] = math.Exp(logSum / float64(len(priorities)))
+		sum += group[j]
+	}
+	for j := range group {
+		group[j] /= sum
+	}
+	return group
+}
+
+// readAHPWeights зчитує матриці парних порівнянь експертів і повертає
+// групові ваги критеріїв та групове відношення узгодженості
+func (m *MCDMSystem) readAHPWeights(ir *inputReader) ([]float64, float64) {
+	n := len(m.criteria)
+	experts := ir.readIntInRange(promptExpertCount, 1, 100)
+
+	matrices := make([][][]float64, experts)
+	priorities := make([][]float64, experts)
+	ratios := make([]float64, experts)
+	for e := range experts {
+		fmt.Printf(promptExpertMatrix, e+1)
+		matrices[e] = ir.readPairwiseMatrix(m.criterionNames())
+
+		var lambdaMax float64
+		priorities[e], lambdaMax = AHPPriorities(matrices[e])
+		ratios[e] = AHPConsistencyRatio(lambdaMax, n)
+	}
+	m.PrintExpertPriorities(priorities, ratios)
+
+	group := AggregateJudgments(matrices)
+	weights, lambdaMax := AHPPriorities(group)
+	if experts > 1 && ir.readChoice(promptAHPAggregation, aggregatePriorities) == aggregatePriorities {
+		weights = AggregatePriorities(priorities)
+	}
+	return weights, AHPConsistencyRatio(lambdaMax, n)
+}
+
+func (m *MCDMSystem) PrintExpertPriorities(priorities [][]float64, ratios []float64) {
+	fmt.Println("\nПріоритети критеріїв за оцінками експертів:")
+	fmt.Printf(headerFormat, "Експерт")
+	for _, c := range m.criteria {
+		fmt.Printf(critHeaderFormat, c.name)
+	}
+	fmt.Printf(critHeaderFormat, "CR")
+	fmt.Println()
+
+	for e, w := range priorities {
+		fmt.Printf(headerFormat, fmt.Sprintf("Експерт %d", e+1))
+		for _, v := range w {
+			fmt.Printf(weightFormat, v)
+		}
+		fmt.Printf(weightFormat, ratios[e])
+		if ratios[e] > 0.1 {
+			fmt.Print("неузгоджено")
+		}
+		fmt.Println()
+	}
+}
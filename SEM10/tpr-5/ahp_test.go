@@ -0,0 +1,41 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAHPPriorities(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		a       [][]float64
+		weights []float64
+		lambda  float64
+		cr      float64
+	}{
+		// a_ij = w_i / w_j для w = (0.5, 0.3, 0.2): λmax = n, CR = 0
+		{"узгоджена", [][]float64{{1, 5.0 / 3, 2.5}, {0.6, 1, 1.5}, {0.4, 2.0 / 3, 1}}, []float64{0.5, 0.3, 0.2}, 3, 0},
+		{"Сааті", [][]float64{{1, 3, 5}, {1.0 / 3, 1, 3}, {1.0 / 5, 1.0 / 3, 1}},
+			[]float64{0.6369855717447571, 0.25828499437449504, 0.10472943388074787}, 3.0385110905581705, 0.03319921599842283},
+		{"два елементи", [][]float64{{1, 3}, {1.0 / 3, 1}}, []float64{0.75, 0.25}, 2, 0},
+	} {
+		weights, lambda := AHPPriorities(tt.a)
+		cr := AHPConsistencyRatio(lambda, len(tt.a))
+		if !closeTo(weights, tt.weights, 1e-6) || math.Abs(lambda-tt.lambda) > 1e-6 || math.Abs(cr-tt.cr) > 1e-6 {
+			t.Errorf("%s: пріоритети %v, λmax %v, CR %v; очікувалося %v, %v, %v", tt.name, weights, lambda, cr, tt.weights, tt.lambda, tt.cr)
+		}
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	// Середнє геометричне 2 і 8 – 4, а 1/2 і 1/8 – 1/4: матриця залишається оберненосиметричною
+	group := AggregateJudgments([][][]float64{{{1, 2}, {0.5, 1}}, {{1, 8}, {0.125, 1}}})
+	if !closeTo(group[0], []float64{1, 4}, 1e-12) || !closeTo(group[1], []float64{0.25, 1}, 1e-12) {
+		t.Errorf("AggregateJudgments = %v", group)
+	}
+
+	// √(0.8·0.2) = 0.4 і √(0.2·0.8) = 0.4 після нормування дають (0.5, 0.5)
+	if got := AggregatePriorities([][]float64{{0.8, 0.2}, {0.2, 0.8}}); !closeTo(got, []float64{0.5, 0.5}, 1e-12) {
+		t.Errorf("AggregatePriorities = %v", got)
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+type (
+	// FuzzyNumber – трикутне нечітке число (l, m, u)
+	FuzzyNumber struct {
+		l, m, u float64
+	}
+
+	// LinguisticTerm зіставляє лінгвістичну оцінку з нечітким числом
+	LinguisticTerm struct {
+		name  string
+		value FuzzyNumber
+	}
+)
+
+// Шкали Чена (2000) для ваг критеріїв та оцінок альтернатив
+var (
+	weightTerms = []LinguisticTerm{
+		{"Дуже низька", FuzzyNumber{0, 0, 0.1}},
+		{"Низька", FuzzyNumber{0, 0.1, 0.3}},
+		{"Нижче середньої", FuzzyNumber{0.1, 0.3, 0.5}},
+		{"Середня", FuzzyNumber{0.3, 0.5, 0.7}},
+		{"Вище середньої", FuzzyNumber{0.5, 0.7, 0.9}},
+		{"Висока", FuzzyNumber{0.7, 0.9, 1}},
+		{"Дуже висока", FuzzyNumber{0.9, 1, 1}},
+	}
+
+	ratingTerms = []LinguisticTerm{
+		{"Дуже погано", FuzzyNumber{0, 0, 1}},
+		{"Погано", FuzzyNumber{0, 1, 3}},
+		{"Нижче середнього", FuzzyNumber{1, 3, 5}},
+		{"Середньо", FuzzyNumber{3, 5, 7}},
+		{"Вище середнього", FuzzyNumber{5, 7, 9}},
+		{"Добре", FuzzyNumber{7, 9, 10}},
+		{"Дуже добре", FuzzyNumber{9, 10, 10}},
+	}
+)
+
+func (f FuzzyNumber) mul(g FuzzyNumber) FuzzyNumber {
+	return FuzzyNumber{f.l * g.l, f.m * g.m, f.u * g.u}
+}
+
+// defuzzify повертає центр ваги трикутного числа
+func (f FuzzyNumber) defuzzify() float64 {
+	return (f.l + f.m + f.u) / 3
+}
+
+func (f FuzzyNumber) String() string {
+	return fmt.Sprintf("(%.2f; %.2f; %.2f)", f.l, f.m, f.u)
+}
+
+// vertexDistance – вершинна відстань між трикутними числами
+func vertexDistance(a, b FuzzyNumber) float64 {
+	dl, dm, du := a.l-b.l, a.m-b.m, a.u-b.u
+	return math.Sqrt((dl*dl + dm*dm + du*du) / 3)
+}
+
+// printTerms виводить перелік лінгвістичних термів для вибору
+func printTerms(title string, terms []LinguisticTerm) {
+	fmt.Printf("\n%s:\n", title)
+	for i, t := range terms {
+		fmt.Printf("%d) %-20s %s\n", i+1, t.name, t.value)
+	}
+}
+
+// CollectFuzzyRatings зчитує лінгвістичні оцінки альтернатив та ваги критеріїв
+func (m *MCDMSystem) CollectFuzzyRatings(ir *inputReader) {
+	printTerms("Лінгвістичні оцінки важливості критеріїв", weightTerms)
+	m.fuzzyWeights = make([]int, len(m.criteria))
+	for j, c := range m.criteria {
+		prompt := fmt.Sprintf(promptFuzzyWeight, c.name, len(weightTerms))
+		m.fuzzyWeights[j] = ir.readChoice(prompt, len(weightTerms)) - 1
+	}
+
+	printTerms("Лінгвістичні оцінки альтернатив", ratingTerms)
+	m.fuzzyRatings = make([][]int, len(m.alternatives))
+	for i, alt := range m.alternatives {
+		fmt.Printf(promptAltValues, alt)
+		m.fuzzyRatings[i] = make([]int, len(m.criteria))
+		for j, c := range m.criteria {
+			prompt := fmt.Sprintf(promptFuzzyRating, c.name, len(ratingTerms))
+			m.fuzzyRatings[i][j] = ir.readChoice(prompt, len(ratingTerms)) - 1
+		}
+	}
+}
+
+func (m *MCDMSystem) PrintFuzzyRatings() {
+	fmt.Println("\nЛінгвістична матриця оцінок:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for _, c := range m.criteria {
+		fmt.Printf(termHeaderFormat, c.name)
+	}
+	fmt.Println()
+
+	fmt.Printf(headerFormat, "Вага")
+	for _, w := range m.fuzzyWeights {
+		fmt.Printf(termHeaderFormat, weightTerms[w].name)
+	}
+	fmt.Println()
+
+	for i, alt := range m.alternatives {
+		fmt.Printf(headerFormat, alt)
+		for _, r := range m.fuzzyRatings[i] {
+			fmt.Printf(termHeaderFormat, ratingTerms[r].name)
+		}
+		fmt.Println()
+	}
+}
+
+// CalculateFuzzyTOPSIS розраховує нечіткий TOPSIS за Ченом.
+// Оцінки критеріїв максимізації нормуються як (l/u*, m/u*, u/u*),
+// критеріїв мінімізації – як (1 - u/u*, 1 - m/u*, 1 - l/u*), де u* – максимальна
+// верхня межа у стовпці. Нормовані оцінки множаться на нечіткі ваги, після чого
+// рахуються відстані до нечітких ідеального (1, 1, 1) та антиідеального (0, 0, 0)
+// розв'язків і коефіцієнт близькості CC = d- / (d* + d-).
+func (m *MCDMSystem) CalculateFuzzyTOPSIS() (dIdeal, dAnti, closeness []float64) {
+	rows := len(m.alternatives)
+	dIdeal = make([]float64, rows)
+	dAnti = make([]float64, rows)
+	closeness = make([]float64, rows)
+
+	ideal := FuzzyNumber{1, 1, 1}
+	antiIdeal := FuzzyNumber{0, 0, 0}
+
+	for j, c := range m.criteria {
+		upper := 0.0
+		for i := range rows {
+			upper = math.Max(upper, ratingTerms[m.fuzzyRatings[i][j]].value.u)
+		}
+
+		weight := weightTerms[m.fuzzyWeights[j]].value
+		for i := range rows {
+			r := ratingTerms[m.fuzzyRatings[i][j]].value
+			var norm FuzzyNumber
+			if c.benefit {
+				norm = FuzzyNumber{r.l / upper, r.m / upper, r.u / upper}
+			} else {
+				norm = FuzzyNumber{1 - r.u/upper, 1 - r.m/upper, 1 - r.l/upper}
+			}
+
+			v := norm.mul(weight)
+			dIdeal[i] += vertexDistance(v, ideal)
+			dAnti[i] += vertexDistance(v, antiIdeal)
+		}
+	}
+
+	for i := range rows {
+		closeness[i] = dAnti[i] / (dIdeal[i] + dAnti[i])
+	}
+	return dIdeal, dAnti, closeness
+}
+
+func (m *MCDMSystem) PrintFuzzyWeights() {
+	fmt.Println("\nНечіткі ваги критеріїв:")
+	fmt.Printf(headerFormat, "Критерій")
+	fmt.Printf(fuzzyHeaderFormat, "Вага (l; m; u)")
+	fmt.Printf(critHeaderFormat, "Дефазифікована")
+	fmt.Println()
+
+	for j, c := range m.criteria {
+		w := weightTerms[m.fuzzyWeights[j]].value
+		fmt.Printf(headerFormat, c.name)
+		fmt.Printf(fuzzyHeaderFormat, w)
+		fmt.Printf(weightFormat, w.defuzzify())
+		fmt.Println()
+	}
+}
+
+func (m *MCDMSystem) PrintFuzzyDistances(dIdeal, dAnti, closeness []float64) {
+	fmt.Println("\nВідстані до нечітких ідеального та антиідеального розв'язків:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	fmt.Printf(critHeaderFormat, "d*")
+	fmt.Printf(critHeaderFormat, "d-")
+	fmt.Printf(critHeaderFormat, "CC")
+	fmt.Println()
+
+	for i, alt := range m.alternatives {
+		fmt.Printf(headerFormat, alt)
+		fmt.Printf(weightFormat, dIdeal[i])
+		fmt.Printf(weightFormat, dAnti[i])
+		fmt.Printf(weightFormat, closeness[i])
+		fmt.Println()
+	}
+}
@@ -0,0 +1,14 @@
+module tpr-5
+
+go 1.22.0
+
+require (
+	gonum.org/v1/gonum v0.15.1
+	tprlib v0.0.0-00010101000000-000000000000
+	tprtest v0.0.0-00010101000000-000000000000
+)
+
+replace (
+	tprlib => ../tprlib
+	tprtest => ../tprtest
+)
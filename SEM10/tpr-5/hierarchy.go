@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	levelManual = iota + 1
+	levelPairwise
+	levelRanks
+)
+
+// CriteriaNode – вузол ієрархії критеріїв «мета → критерії → підкритерії».
+// Листки відповідають стовпцям матриці рішень (leaf – індекс стовпця, для
+// внутрішніх вузлів -1); weight – локальна вага вузла серед сусідів одного батька.
+type CriteriaNode struct {
+	name     string
+	weight   float64
+	leaf     int
+	children []*CriteriaNode
+}
+
+// GlobalWeights поширює ваги ієрархії мультиплікативно: глобальна вага листка
+// дорівнює добутку локальних ваг на шляху від мети. Результат упорядковано за
+// стовпцями матриці, тож його можна використовувати в SAW, TOPSIS і MAUT як звичайні ваги.
+func (n *CriteriaNode) GlobalWeights(count int) []float64 {
+	weights := make([]float64, count)
+	var walk func(node *CriteriaNode, w float64)
+	walk = func(node *CriteriaNode, w float64) {
+		if node.leaf >= 0 {
+			weights[node.leaf] = w
+			return
+		}
+		for _, c := range node.children {
+			walk(c, w*c.weight)
+		}
+	}
+	walk(n, 1)
+	return weights
+}
+
+// readHierarchy зчитує групи критеріїв верхнього рівня, розподіл критеріїв матриці
+// між ними та локальні ваги кожного рівня; групи з одним критерієм не утворюють
+// окремого рівня
+func (m *MCDMSystem) readHierarchy(ir *inputReader) *CriteriaNode {
+	goal := &CriteriaNode{name: "Мета", leaf: -1}
+	count := ir.readIntInRange(promptGroupCount, 1, len(m.criteria))
+	for g := range count {
+		name, _ := ir.readString(fmt.Sprintf(promptGroupName, g+1))
+		goal.children = append(goal.children, &CriteriaNode{name: name, leaf: -1})
+	}
+
+	fmt.Println("\nГрупи критеріїв:")
+	for g, group := range goal.children {
+		fmt.Printf("%d) %s\n", g+1, group.name)
+	}
+	for {
+		for _, group := range goal.children {
+			group.children = nil
+		}
+		for j, c := range m.criteria {
+			g := ir.readChoice(fmt.Sprintf(promptCritGroup, c.name, count), count) - 1
+			goal.children[g].children = append(goal.children[g].children, &CriteriaNode{name: c.name, leaf: j})
+		}
+		empty := false
+		for _, group := range goal.children {
+			empty = empty || len(group.children) == 0
+		}
+		if !empty {
+			break
+		}
+		fmt.Println(errEmptyGroup)
+	}
+
+	// Група з одного критерію – це сам критерій
+	for g, group := range goal.children {
+		if len(group.children) == 1 {
+			goal.children[g] = group.children[0]
+		}
+	}
+
+	ir.readLevelWeights(goal)
+	for _, group := range goal.children {
+		if len(group.children) > 0 {
+			ir.readLevelWeights(group)
+		}
+	}
+	return goal
+}
+
+// readLevelWeights визначає локальні ваги дочірніх вузлів обраним способом
+func (ir *inputReader) readLevelWeights(parent *CriteriaNode) {
+	names := make([]string, len(parent.children))
+	for k, c := range parent.children {
+		names[k] = c.name
+	}
+	if len(names) == 1 {
+		parent.children[0].weight = 1
+		return
+	}
+
+	fmt.Printf(promptLevel, parent.name, strings.Join(names, ", "))
+	var weights []float64
+	switch ir.readChoice(promptLevelMethod, levelRanks) {
+	case levelManual:
+		weights = ir.readManualWeights(names)
+	case levelPairwise:
+		var lambdaMax float64
+		weights, lambdaMax = AHPPriorities(ir.readPairwiseMatrix(names))
+		fmt.Printf("Відношення узгодженості CR: %.4f\n", AHPConsistencyRatio(lambdaMax, len(names)))
+	case levelRanks:
+		weights = RankWeights(ir.readRanks(names), rankOrderCentroid)
+	}
+	for k, c := range parent.children {
+		c.weight = weights[k]
+	}
+}
+
+// PrintHierarchy виводить дерево критеріїв з локальними та глобальними вагами
+func (n *CriteriaNode) PrintHierarchy() {
+	fmt.Println("\nІєрархія критеріїв:")
+	fmt.Printf(headerFormat+critHeaderFormat+critHeaderFormat+"\n", "Вузол", "Локальна вага", "Глобальна вага")
+	var walk func(node *CriteriaNode, depth int, w float64)
+	walk = func(node *CriteriaNode, depth int, w float64) {
+		for _, c := range node.children {
+			fmt.Printf(headerFormat+weightFormat+weightFormat+"\n", strings.Repeat("  ", depth)+c.name, c.weight, w*c.weight)
+			walk(c, depth+1, w*c.weight)
+		}
+	}
+	walk(n, 0, 1)
+}
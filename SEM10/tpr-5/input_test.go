@@ -0,0 +1,150 @@
+package main
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"tprtest"
+)
+
+// fuzzInput повертає читача введення з даних фазера; після них іде коректне значення valid,
+// щоб цикл повторного запиту завершився до кінця введення (на якому програма виходить)
+func fuzzInput(data, valid string) *inputReader {
+	return &inputReader{tprtest.Input(data, valid)}
+}
+
+func FuzzReadNumbers(f *testing.F) {
+	for _, s := range []string{"3", "0\n-1\n2", "abc", "7,5", "NaN\nInf\n1e400\n0.5", "1/0\n0/0\n-1/9", "1/3", " 9 / 1 ", "\n\n"} {
+		f.Add(s)
+	}
+	tprtest.Silence(f)
+	f.Fuzz(func(t *testing.T, data string) {
+		// Коректна перша відповідь має бути прийнята без змін
+		first := tprtest.FirstAnswer(data)
+		wantInt, intErr := strconv.Atoi(first)
+		wantFloat, floatErr := strconv.ParseFloat(first, 64)
+
+		n := fuzzInput(data, "2").readIntInRange("", 1, 5)
+		if n < 1 || n > 5 {
+			t.Fatalf("readIntInRange повернув %d поза [1, 5]", n)
+		}
+		if intErr == nil && wantInt >= 1 && wantInt <= 5 && n != wantInt {
+			t.Fatalf("readIntInRange повернув %d замість %d", n, wantInt)
+		}
+
+		v := fuzzInput(data, "1").readValidatedFloat("", math.SmallestNonzeroFloat64, math.MaxFloat64)
+		if !(v > 0) || math.IsInf(v, 0) {
+			t.Fatalf("readValidatedFloat повернув %v", v)
+		}
+		if floatErr == nil && wantFloat > 0 && wantFloat <= math.MaxFloat64 && v != wantFloat {
+			t.Fatalf("readValidatedFloat повернув %v замість %v", v, wantFloat)
+		}
+
+		v = fuzzInput(data, "1").readRatio("")
+		if !(v >= 1.0/9-1e-9 && v <= 9) {
+			t.Fatalf("readRatio повернув %v поза шкалою Сааті", v)
+		}
+		if floatErr == nil && wantFloat >= 1.0/9-1e-9 && wantFloat <= 9 && v != wantFloat {
+			t.Fatalf("readRatio повернув %v замість %v", v, wantFloat)
+		}
+	})
+}
+
+func FuzzXMCDA(f *testing.F) {
+	m := &MCDMSystem{
+		alternatives: []string{"A", "B"},
+		criteria:     []Criterion{{"Ціна", false}, {"Якість", true}},
+		matrix:       [][]float64{{100, 7}, {80, 5}},
+		weights:      []float64{0.4, 0.6},
+	}
+	path := filepath.Join(f.TempDir(), "problem.xml")
+	if err := m.SaveXMCDA(path); err != nil {
+		f.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(data)
+	f.Add([]byte(`<XMCDA><alternatives><alternative id="a"/></alternatives><criteria><criterion id="g"/></criteria>` +
+		`<performanceTable><alternativePerformances><alternativeID>a</alternativeID>` +
+		`<performance><criterionID>g</criterionID><values><value><real>NaN</real></value></values></performance>` +
+		`</alternativePerformances></performanceTable></XMCDA>`))
+	f.Add([]byte(`<XMCDA><alternatives><alternative id=""/></alternatives><criteria><criterion id="g"/><criterion id="h"/></criteria>` +
+		`<performanceTable><alternativePerformances><alternativeID></alternativeID>` +
+		`<performance><criterionID>g</criterionID><values><value><real>1</real></value></values></performance>` +
+		`<performance><criterionID>h</criterionID><values><value><real>2</real></value></values></performance>` +
+		`</alternativePerformances></performanceTable><criteriaValues mcdaConcept="weights">` +
+		`<criterionValues><criterionID>g</criterionID><values><value><real>1e308</real></value></values></criterionValues>` +
+		`<criterionValues><criterionID>h</criterionID><values><value><real>1.7e308</real></value></values></criterionValues>` +
+		`</criteriaValues></XMCDA>`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		m, err := decodeXMCDA(data)
+		if err != nil {
+			return
+		}
+		if len(m.matrix) != len(m.alternatives) {
+			t.Fatalf("%d рядків таблиці оцінок для %d альтернатив", len(m.matrix), len(m.alternatives))
+		}
+		for i, row := range m.matrix {
+			if len(row) != len(m.criteria) {
+				t.Fatalf("альтернатива %q: %d оцінок для %d критеріїв", m.alternatives[i], len(row), len(m.criteria))
+			}
+			for j, v := range row {
+				if !(v > 0) || math.IsInf(v, 0) {
+					t.Fatalf("прийнято значення %v для %q за критерієм %q", v, m.alternatives[i], m.criteria[j].name)
+				}
+			}
+		}
+		if m.weights != nil {
+			if len(m.weights) != len(m.criteria) {
+				t.Fatalf("%d ваг для %d критеріїв", len(m.weights), len(m.criteria))
+			}
+			sum := 0.0
+			for _, w := range m.weights {
+				sum += w
+			}
+			if math.Abs(sum-1) > 1e-9 {
+				t.Fatalf("сума ваг %v після нормування", sum)
+			}
+		}
+
+		// Збережена задача має зчитуватися назад без змін
+		saved, err := m.encodeXMCDA()
+		if err != nil {
+			t.Fatalf("задачу не збережено: %v", err)
+		}
+		back, err := decodeXMCDA(saved)
+		if err != nil {
+			t.Fatalf("збережену задачу не зчитано: %v\n%s", err, saved)
+		}
+		if !slices.Equal(back.alternatives, m.alternatives) || !slices.Equal(back.criteria, m.criteria) ||
+			!slices.EqualFunc(back.matrix, m.matrix, slices.Equal[[]float64]) {
+			t.Fatalf("зчитано %+v замість збереженої задачі %+v", back, m)
+		}
+		if (back.weights == nil) != (m.weights == nil) || !slices.EqualFunc(back.weights, m.weights, func(a, b float64) bool {
+			return math.Abs(a-b) <= 1e-12
+		}) {
+			t.Fatalf("зчитано ваги %v замість %v", back.weights, m.weights)
+		}
+
+		for j, w := range m.weights {
+			if !(w >= 0) {
+				t.Fatalf("прийнято вагу %v критерію %q", w, m.criteria[j].name)
+			}
+		}
+		if m.weights == nil {
+			m.weights = make([]float64, len(m.criteria))
+			for j := range m.weights {
+				m.weights[j] = 1 / float64(len(m.criteria))
+			}
+		}
+		m.CalculateSAW()
+		m.CalculateTOPSIS()
+	})
+}
@@ -0,0 +1,559 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Prompt templates
+	promptAltCount       = "Введіть кількість альтернатив: "
+	promptAltName        = "Введіть назву альтернативи %d: "
+	promptCritCount      = "Введіть кількість критеріїв: "
+	promptCritName       = "Введіть назву критерію %d: "
+	promptCritType       = "Тип критерію '%s' (1 – максимізація, 2 – мінімізація): "
+	promptAltValues      = "\nВведіть значення для альтернативи '%s':\n"
+	promptCritValue      = "Значення за критерієм '%s' (> 0): "
+	promptWeightMethod   = "\nСпосіб визначення ваг критеріїв (1 – ввести вручну, 2 – ентропійний метод, 3 – метод найкращого-найгіршого, 4 – за рангами критеріїв, 5 – метод аналізу ієрархій (група експертів), 6 – ієрархія критеріїв і підкритеріїв): "
+	promptWeight         = "Вага критерію '%s' (>= 0): "
+	promptBestCrit       = "Номер найкращого (найважливішого) критерію: "
+	promptWorstCrit      = "Номер найгіршого (найменш важливого) критерію: "
+	promptBestToOther    = "Наскільки '%s' важливіший за '%s' (від 1 до 9): "
+	promptOtherToWorst   = "Наскільки '%s' важливіший за '%s' (від 1 до 9): "
+	promptCritRank       = "Ранг важливості критерію '%s' (1…%d, 1 – найважливіший): "
+	promptRankMethod     = "Формула ваг (1 – ROC, 2 – сума рангів, 3 – обернені ранги): "
+	promptExpertCount    = "Кількість експертів (від 1 до 100): "
+	promptExpertMatrix   = "\nПарні порівняння критеріїв експерта %d (шкала Сааті від 1/9 до 9):\n"
+	promptPairwise       = "Наскільки '%s' важливіший за '%s': "
+	promptAHPAggregation = "Спосіб агрегації (1 – середнє геометричне матриць AIJ, 2 – середнє геометричне пріоритетів AIP): "
+	promptMode           = "\nМетод (1 – SAW/TOPSIS, 2 – нечіткий TOPSIS з лінгвістичними оцінками, 3 – багатоатрибутивна корисність MAUT, 4 – стохастичний аналіз прийнятності SMAA-2): "
+	promptUtilityCrit    = "\nФункція корисності критерію '%s' (найгірше значення %.2f, найкраще %.2f):\n"
+	promptUtilityKind    = "Вид функції (1 – лінійна, 2 – експоненційна, 3 – кусково-лінійна за точками): "
+	promptUtilityRisk    = "Коефіцієнт ризику c (c > 0 – несхильність, c < 0 – схильність до ризику, c != 0): "
+	promptUtilityPoints  = "Кількість проміжних точок (від 0 до 9): "
+	promptUtilityX       = "Значення точки %d (від %.2f до %.2f): "
+	promptUtilityU       = "Корисність значення %.2f (від 0 до 1): "
+	promptAggregation    = "\nСпосіб агрегації (1 – адитивний, 2 – мультиплікативний): "
+	promptScaling        = "Масштабний коефіцієнт k для критерію '%s' (від 0 до 1): "
+	promptSMAAWeights    = "\nІнформація про ваги (1 – відсутня, 2 – порядок важливості критеріїв, 3 – інтервали ваг): "
+	promptWeightLo       = "Нижня межа ваги критерію '%s' (від 0 до 1): "
+	promptWeightHi       = "Верхня межа ваги критерію '%s': "
+	promptSMAADeviation  = "Відносна невизначеність значень критеріїв (від 0 до 0.99, 0 – точні значення): "
+	promptSMAAIterations = "Кількість ітерацій Монте-Карло (від 100 до 1000000): "
+	promptSMAASeed       = "Зерно генератора: %d (повторити ті самі результати: -seed %d)\n"
+	promptFuzzyWeight    = "Важливість критерію '%s' (1…%d): "
+	promptFuzzyRating    = "Оцінка за критерієм '%s' (1…%d): "
+	promptGroupCount     = "Кількість груп критеріїв верхнього рівня: "
+	promptGroupName      = "Назва групи критеріїв %d: "
+	promptCritGroup      = "Група критерію '%s' (1…%d): "
+	promptLevel          = "\nЛокальні ваги елементів вузла '%s' (%s)\n"
+	promptLevelMethod    = "Спосіб (1 – ввести вручну, 2 – парні порівняння за шкалою Сааті, 3 – за рангами ROC): "
+	promptMethodResults  = "\nРезультати за методом %s:\n"
+	promptWeightsResults = "\nВаги критеріїв (%s):\n"
+
+	// Error messages
+	errInvalidCount     = "Некоректне число %s"
+	errInvalidValue     = "Некоректне значення. Будь ласка, спробуйте ще раз."
+	errInputClosed      = "Введення завершилося раніше, ніж було отримано всі дані"
+	errZeroWeightsSum   = "Сума ваг повинна бути більшою за 0. Введіть ваги ще раз."
+	errScalingConstants = "Щонайменше два коефіцієнти k повинні бути більшими за 0. Введіть коефіцієнти ще раз."
+	errUtilityDuplicate = "Точка з таким значенням уже задана. Введіть інше значення."
+	errSameBestWorst    = "Найкращий і найгірший критерії повинні відрізнятися."
+	errBWMNoSolution    = "Не вдалося розв'язати задачу BWM. Введіть порівняння ще раз."
+	errXMCDANoValue     = "XMCDA: елемент не містить значення"
+	errXMCDAUnknownID   = "XMCDA: невідомий ідентифікатор '%s'"
+	errXMCDANoID        = "XMCDA: у кожної альтернативи й кожного критерію повинен бути атрибут id"
+	errXMCDANonPositive = "XMCDA: значення альтернативи '%s' за критерієм '%s' повинно бути скінченним числом, більшим за 0"
+	errXMCDAIncomplete  = "XMCDA: таблиця оцінок заповнена не повністю"
+	errXMCDAWeight      = "XMCDA: вага критерію '%s' повинна бути невід'ємним числом"
+	errDuplicateRanks   = "Ранги критеріїв повинні бути різними. Введіть ранги ще раз."
+	errEmptyGroup       = "Кожна група повинна містити хоча б один критерій. Розподіліть критерії ще раз."
+	errWeightIntervals  = "Сума нижніх меж повинна бути не більшою за 1, а верхніх – не меншою за 1. Введіть межі ще раз."
+	errSMAANoWeights    = "Не вдалося згенерувати ваги в заданих інтервалах: інтервали занадто вузькі."
+	errSMAAInterrupted  = "Обчислення перервано: результати отримано за %d з %d ітерацій."
+	errNormalization    = "Невідомий спосіб нормалізації '%s': потрібен один з %s"
+	errSensitivityDelta = "Зміна ваги -weight-sensitivity повинна бути від 0 до 1"
+	errEngine           = "Невідомий обчислювальний рушій '%s': доступні %s (gonum – у збірці з -tags gonum)"
+	errNormalizedPath   = "Для збереження нормалізованої матриці (-normalized) вкажіть спосіб нормалізації -normalize"
+
+	// Table formats
+	headerFormat      = "%-20s"
+	critHeaderFormat  = "%-15s"
+	termHeaderFormat  = "%-20s"
+	fuzzyHeaderFormat = "%-25s"
+	scoreFormat       = "%-15.2f"
+	weightFormat      = "%-15.4f"
+	resultRankFormat  = "%-5s %-20s %-15s\n"
+	resultItemFormat  = "%-5d %-20s %-15.4f\n"
+)
+
+const (
+	modeCrisp = iota + 1
+	modeFuzzy
+	modeMAUT
+	modeSMAA
+)
+
+const (
+	weightsManual = iota + 1
+	weightsEntropy
+	weightsBWM
+	weightsRanks
+	weightsAHP
+	weightsHierarchy
+)
+
+type (
+	inputReader struct {
+		reader *bufio.Reader
+	}
+
+	// Criterion описує критерій та напрям його оптимізації
+	Criterion struct {
+		name    string
+		benefit bool // true – більше значення краще, false – менше
+	}
+
+	MCDMSystem struct {
+		alternatives []string
+		criteria     []Criterion
+		// matrix[i][j] – значення альтернативи i за критерієм j
+		matrix  [][]float64
+		weights []float64
+		// індекси лінгвістичних термів для нечіткого TOPSIS
+		fuzzyRatings [][]int
+		fuzzyWeights []int
+		utilities    []UtilityFunction
+		// normalization – спосіб нормалізації для SAW і TOPSIS ("" – власний для кожного методу)
+		normalization string
+	}
+
+	// AltValue використовується для сортування альтернатив
+	// по обчисленій величині методу
+	AltValue struct {
+		alt   string
+		value float64
+	}
+)
+
+func newInputReader() *inputReader {
+	return &inputReader{bufio.NewReader(os.Stdin)}
+}
+
+func (ir *inputReader) readString(prompt string) (string, error) {
+	fmt.Print(prompt)
+	input, err := ir.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(input), nil
+}
+
+func (ir *inputReader) readInt(prompt string) (int, error) {
+	str, err := ir.readString(prompt)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(str)
+}
+
+func (ir *inputReader) readChoice(prompt string, max int) int {
+	return ir.readIntInRange(prompt, 1, max)
+}
+
+func (ir *inputReader) readIntInRange(prompt string, min, max int) int {
+	for {
+		v, err := ir.readInt(prompt)
+		var numErr *strconv.NumError
+		switch {
+		case err == nil && v >= min && v <= max:
+			return v
+		case err != nil && !errors.As(err, &numErr):
+			inputClosed()
+		}
+		fmt.Println(errInvalidValue)
+	}
+}
+
+// inputClosed завершує програму, якщо стандартний вхід закрито посеред введення:
+// інакше цикл повторного запиту чекав би на коректне значення безкінечно
+func inputClosed() {
+	fmt.Println("\n" + errInputClosed)
+	os.Exit(1)
+}
+
+func (ir *inputReader) readValidatedFloat(prompt string, min, max float64) float64 {
+	for {
+		str, err := ir.readString(prompt)
+		if err != nil {
+			inputClosed()
+		}
+		val, err := strconv.ParseFloat(str, 64)
+		if err == nil && val >= min && val <= max {
+			return val
+		}
+		fmt.Println(errInvalidValue)
+	}
+}
+
+func newMCDMSystem(ir *inputReader) (*MCDMSystem, error) {
+	altCount, err := ir.readInt(promptAltCount)
+	if err != nil || altCount <= 0 {
+		return nil, fmt.Errorf(errInvalidCount, "альтернатив")
+	}
+
+	alts := make([]string, altCount)
+	for i := range altCount {
+		alts[i], _ = ir.readString(fmt.Sprintf(promptAltName, i+1))
+	}
+
+	critCount, err := ir.readInt(promptCritCount)
+	if err != nil || critCount <= 0 {
+		return nil, fmt.Errorf(errInvalidCount, "критеріїв")
+	}
+
+	criteria := make([]Criterion, critCount)
+	for j := range critCount {
+		name, _ := ir.readString(fmt.Sprintf(promptCritName, j+1))
+		criteria[j] = Criterion{name: name}
+	}
+	for j := range criteria {
+		kind := ir.readChoice(fmt.Sprintf(promptCritType, criteria[j].name), 2)
+		criteria[j].benefit = kind == 1
+	}
+
+	return &MCDMSystem{
+		alternatives: alts,
+		criteria:     criteria,
+	}, nil
+}
+
+func (m *MCDMSystem) CollectMatrix(ir *inputReader) {
+	m.matrix = make([][]float64, len(m.alternatives))
+	for i, alt := range m.alternatives {
+		fmt.Printf(promptAltValues, alt)
+		m.matrix[i] = make([]float64, len(m.criteria))
+
+		for j, c := range m.criteria {
+			prompt := fmt.Sprintf(promptCritValue, c.name)
+			m.matrix[i][j] = ir.readValidatedFloat(prompt, math.SmallestNonzeroFloat64, math.MaxFloat64)
+		}
+	}
+}
+
+func (m *MCDMSystem) PrintMatrix() {
+	fmt.Println("\nМатриця рішень:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for _, c := range m.criteria {
+		fmt.Printf(critHeaderFormat, c.name)
+	}
+	fmt.Println()
+
+	fmt.Printf(headerFormat, "Напрям")
+	for _, c := range m.criteria {
+		if c.benefit {
+			fmt.Printf(critHeaderFormat, "max")
+		} else {
+			fmt.Printf(critHeaderFormat, "min")
+		}
+	}
+	fmt.Println()
+
+	for i, alt := range m.alternatives {
+		fmt.Printf(headerFormat, alt)
+		for _, v := range m.matrix[i] {
+			fmt.Printf(scoreFormat, v)
+		}
+		fmt.Println()
+	}
+}
+
+// CollectWeights визначає ваги критеріїв обраним користувачем способом;
+// ваги, імпортовані разом із задачею, використовуються без змін
+func (m *MCDMSystem) CollectWeights(ir *inputReader) {
+	if m.weights != nil {
+		m.PrintWeights("імпортовані з XMCDA", nil)
+		return
+	}
+
+	switch ir.readChoice(promptWeightMethod, weightsHierarchy) {
+	case weightsManual:
+		m.weights = ir.readManualWeights(m.criterionNames())
+		m.PrintWeights("суб'єктивні", nil)
+	case weightsEntropy:
+		var entropy []float64
+		m.weights, entropy = EntropyWeights(m.matrix)
+		m.PrintWeights("ентропійний метод", entropy)
+	case weightsBWM:
+		var ksi, cr float64
+		m.weights, ksi, cr = m.readBWMWeights(ir)
+		m.PrintWeights("метод найкращого-найгіршого", nil)
+		fmt.Printf("Відхилення ξ*: %.4f\n", ksi)
+		fmt.Printf("Коефіцієнт узгодженості CR: %.4f\n", cr)
+	case weightsRanks:
+		ranks := ir.readRanks(m.criterionNames())
+		method := ir.readChoice(promptRankMethod, rankReciprocal)
+		m.weights = RankWeights(ranks, method)
+		m.PrintWeights(rankMethodNames[method], nil)
+	case weightsAHP:
+		var cr float64
+		m.weights, cr = m.readAHPWeights(ir)
+		m.PrintWeights("метод аналізу ієрархій", nil)
+		fmt.Printf("Групове відношення узгодженості CR: %.4f\n", cr)
+	case weightsHierarchy:
+		goal := m.readHierarchy(ir)
+		m.weights = goal.GlobalWeights(len(m.criteria))
+		goal.PrintHierarchy()
+		m.PrintWeights("ієрархія критеріїв", nil)
+	}
+}
+
+var rankMethodNames = map[int]string{
+	rankOrderCentroid: "центроїд рангів ROC",
+	rankSum:           "сума рангів",
+	rankReciprocal:    "обернені ранги",
+}
+
+// criterionNames повертає назви критеріїв у порядку стовпців матриці
+func (m *MCDMSystem) criterionNames() []string {
+	names := make([]string, len(m.criteria))
+	for j, c := range m.criteria {
+		names[j] = c.name
+	}
+	return names
+}
+
+// readRanks зчитує порядок важливості критеріїв names (перестановку 1…n)
+func (ir *inputReader) readRanks(names []string) []int {
+	n := len(names)
+	for {
+		ranks := make([]int, n)
+		used := make(map[int]bool)
+		for j, name := range names {
+			ranks[j] = ir.readChoice(fmt.Sprintf(promptCritRank, name, n), n)
+			used[ranks[j]] = true
+		}
+
+		if len(used) == n {
+			return ranks
+		}
+		fmt.Println(errDuplicateRanks)
+	}
+}
+
+// readBWMWeights зчитує найкращий і найгірший критерії та вектори порівнянь BWM
+func (m *MCDMSystem) readBWMWeights(ir *inputReader) ([]float64, float64, float64) {
+	n := len(m.criteria)
+	fmt.Println("\nКритерії:")
+	for j, c := range m.criteria {
+		fmt.Printf("%d) %s\n", j+1, c.name)
+	}
+
+	for {
+		best := ir.readChoice(promptBestCrit, n) - 1
+		worst := ir.readChoice(promptWorstCrit, n) - 1
+		if n > 1 && best == worst {
+			fmt.Println(errSameBestWorst)
+			continue
+		}
+
+		bestToOthers := make([]float64, n)
+		othersToWorst := make([]float64, n)
+		for j, c := range m.criteria {
+			if j == best {
+				bestToOthers[j] = 1
+				continue
+			}
+			prompt := fmt.Sprintf(promptBestToOther, m.criteria[best].name, c.name)
+			bestToOthers[j] = ir.readValidatedFloat(prompt, 1, 9)
+		}
+		for j, c := range m.criteria {
+			if j == worst {
+				othersToWorst[j] = 1
+				continue
+			}
+			prompt := fmt.Sprintf(promptOtherToWorst, c.name, m.criteria[worst].name)
+			othersToWorst[j] = ir.readValidatedFloat(prompt, 1, 9)
+		}
+
+		weights, ksi, ok := BWMWeights(best, worst, bestToOthers, othersToWorst)
+		if !ok {
+			fmt.Println(errBWMNoSolution)
+			continue
+		}
+		return weights, ksi, BWMConsistencyRatio(worst, bestToOthers, othersToWorst)
+	}
+}
+
+// readManualWeights зчитує суб'єктивні ваги критеріїв names та нормує їх так, щоб сума дорівнювала 1
+func (ir *inputReader) readManualWeights(names []string) []float64 {
+	for {
+		weights := make([]float64, len(names))
+		for j, name := range names {
+			weights[j] = ir.readValidatedFloat(fmt.Sprintf(promptWeight, name), 0, math.MaxFloat64)
+		}
+
+		if normalizeWeights(weights) {
+			return weights
+		}
+		fmt.Println(errZeroWeightsSum)
+	}
+}
+
+func (m *MCDMSystem) PrintWeights(method string, entropy []float64) {
+	fmt.Printf(promptWeightsResults, method)
+	fmt.Printf(headerFormat, "Критерій")
+	if entropy != nil {
+		fmt.Printf(critHeaderFormat, "Ентропія")
+	}
+	fmt.Printf(critHeaderFormat, "Вага")
+	fmt.Println()
+
+	for j, c := range m.criteria {
+		fmt.Printf(headerFormat, c.name)
+		if entropy != nil {
+			fmt.Printf(weightFormat, entropy[j])
+		}
+		fmt.Printf(weightFormat, m.weights[j])
+		fmt.Println()
+	}
+}
+
+func sortAltValues(alts []string, values []float64) []AltValue {
+	arr := make([]AltValue, len(alts))
+	for i, alt := range alts {
+		arr[i] = AltValue{alt, values[i]}
+	}
+	// Для SAW та TOPSIS більше значення – краще
+	sort.SliceStable(arr, func(i, j int) bool {
+		return arr[i].value > arr[j].value
+	})
+	return arr
+}
+
+func PrintRanking(title string, altValues []AltValue, valueLabel string) {
+	fmt.Printf(promptMethodResults, title)
+	fmt.Printf(resultRankFormat, "Ранг", "Альтернатива", valueLabel)
+	for i, item := range altValues {
+		fmt.Printf(resultItemFormat, i+1, item.alt, item.value)
+	}
+}
+
+func main() {
+	importPath := flag.String("import", "", "файл задачі у форматі XMCDA для імпорту")
+	exportPath := flag.String("export", "", "файл для експорту задачі у форматі XMCDA")
+	normalization := flag.String("normalize", "", "нормалізація матриці рішень для SAW, TOPSIS і SMAA: minmax, vector, sum або max "+
+		"(за замовчуванням SAW – за максимумом, TOPSIS – векторна)")
+	normalizedPath := flag.String("normalized", "", "файл CSV для збереження нормалізованої матриці рішень")
+	reversal := flag.Bool("reversal", false, "після SAW і TOPSIS перевірити rank reversal: видаляти по одній альтернативі й порівнювати ранжування")
+	sensitivity := flag.Float64("weight-sensitivity", 0, "після SAW і TOPSIS змінити вагу кожного критерію на ±δ і знайти інтервали стійкості ваг (δ від 0 до 1, 0 – не аналізувати)")
+	engine := flag.String("engine", engineBuiltin, "обчислювальний рушій нормалізації, SAW, TOPSIS і AHP: builtin або gonum (gonum/mat, збірка з -tags gonum)")
+	seedFlag := flag.Uint64("seed", 0, "зерно генератора SMAA; однакове зерно дає однакові індекси прийнятності (за замовчуванням випадкове)")
+	flag.Parse()
+
+	seedSet := false
+	flag.Visit(func(f *flag.Flag) { seedSet = seedSet || f.Name == "seed" })
+	if !seedSet {
+		*seedFlag = uint64(time.Now().UnixNano())
+	}
+	setSeed(*seedFlag)
+	if err := setEngine(*engine); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := checkNormalization(*normalization); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if *sensitivity < 0 || *sensitivity > 1 {
+		fmt.Println(errSensitivityDelta)
+		return
+	}
+	if *normalizedPath != "" && *normalization == "" {
+		fmt.Println(errNormalizedPath)
+		return
+	}
+
+	ir := newInputReader()
+	var m *MCDMSystem
+	var err error
+	if *importPath != "" {
+		m, err = loadXMCDA(*importPath)
+	} else {
+		m, err = newMCDMSystem(ir)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if *exportPath != "" {
+		defer func() {
+			if err := m.SaveXMCDA(*exportPath); err != nil {
+				fmt.Println(err)
+				return
+			}
+			fmt.Printf("\nЗадачу збережено у файл %s\n", *exportPath)
+		}()
+	}
+
+	mode := ir.readChoice(promptMode, modeSMAA)
+	if mode == modeFuzzy {
+		m.CollectFuzzyRatings(ir)
+		m.PrintFuzzyRatings()
+		m.PrintFuzzyWeights()
+
+		dIdeal, dAnti, closeness := m.CalculateFuzzyTOPSIS()
+		m.PrintFuzzyDistances(dIdeal, dAnti, closeness)
+		PrintRanking("нечіткого TOPSIS", sortAltValues(m.alternatives, closeness), "CC")
+		return
+	}
+
+	if m.matrix == nil {
+		m.CollectMatrix(ir)
+	}
+	m.PrintMatrix()
+	if m.normalization = *normalization; m.normalization != "" {
+		m.PrintNormalizedMatrix()
+	}
+	if m.normalization != "" && *normalizedPath != "" {
+		if err := m.SaveNormalizedCSV(*normalizedPath); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Printf("\nНормалізовану матрицю збережено у файл %s\n", *normalizedPath)
+		}
+	}
+
+	if mode == modeMAUT {
+		m.RunMAUT(ir)
+		return
+	}
+	if mode == modeSMAA {
+		m.RunSMAA(ir)
+		return
+	}
+
+	m.CollectWeights(ir)
+
+	saw := m.CalculateSAW()
+	PrintRanking("SAW", sortAltValues(m.alternatives, saw), "Зважена сума")
+
+	topsis := m.CalculateTOPSIS()
+	PrintRanking("TOPSIS", sortAltValues(m.alternatives, topsis), "Близькість")
+
+	if *reversal {
+		m.PrintRankReversal("SAW", (*MCDMSystem).CalculateSAW)
+		m.PrintRankReversal("TOPSIS", (*MCDMSystem).CalculateTOPSIS)
+	}
+	if *sensitivity > 0 {
+		m.PrintWeightSensitivity("SAW", *sensitivity, (*MCDMSystem).CalculateSAW)
+		m.PrintWeightSensitivity("TOPSIS", *sensitivity, (*MCDMSystem).CalculateTOPSIS)
+	}
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+)
+
+const (
+	utilityLinear = iota + 1
+	utilityExponential
+	utilityPiecewise
+)
+
+const (
+	aggregateAdditive = iota + 1
+	aggregateMultiplicative
+)
+
+type (
+	// UtilityPoint – оцінена експертом точка функції корисності
+	UtilityPoint struct {
+		x, u float64
+	}
+
+	// UtilityFunction – функція корисності критерію на проміжку від найгіршого
+	// до найкращого значення в матриці рішень
+	UtilityFunction struct {
+		kind        int
+		worst, best float64
+		risk        float64        // коефіцієнт схильності до ризику для експоненційної функції
+		points      []UtilityPoint // вузли кусково-лінійної функції, впорядковані за x
+	}
+)
+
+var utilityNames = map[int]string{
+	utilityLinear:      "лінійна",
+	utilityExponential: "експонента",
+	utilityPiecewise:   "за точками",
+}
+
+// Value повертає корисність значення x у межах [0; 1]
+func (f UtilityFunction) Value(x float64) float64 {
+	if f.best == f.worst {
+		return 1
+	}
+	z := math.Max(0, math.Min(1, (x-f.worst)/(f.best-f.worst)))
+
+	switch f.kind {
+	case utilityExponential:
+		// u(z) = (1 - e^(-c·z)) / (1 - e^(-c)); c > 0 – несхильність до ризику, c < 0 – схильність
+		if f.risk == 0 {
+			return z
+		}
+		return (1 - math.Exp(-f.risk*z)) / (1 - math.Exp(-f.risk))
+	case utilityPiecewise:
+		pts := f.points
+		for k := 1; k < len(pts); k++ {
+			if x <= pts[k].x {
+				t := (x - pts[k-1].x) / (pts[k].x - pts[k-1].x)
+				return pts[k-1].u + t*(pts[k].u-pts[k-1].u)
+			}
+		}
+		return pts[len(pts)-1].u
+	}
+	return z
+}
+
+// CollectUtilityFunctions зчитує вид функції корисності для кожного критерію
+func (m *MCDMSystem) CollectUtilityFunctions(ir *inputReader) {
+	m.utilities = make([]UtilityFunction, len(m.criteria))
+
+	for j, c := range m.criteria {
+		minVal, maxVal := m.columnRange(j)
+		f := UtilityFunction{worst: minVal, best: maxVal}
+		if !c.benefit {
+			f.worst, f.best = maxVal, minVal
+		}
+
+		fmt.Printf(promptUtilityCrit, c.name, f.worst, f.best)
+		f.kind = ir.readChoice(promptUtilityKind, utilityPiecewise)
+
+		switch f.kind {
+		case utilityExponential:
+			for f.risk == 0 {
+				f.risk = ir.readValidatedFloat(promptUtilityRisk, -50, 50)
+			}
+		case utilityPiecewise:
+			f.points = readUtilityPoints(ir, f.worst, f.best)
+		}
+		m.utilities[j] = f
+	}
+}
+
+// readUtilityPoints зчитує проміжні точки кусково-лінійної функції;
+// найгірше значення має корисність 0, найкраще – 1
+func readUtilityPoints(ir *inputReader, worst, best float64) []UtilityPoint {
+	lo, hi := math.Min(worst, best), math.Max(worst, best)
+	points := []UtilityPoint{{worst, 0}, {best, 1}}
+
+	count := ir.readIntInRange(promptUtilityPoints, 0, 9)
+	for k := range count {
+		x := ir.readValidatedFloat(fmt.Sprintf(promptUtilityX, k+1, lo, hi), lo, hi)
+		// Дві точки з однаковим x дали б ділення на нуль в UtilityFunction.Value
+		for slices.ContainsFunc(points, func(p UtilityPoint) bool { return p.x == x }) {
+			fmt.Println(errUtilityDuplicate)
+			x = ir.readValidatedFloat(fmt.Sprintf(promptUtilityX, k+1, lo, hi), lo, hi)
+		}
+		u := ir.readValidatedFloat(fmt.Sprintf(promptUtilityU, x), 0, 1)
+		points = append(points, UtilityPoint{x, u})
+	}
+
+	sort.Slice(points, func(a, b int) bool {
+		return points[a].x < points[b].x
+	})
+	return points
+}
+
+// CollectScalingConstants зчитує масштабні коефіцієнти k_j мультиплікативної моделі;
+// щонайменше два з них повинні бути ненульовими, інакше рівняння для K не має
+// ненульового розв'язку
+func (m *MCDMSystem) CollectScalingConstants(ir *inputReader) {
+	m.weights = make([]float64, len(m.criteria))
+	for {
+		for j, c := range m.criteria {
+			m.weights[j] = ir.readValidatedFloat(fmt.Sprintf(promptScaling, c.name), 0, 1)
+		}
+		if positiveCount(m.weights) >= 2 {
+			return
+		}
+		fmt.Println(errScalingConstants)
+	}
+}
+
+func positiveCount(values []float64) int {
+	count := 0
+	for _, v := range values {
+		if v > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// InteractionConstant знаходить константу взаємодії K мультиплікативної моделі
+// з рівняння 1 + K = Π (1 + K·k_j). Якщо Σ k_j = 1, модель адитивна і K = 0;
+// якщо Σ k_j > 1, то -1 < K < 0; якщо Σ k_j < 1, то K > 0.
+// Коли ненульових k_j менше двох, ненульового розв'язку немає, і повертається K = 0,
+// тобто адитивна модель.
+func InteractionConstant(scaling []float64) float64 {
+	sum := 0.0
+	for _, k := range scaling {
+		sum += k
+	}
+	if math.Abs(sum-1) < 1e-9 || positiveCount(scaling) < 2 {
+		return 0
+	}
+
+	f := func(K float64) float64 {
+		prod := 1.0
+		for _, k := range scaling {
+			prod *= 1 + K*k
+		}
+		return prod - (1 + K)
+	}
+
+	var lo, hi float64
+	if sum > 1 {
+		lo, hi = -1+1e-12, -1e-12
+	} else {
+		lo, hi = 1e-12, 1
+		for f(hi) < 0 {
+			hi *= 2
+		}
+	}
+
+	for range 200 {
+		mid := (lo + hi) / 2
+		if (f(mid) > 0) == (f(hi) > 0) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// CalculateMAUT розраховує багатоатрибутивну корисність альтернатив:
+// адитивно U = Σ w_j·u_j або мультиплікативно U = (Π (1 + K·k_j·u_j) - 1) / K
+func (m *MCDMSystem) CalculateMAUT(aggregation int, K float64) (utilities [][]float64, scores []float64) {
+	utilities = make([][]float64, len(m.alternatives))
+	scores = make([]float64, len(m.alternatives))
+
+	for i := range m.alternatives {
+		utilities[i] = make([]float64, len(m.criteria))
+		prod := 1.0
+		for j, f := range m.utilities {
+			u := f.Value(m.matrix[i][j])
+			utilities[i][j] = u
+			scores[i] += m.weights[j] * u
+			prod *= 1 + K*m.weights[j]*u
+		}
+
+		if aggregation == aggregateMultiplicative && K != 0 {
+			scores[i] = (prod - 1) / K
+		}
+	}
+	return utilities, scores
+}
+
+func (m *MCDMSystem) PrintUtilities(utilities [][]float64) {
+	fmt.Println("\nМатриця корисностей:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for _, c := range m.criteria {
+		fmt.Printf(critHeaderFormat, c.name)
+	}
+	fmt.Println()
+
+	fmt.Printf(headerFormat, "Функція")
+	for _, f := range m.utilities {
+		fmt.Printf(critHeaderFormat, utilityNames[f.kind])
+	}
+	fmt.Println()
+
+	for i, alt := range m.alternatives {
+		fmt.Printf(headerFormat, alt)
+		for _, u := range utilities[i] {
+			fmt.Printf(weightFormat, u)
+		}
+		fmt.Println()
+	}
+}
+
+// RunMAUT виконує аналіз за теорією багатоатрибутивної корисності
+func (m *MCDMSystem) RunMAUT(ir *inputReader) {
+	m.CollectUtilityFunctions(ir)
+
+	K := 0.0
+	aggregation := ir.readChoice(promptAggregation, aggregateMultiplicative)
+	if aggregation == aggregateAdditive {
+		m.CollectWeights(ir)
+	} else {
+		m.CollectScalingConstants(ir)
+		K = InteractionConstant(m.weights)
+		fmt.Printf("\nКонстанта взаємодії K: %.4f\n", K)
+	}
+
+	utilities, scores := m.CalculateMAUT(aggregation, K)
+	m.PrintUtilities(utilities)
+	PrintRanking("MAUT", sortAltValues(m.alternatives, scores), "Корисність")
+}
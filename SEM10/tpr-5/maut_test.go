@@ -0,0 +1,82 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUtilityValue(t *testing.T) {
+	piecewise := UtilityFunction{kind: utilityPiecewise, worst: 0, best: 10,
+		points: []UtilityPoint{{0, 0}, {5, 0.8}, {10, 1}}}
+	for _, tt := range []struct {
+		name string
+		f    UtilityFunction
+		x    float64
+		want float64
+	}{
+		{"лінійна", UtilityFunction{kind: utilityLinear, worst: 0, best: 10}, 5, 0.5},
+		{"лінійна нижче найгіршого", UtilityFunction{kind: utilityLinear, worst: 0, best: 10}, -1, 0},
+		{"лінійна вище найкращого", UtilityFunction{kind: utilityLinear, worst: 0, best: 10}, 12, 1},
+		{"лінійна, мінімізація", UtilityFunction{kind: utilityLinear, worst: 10, best: 0}, 2.5, 0.75},
+		{"однакові межі", UtilityFunction{kind: utilityLinear, worst: 3, best: 3}, 3, 1},
+		// (1 − e^(−0.5)) / (1 − e^(−1))
+		{"експонента", UtilityFunction{kind: utilityExponential, worst: 0, best: 1, risk: 1}, 0.5, 0.6224593312018546},
+		{"за точками, перший відрізок", piecewise, 2.5, 0.4},
+		{"за точками, другий відрізок", piecewise, 7.5, 0.9},
+		{"за точками, вузол", piecewise, 5, 0.8},
+	} {
+		if got := tt.f.Value(tt.x); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("%s: u(%v) = %v, очікувалося %v", tt.name, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestInteractionConstant(t *testing.T) {
+	for _, tt := range []struct {
+		scaling []float64
+		want    float64
+	}{
+		{[]float64{0.5, 0.5}, 0},
+		// 1 + K = (1 + 0.4K)² → K = 1.25
+		{[]float64{0.4, 0.4}, 1.25},
+		// 1 + K = (1 + 0.6K)² → K = −5/9
+		{[]float64{0.6, 0.6}, -5.0 / 9},
+		// 1 + K = (1 + 0.2K)³ → K² + 15K − 50 = 0
+		{[]float64{0.2, 0.2, 0.2}, (-15 + math.Sqrt(425)) / 2},
+		{[]float64{0.7, 0, 0}, 0},
+	} {
+		if got := InteractionConstant(tt.scaling); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("InteractionConstant(%v) = %v, очікувалося %v", tt.scaling, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateMAUT(t *testing.T) {
+	linear := UtilityFunction{kind: utilityLinear, worst: 0, best: 10}
+	m := &MCDMSystem{
+		alternatives: []string{"A", "B", "C"},
+		criteria:     []Criterion{{"g1", true}, {"g2", true}},
+		matrix:       [][]float64{{10, 0}, {0, 10}, {10, 10}},
+		utilities:    []UtilityFunction{linear, linear},
+	}
+	for _, tt := range []struct {
+		name        string
+		aggregation int
+		weights     []float64
+		K           float64
+		want        []float64
+	}{
+		{"адитивна", aggregateAdditive, []float64{0.6, 0.4}, 0, []float64{0.6, 0.4, 1}},
+		// A: ((1 + 1.25·0.4) − 1) / 1.25 = 0.4; C: ((1 + 1.25·0.4)² − 1) / 1.25 = 1
+		{"мультиплікативна", aggregateMultiplicative, []float64{0.4, 0.4}, 1.25, []float64{0.4, 0.4, 1}},
+	} {
+		m.weights = tt.weights
+		utilities, scores := m.CalculateMAUT(tt.aggregation, tt.K)
+		if !closeTo(scores, tt.want, 1e-12) {
+			t.Errorf("%s: оцінки %v, очікувалося %v", tt.name, scores, tt.want)
+		}
+		if !closeTo(utilities[2], []float64{1, 1}, 0) {
+			t.Errorf("%s: корисності найкращої альтернативи %v", tt.name, utilities[2])
+		}
+	}
+}
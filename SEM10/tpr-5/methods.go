@@ -0,0 +1,98 @@
+package main
+
+import "math"
+
+// CalculateSAW розраховує метод простого зваженого підсумовування (SAW).
+// Критерії максимізації нормуються як x / max, критерії мінімізації – як min / x,
+// після чого оцінка альтернативи дорівнює зваженій сумі нормованих значень.
+// Якщо задано m.normalization, використовується відповідна нормалізація.
+func (m *MCDMSystem) CalculateSAW() []float64 {
+	scores := make([]float64, len(m.alternatives))
+	if m.normalization != "" {
+		return numeric.WeightedSums(m.Normalized(), m.weights)
+	}
+
+	for j, c := range m.criteria {
+		minVal, maxVal := m.columnRange(j)
+		for i := range m.alternatives {
+			var r float64
+			if c.benefit {
+				r = m.matrix[i][j] / maxVal
+			} else {
+				r = minVal / m.matrix[i][j]
+			}
+			scores[i] += m.weights[j] * r
+		}
+	}
+	return scores
+}
+
+// CalculateTOPSIS розраховує коефіцієнти близькості до ідеального розв'язку.
+// Матриця нормується векторно, зважується, після чого для кожної альтернативи
+// обчислюються відстані до ідеального (A+) та антиідеального (A-) розв'язків,
+// а коефіцієнт близькості C = D- / (D+ + D-). Якщо задано m.normalization,
+// замість векторної використовується відповідна нормалізація, після якої
+// всі критерії максимізуються.
+func (m *MCDMSystem) CalculateTOPSIS() []float64 {
+	rows, cols := len(m.alternatives), len(m.criteria)
+
+	weighted := make([][]float64, rows)
+	for i := range rows {
+		weighted[i] = make([]float64, cols)
+	}
+	var normalized [][]float64
+	var norms []float64
+	if m.normalization != "" {
+		normalized = m.Normalized()
+	} else {
+		norms = numeric.ColumnNorms(m.matrix)
+	}
+
+	ideal := make([]float64, cols)
+	antiIdeal := make([]float64, cols)
+	for j, c := range m.criteria {
+		if normalized != nil {
+			c.benefit = true
+			for i := range rows {
+				weighted[i][j] = m.weights[j] * normalized[i][j]
+			}
+		} else {
+			for i := range rows {
+				weighted[i][j] = m.weights[j] * m.matrix[i][j] / norms[j]
+			}
+		}
+
+		best, worst := weighted[0][j], weighted[0][j]
+		for i := range rows {
+			v := weighted[i][j]
+			if (c.benefit && v > best) || (!c.benefit && v < best) {
+				best = v
+			}
+			if (c.benefit && v < worst) || (!c.benefit && v > worst) {
+				worst = v
+			}
+		}
+		ideal[j], antiIdeal[j] = best, worst
+	}
+
+	dPlus := numeric.Distances(weighted, ideal)
+	dMinus := numeric.Distances(weighted, antiIdeal)
+	closeness := make([]float64, rows)
+	for i := range rows {
+		if dPlus[i]+dMinus[i] == 0 {
+			closeness[i] = 0
+			continue
+		}
+		closeness[i] = dMinus[i] / (dPlus[i] + dMinus[i])
+	}
+	return closeness
+}
+
+func (m *MCDMSystem) columnRange(j int) (minVal, maxVal float64) {
+	minVal, maxVal = m.matrix[0][j], m.matrix[0][j]
+	for i := range m.matrix {
+		minVal = math.Min(minVal, m.matrix[i][j])
+		maxVal = math.Max(maxVal, m.matrix[i][j])
+	}
+	return minVal, maxVal
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// closeTo перевіряє, що вектори got і want однакової довжини й збігаються з точністю tol
+func closeTo(got, want []float64, tol float64) bool {
+	return slices.EqualFunc(got, want, func(a, b float64) bool { return math.Abs(a-b) <= tol })
+}
+
+// exampleSystem – задача з двома альтернативами: ціну мінімізують, якість максимізують
+func exampleSystem() *MCDMSystem {
+	return &MCDMSystem{
+		alternatives: []string{"A", "B"},
+		criteria:     []Criterion{{"Ціна", false}, {"Якість", true}},
+		matrix:       [][]float64{{100, 7}, {80, 5}},
+		weights:      []float64{0.4, 0.6},
+	}
+}
+
+func TestMethods(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		calculate func(m *MCDMSystem) []float64
+		want      []float64
+	}{
+		// A: 0.4·80/100 + 0.6·7/7; B: 0.4·80/80 + 0.6·5/7
+		{"SAW", (*MCDMSystem).CalculateSAW, []float64{0.92, 0.4 + 0.6*5.0/7}},
+		{"TOPSIS", (*MCDMSystem).CalculateTOPSIS, []float64{0.6906939923828985, 0.30930600761710153}},
+		// Після min-max нормалізації A = (0, 1), B = (1, 0)
+		{"SAW minmax", func(m *MCDMSystem) []float64 {
+			m.normalization = normMinMax
+			return m.CalculateSAW()
+		}, []float64{0.6, 0.4}},
+		{"TOPSIS minmax", func(m *MCDMSystem) []float64 {
+			m.normalization = normMinMax
+			return m.CalculateTOPSIS()
+		}, []float64{0.6, 0.4}},
+	} {
+		if got := tt.calculate(exampleSystem()); !closeTo(got, tt.want, 1e-12) {
+			t.Errorf("%s = %v, очікувалося %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEntropyWeights(t *testing.T) {
+	// Однакові значення першого критерію не несуть інформації: E = 1, вага 0.
+	// Другий: p = (1/4, 3/4), E = 0.8113, уся вага переходить до нього.
+	weights, entropy := EntropyWeights([][]float64{{1, 1}, {1, 3}})
+	if !closeTo(entropy, []float64{1, 0.8112781244591328}, 1e-12) || !closeTo(weights, []float64{0, 1}, 1e-12) {
+		t.Errorf("EntropyWeights: ваги %v, ентропія %v", weights, entropy)
+	}
+}
+
+func TestRankWeights(t *testing.T) {
+	ranks := []int{2, 1, 3}
+	for _, tt := range []struct {
+		method int
+		want   []float64
+	}{
+		{rankOrderCentroid, []float64{(1.0/2 + 1.0/3) / 3, (1 + 1.0/2 + 1.0/3) / 3, 1.0 / 9}},
+		{rankSum, []float64{2.0 / 6, 3.0 / 6, 1.0 / 6}},
+		{rankReciprocal, []float64{0.5 / (11.0 / 6), 1 / (11.0 / 6), 1.0 / 3 / (11.0 / 6)}},
+	} {
+		if got := RankWeights(ranks, tt.method); !closeTo(got, tt.want, 1e-12) {
+			t.Errorf("RankWeights(%v, %s) = %v, очікувалося %v", ranks, rankMethodNames[tt.method], got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeWeights(t *testing.T) {
+	for _, tt := range []struct {
+		weights, want []float64
+	}{
+		{[]float64{1, 3}, []float64{0.25, 0.75}},
+		// Сума переповнилася б до +Inf і обнулила ваги
+		{[]float64{math.MaxFloat64, math.MaxFloat64}, []float64{0.5, 0.5}},
+		{[]float64{0, 0}, nil},
+	} {
+		weights := slices.Clone(tt.weights)
+		ok := normalizeWeights(weights)
+		if ok != (tt.want != nil) || ok && !closeTo(weights, tt.want, 1e-12) {
+			t.Errorf("normalizeWeights(%v) = %v, %v; очікувалося %v", tt.weights, weights, ok, tt.want)
+		}
+	}
+}
+
+func TestBWMWeights(t *testing.T) {
+	for _, tt := range []struct {
+		name                        string
+		bestToOthers, othersToWorst []float64
+		weights                     []float64
+		ksi, cr                     float64
+	}{
+		// Узгоджені порівняння: w_B/w_j = a_Bj, w_j/w_W = a_jW без відхилень
+		{"узгоджені", []float64{1, 2, 4}, []float64{4, 2, 1}, []float64{4.0 / 7, 2.0 / 7, 1.0 / 7}, 0, 0},
+		// a_B1·a_1W = 4 ≠ a_BW = 8. Із w_1 = 2w_2 + e_3, w_0 = 2w_1 + e_1 = 8w_2 + e_2 випливає
+		// Σ w = 7w_2 + 3e_3 + e_1 <= 11ξ, тож ξ* = 1/11 при w = (7, 3, 1)/11;
+		// CR = |2·2 - 8| / (8² - 8) = 1/14
+		{"неузгоджені", []float64{1, 2, 8}, []float64{8, 2, 1}, []float64{7.0 / 11, 3.0 / 11, 1.0 / 11}, 1.0 / 11, 1.0 / 14},
+	} {
+		weights, ksi, ok := BWMWeights(0, 2, tt.bestToOthers, tt.othersToWorst)
+		if !ok || !closeTo(weights, tt.weights, 1e-9) || math.Abs(ksi-tt.ksi) > 1e-9 {
+			t.Errorf("%s: BWMWeights = %v, ξ = %v, %v; очікувалося %v, ξ = %v", tt.name, weights, ksi, ok, tt.weights, tt.ksi)
+		}
+		if cr := BWMConsistencyRatio(2, tt.bestToOthers, tt.othersToWorst); math.Abs(cr-tt.cr) > 1e-12 {
+			t.Errorf("%s: BWMConsistencyRatio = %v, очікувалося %v", tt.name, cr, tt.cr)
+		}
+	}
+}
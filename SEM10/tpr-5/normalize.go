@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Способи нормалізації матриці рішень. Після нормалізації всі критерії
+// стають критеріями максимізації зі значеннями від 0 до 1.
+const (
+	normMinMax = "minmax" // (x − min) / (max − min); мінімізація – (max − x) / (max − min)
+	normVector = "vector" // x / √Σx²; мінімізація – 1 − x / √Σx²
+	normSum    = "sum"    // x / Σx; мінімізація – (1/x) / Σ(1/x)
+	normMax    = "max"    // x / max; мінімізація – min / x
+)
+
+var normalizations = []string{normMinMax, normVector, normSum, normMax}
+
+// checkNormalization перевіряє назву способу нормалізації; порожня назва –
+// кожен метод нормує матрицю по-своєму (SAW – за максимумом, TOPSIS – векторно)
+func checkNormalization(method string) error {
+	if method == "" || slices.Contains(normalizations, method) {
+		return nil
+	}
+	return fmt.Errorf(errNormalization, method, strings.Join(normalizations, ", "))
+}
+
+// Normalized повертає матрицю рішень, нормалізовану способом m.normalization
+// з урахуванням напряму критеріїв: більше значення завжди краще
+func (m *MCDMSystem) Normalized() [][]float64 {
+	out := make([][]float64, len(m.matrix))
+	for i := range out {
+		out[i] = make([]float64, len(m.criteria))
+	}
+	norms := numeric.ColumnNorms(m.matrix)
+	for j, c := range m.criteria {
+		minVal, maxVal := m.columnRange(j)
+		norm, total, inverseTotal := norms[j], 0.0, 0.0
+		for i := range m.matrix {
+			x := m.matrix[i][j]
+			total += x
+			inverseTotal += 1 / x
+		}
+
+		for i := range m.matrix {
+			x := m.matrix[i][j]
+			var r float64
+			switch m.normalization {
+			case normMinMax:
+				r = 1
+				if maxVal > minVal {
+					r = (x - minVal) / (maxVal - minVal)
+					if !c.benefit {
+						r = 1 - r
+					}
+				}
+			case normVector:
+				r = x / norm
+				if !c.benefit {
+					r = 1 - r
+				}
+			case normSum:
+				r = x / total
+				if !c.benefit {
+					r = 1 / x / inverseTotal
+				}
+			case normMax:
+				r = x / maxVal
+				if !c.benefit {
+					r = minVal / x
+				}
+			}
+			out[i][j] = r
+		}
+	}
+	return out
+}
+
+// PrintNormalizedMatrix виводить нормалізовану матрицю рішень
+func (m *MCDMSystem) PrintNormalizedMatrix() {
+	fmt.Printf("\nНормалізована матриця рішень (%s):\n", m.normalization)
+	fmt.Printf(headerFormat, "Альтернатива")
+	for _, c := range m.criteria {
+		fmt.Printf(critHeaderFormat, c.name)
+	}
+	fmt.Println()
+	for i, row := range m.Normalized() {
+		fmt.Printf(headerFormat, m.alternatives[i])
+		for _, v := range row {
+			fmt.Printf(weightFormat, v)
+		}
+		fmt.Println()
+	}
+}
+
+// SaveNormalizedCSV записує нормалізовану матрицю рішень у файл CSV:
+// рядок заголовків з назвами критеріїв, далі рядок на кожну альтернативу
+func (m *MCDMSystem) SaveNormalizedCSV(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	header := []string{"Альтернатива"}
+	for _, c := range m.criteria {
+		header = append(header, c.name)
+	}
+	w.Write(header)
+	for i, row := range m.Normalized() {
+		record := []string{m.alternatives[i]}
+		for _, v := range row {
+			record = append(record, strconv.FormatFloat(v, 'g', -1, 64))
+		}
+		w.Write(record)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Обчислювальні рушії матричних операцій
+const (
+	engineBuiltin = "builtin" // власна реалізація на зрізах
+	engineGonum   = "gonum"   // gonum/mat, лише у збірці з тегом gonum (див. numeric_gonum.go)
+)
+
+// numericEngine – матричні операції, на яких побудовано нормалізацію, SAW, TOPSIS
+// і AHP; рушій обирається прапорцем -engine
+type numericEngine interface {
+	// ColumnNorms повертає евклідові норми стовпців √Σx²
+	ColumnNorms(a [][]float64) []float64
+	// WeightedSums повертає зважену суму Σ w_j·a_ij кожного рядка
+	WeightedSums(a [][]float64, w []float64) []float64
+	// Distances повертає евклідову відстань від кожного рядка до точки p
+	Distances(a [][]float64, p []float64) []float64
+	// PrincipalEigenvector повертає головний власний вектор додатної матриці, нормований до суми 1
+	PrincipalEigenvector(a [][]float64) []float64
+}
+
+// engines – доступні рушії; рушій gonum додається у збірці з тегом gonum
+var engines = map[string]numericEngine{engineBuiltin: builtinEngine{}}
+
+// numeric – рушій, обраний прапорцем -engine
+var numeric numericEngine = builtinEngine{}
+
+// setEngine обирає рушій за назвою
+func setEngine(name string) error {
+	e, ok := engines[name]
+	if !ok {
+		names := make([]string, 0, len(engines))
+		for n := range engines {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		return fmt.Errorf(errEngine, name, strings.Join(names, ", "))
+	}
+	numeric = e
+	return nil
+}
+
+// builtinEngine – рушій без зовнішніх залежностей
+type builtinEngine struct{}
+
+func (builtinEngine) ColumnNorms(a [][]float64) []float64 {
+	norms := make([]float64, len(a[0]))
+	for _, row := range a {
+		for j, x := range row {
+			norms[j] += x * x
+		}
+	}
+	for j := range norms {
+		norms[j] = math.Sqrt(norms[j])
+	}
+	return norms
+}
+
+func (builtinEngine) WeightedSums(a [][]float64, w []float64) []float64 {
+	sums := make([]float64, len(a))
+	for i, row := range a {
+		for j, x := range row {
+			sums[i] += w[j] * x
+		}
+	}
+	return sums
+}
+
+func (builtinEngine) Distances(a [][]float64, p []float64) []float64 {
+	out := make([]float64, len(a))
+	for i, row := range a {
+		out[i] = distance(row, p)
+	}
+	return out
+}
+
+// PrincipalEigenvector знаходить вектор степеневим методом
+func (builtinEngine) PrincipalEigenvector(a [][]float64) []float64 {
+	n := len(a)
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1 / float64(n)
+	}
+
+	for range 1000 {
+		next := make([]float64, n)
+		sum := 0.0
+		for i := range n {
+			for j := range n {
+				next[i] += a[i][j] * weights[j]
+			}
+			sum += next[i]
+		}
+
+		delta := 0.0
+		for i := range n {
+			next[i] /= sum
+			delta = math.Max(delta, math.Abs(next[i]-weights[i]))
+		}
+		weights = next
+		if delta < 1e-12 {
+			break
+		}
+	}
+	return weights
+}
+
+// distance повертає евклідову відстань між двома векторами
+func distance(a, b []float64) float64 {
+	sum := 0.0
+	for j := range a {
+		d := a[j] - b[j]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,82 @@
+//go:build gonum
+
+// Рушій gonum (модуль gonum.org/v1/gonum) збирається лише з тегом gonum, тож
+// збірка за замовчуванням його не компілює:
+//
+//	go build -tags gonum
+//	./tpr-5 -engine gonum
+package main
+
+import "gonum.org/v1/gonum/mat"
+
+func init() {
+	engines[engineGonum] = gonumEngine{}
+}
+
+// gonumEngine – рушій на основі gonum/mat: BLAS для добутків і LAPACK для власних векторів
+type gonumEngine struct{}
+
+// dense копіює матрицю зі зрізів у mat.Dense
+func dense(a [][]float64) *mat.Dense {
+	d := mat.NewDense(len(a), len(a[0]), nil)
+	for i, row := range a {
+		d.SetRow(i, row)
+	}
+	return d
+}
+
+func (gonumEngine) ColumnNorms(a [][]float64) []float64 {
+	d := dense(a)
+	_, cols := d.Dims()
+	norms := make([]float64, cols)
+	for j := range norms {
+		norms[j] = mat.Norm(d.ColView(j), 2)
+	}
+	return norms
+}
+
+func (gonumEngine) WeightedSums(a [][]float64, w []float64) []float64 {
+	var sums mat.VecDense
+	sums.MulVec(dense(a), mat.NewVecDense(len(w), w))
+	return mat.Col(nil, 0, &sums)
+}
+
+func (gonumEngine) Distances(a [][]float64, p []float64) []float64 {
+	point := mat.NewVecDense(len(p), p)
+	out := make([]float64, len(a))
+	var diff mat.VecDense
+	for i, row := range a {
+		diff.SubVec(mat.NewVecDense(len(row), row), point)
+		out[i] = mat.Norm(&diff, 2)
+	}
+	return out
+}
+
+// PrincipalEigenvector знаходить вектор повним розкладом на власні значення;
+// якщо розклад не вдався, використовується степеневий метод вбудованого рушія
+func (gonumEngine) PrincipalEigenvector(a [][]float64) []float64 {
+	var eig mat.Eigen
+	if !eig.Factorize(dense(a), mat.EigenRight) {
+		return builtinEngine{}.PrincipalEigenvector(a)
+	}
+	values := eig.Values(nil)
+	k := 0
+	for i, v := range values {
+		if real(v) > real(values[k]) {
+			k = i
+		}
+	}
+	var vectors mat.CDense
+	eig.VectorsTo(&vectors)
+
+	weights := make([]float64, len(a))
+	sum := 0.0
+	for i := range weights {
+		weights[i] = real(vectors.At(i, k))
+		sum += weights[i]
+	}
+	for i := range weights {
+		weights[i] /= sum
+	}
+	return weights
+}
@@ -0,0 +1,32 @@
+//go:build gonum
+
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// TestGonumMatchesBuiltin перевіряє, що рушій gonum дає ті самі результати, що й вбудований
+func TestGonumMatchesBuiltin(t *testing.T) {
+	a := [][]float64{{1, 1.0 / 3, 5}, {3, 1, 7}, {1.0 / 5, 1.0 / 7, 1}}
+	w := []float64{0.2, 0.3, 0.5}
+	builtin, gonum := engines[engineBuiltin], engines[engineGonum]
+
+	for _, tt := range []struct {
+		name      string
+		got, want []float64
+	}{
+		{"ColumnNorms", gonum.ColumnNorms(a), builtin.ColumnNorms(a)},
+		{"WeightedSums", gonum.WeightedSums(a, w), builtin.WeightedSums(a, w)},
+		{"Distances", gonum.Distances(a, w), builtin.Distances(a, w)},
+		{"PrincipalEigenvector", gonum.PrincipalEigenvector(a), builtin.PrincipalEigenvector(a)},
+	} {
+		for i := range tt.want {
+			if math.Abs(tt.got[i]-tt.want[i]) > 1e-9 {
+				t.Errorf("%s: gonum %v, builtin %v", tt.name, tt.got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// without повертає копію задачі без альтернативи i; критерії та ваги ті самі
+func (m *MCDMSystem) without(i int) *MCDMSystem {
+	c := *m
+	c.alternatives = slices.Delete(slices.Clone(m.alternatives), i, i+1)
+	c.matrix = slices.Delete(slices.Clone(m.matrix), i, i+1)
+	return &c
+}
+
+// rankOrder повертає альтернативи від найкращої до найгіршої
+func rankOrder(alts []string, scores []float64) []string {
+	order := make([]string, len(alts))
+	for i, av := range sortAltValues(alts, scores) {
+		order[i] = av.alt
+	}
+	return order
+}
+
+// reversedPairs повертає пари альтернатив, відносний порядок яких у after
+// відрізняється від порядку в before (альтернативи, яких немає в after, пропускаються)
+func reversedPairs(before, after []string) []string {
+	pos := make(map[string]int, len(after))
+	for i, alt := range after {
+		pos[alt] = i
+	}
+	var pairs []string
+	for i, a := range before {
+		if _, ok := pos[a]; !ok {
+			continue
+		}
+		for _, b := range before[i+1:] {
+			if p, ok := pos[b]; ok && p < pos[a] {
+				pairs = append(pairs, fmt.Sprintf("%s ≻ %s → %s ≻ %s", a, b, b, a))
+			}
+		}
+	}
+	return pairs
+}
+
+// PrintRankReversal по черзі видаляє кожну альтернативу, крім найкращої,
+// перераховує метод і показує, чи змінився порядок решти альтернатив (rank reversal)
+func (m *MCDMSystem) PrintRankReversal(title string, calculate func(*MCDMSystem) []float64) {
+	base := rankOrder(m.alternatives, calculate(m))
+	fmt.Printf("\nПеревірка rank reversal для методу %s (базове ранжування: %s):\n", title, strings.Join(base, " ≻ "))
+	fmt.Printf(headerFormat, "Видалено")
+	fmt.Printf("%-12s%s\n", "Зміна", "Нове ранжування")
+
+	reversals := 0
+	for _, removed := range base[1:] {
+		reduced := m.without(slices.Index(m.alternatives, removed))
+		order := rankOrder(reduced.alternatives, calculate(reduced))
+		pairs := reversedPairs(base, order)
+
+		fmt.Printf(headerFormat, removed)
+		status := "ні"
+		if len(pairs) > 0 {
+			status = "так"
+			reversals++
+		}
+		fmt.Printf("%-12s%s\n", status, strings.Join(order, " ≻ "))
+		for _, p := range pairs {
+			fmt.Printf("%20s  %s\n", "", p)
+		}
+	}
+
+	if reversals == 0 {
+		fmt.Println("Видалення жодної альтернативи не змінює порядок решти – ранжування стійке")
+		return
+	}
+	fmt.Printf("Rank reversal виникає після видалення %d з %d альтернатив\n", reversals, len(base)-1)
+}
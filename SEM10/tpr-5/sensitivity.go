@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// sensitivityStep – крок пошуку межі інтервалу стійкості ваги; межа уточнюється бісекцією до sensitivityEps
+	sensitivityStep = 0.001
+	sensitivityEps  = 1e-7
+)
+
+// withWeight повертає копію задачі, у якій вага критерію j дорівнює w, а решта ваг
+// змінена пропорційно так, щоб сума лишалася 1 (якщо решта ваг нульові – порівну)
+func (m *MCDMSystem) withWeight(j int, w float64) *MCDMSystem {
+	c := *m
+	c.weights = make([]float64, len(m.weights))
+	rest := 1 - m.weights[j]
+	for k, v := range m.weights {
+		switch {
+		case k == j:
+			c.weights[k] = w
+		case rest > 0:
+			c.weights[k] = v * (1 - w) / rest
+		default:
+			c.weights[k] = (1 - w) / float64(len(m.weights)-1)
+		}
+	}
+	return &c
+}
+
+// bestAlternative повертає найкращу альтернативу за методом calculate
+func (m *MCDMSystem) bestAlternative(calculate func(*MCDMSystem) []float64) string {
+	return sortAltValues(m.alternatives, calculate(m))[0].alt
+}
+
+// stabilityBound шукає від поточної ваги критерію j у напрямку dir (−1 або 1) найближчу
+// вагу, за якої змінюється найкраща альтернатива. Якщо такої ваги в [0; 1] немає,
+// повертає край відрізка і порожню назву альтернативи.
+func (m *MCDMSystem) stabilityBound(j int, dir float64, calculate func(*MCDMSystem) []float64) (float64, string) {
+	best := m.bestAlternative(calculate)
+	limit := (1 + dir) / 2
+	stable := m.weights[j]
+	for stable != limit {
+		next := stable + dir*sensitivityStep
+		if (next-limit)*dir > 0 {
+			next = limit
+		}
+		if alt := m.withWeight(j, next).bestAlternative(calculate); alt != best {
+			// Межа між stable і next уточнюється бісекцією
+			changed, winner := next, alt
+			for math.Abs(changed-stable) > sensitivityEps {
+				mid := (stable + changed) / 2
+				if alt := m.withWeight(j, mid).bestAlternative(calculate); alt != best {
+					changed, winner = mid, alt
+				} else {
+					stable = mid
+				}
+			}
+			return changed, winner
+		}
+		stable = next
+	}
+	return limit, ""
+}
+
+// PrintWeightSensitivity змінює вагу кожного критерію на ±delta (з перенормуванням решти ваг),
+// показує найкращу альтернативу після зміни, інтервал ваги, у якому найкраща альтернатива
+// не змінюється, і найменшу зміну однієї ваги, що змінює найкращу альтернативу
+func (m *MCDMSystem) PrintWeightSensitivity(title string, delta float64, calculate func(*MCDMSystem) []float64) {
+	best := m.bestAlternative(calculate)
+	fmt.Printf("\nЧутливість методу %s до ваг критеріїв (найкраща альтернатива: %s, δ = %g):\n", title, best, delta)
+	fmt.Printf(headerFormat+critHeaderFormat+headerFormat+headerFormat+"%s\n", "Критерій", "Вага", "Найкраща при w−δ", "Найкраща при w+δ", "Інтервал стійкості")
+
+	minChange, minCrit, minWinner := math.Inf(1), -1, ""
+	for j, c := range m.criteria {
+		w := m.weights[j]
+		lo, loWinner := m.stabilityBound(j, -1, calculate)
+		hi, hiWinner := m.stabilityBound(j, 1, calculate)
+		fmt.Printf(headerFormat+weightFormat+headerFormat+headerFormat+"[%.4f; %.4f]\n", c.name, w,
+			m.withWeight(j, math.Max(w-delta, 0)).bestAlternative(calculate),
+			m.withWeight(j, math.Min(w+delta, 1)).bestAlternative(calculate), lo, hi)
+
+		for _, bound := range []struct {
+			change float64
+			winner string
+		}{{w - lo, loWinner}, {hi - w, hiWinner}} {
+			if bound.winner != "" && bound.change < minChange {
+				minChange, minCrit, minWinner = bound.change, j, bound.winner
+			}
+		}
+	}
+
+	if minCrit < 0 {
+		fmt.Println("Зміна ваги жодного критерію не змінює найкращу альтернативу – результат стійкий")
+		return
+	}
+	fmt.Printf("Найменша зміна, що змінює найкращу альтернативу: вага критерію '%s' на %.4f (%.1f%% від поточної), найкращою стає %s\n",
+		m.criteria[minCrit].name, minChange, 100*minChange/math.Max(m.weights[minCrit], sensitivityEps), minWinner)
+}
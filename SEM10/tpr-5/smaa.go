@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"os/signal"
+	"sort"
+
+	"tprlib/progress"
+)
+
+const (
+	smaaWeightsUniform = iota + 1
+	smaaWeightsOrdinal
+	smaaWeightsInterval
+)
+
+// smaaMaxRejections обмежує кількість спроб вибірки ваг у заданих інтервалах
+const smaaMaxRejections = 100000
+
+var (
+	// rng – генератор випадкових ваг і відхилень значень SMAA із зерном seed
+	rng  *rand.Rand
+	seed uint64
+)
+
+// setSeed задає зерно генератора: однакове зерно й однакові вхідні дані дають однакові індекси
+func setSeed(s uint64) {
+	seed = s
+	rng = rand.New(rand.NewPCG(s, s))
+}
+
+// WeightSampler генерує випадкові вектори ваг з розподілу, що відповідає
+// наявній інформації про переваги особи, яка приймає рішення
+type WeightSampler struct {
+	kind   int
+	ranks  []int // ранги важливості критеріїв для порядкової інформації
+	lo, hi []float64
+}
+
+// uniformSimplex рівномірно вибирає вектор ваг із симплекса Σ w_j = 1, w_j >= 0:
+// різниці між впорядкованими рівномірно розподіленими точками на [0; 1]
+func uniformSimplex(n int) []float64 {
+	cuts := make([]float64, n+1)
+	cuts[n] = 1
+	for j := 1; j < n; j++ {
+		cuts[j] = rng.Float64()
+	}
+	sort.Float64s(cuts)
+
+	w := make([]float64, n)
+	for j := range n {
+		w[j] = cuts[j+1] - cuts[j]
+	}
+	return w
+}
+
+// Sample повертає вектор ваг або false, якщо не вдалося потрапити в інтервали
+func (s *WeightSampler) Sample(n int) ([]float64, bool) {
+	switch s.kind {
+	case smaaWeightsOrdinal:
+		// Впорядковані за спаданням ваги призначаються критеріям за рангами
+		sorted := uniformSimplex(n)
+		sort.Sort(sort.Reverse(sort.Float64Slice(sorted)))
+		w := make([]float64, n)
+		for j, r := range s.ranks {
+			w[j] = sorted[r-1]
+		}
+		return w, true
+	case smaaWeightsInterval:
+		for range smaaMaxRejections {
+			w := uniformSimplex(n)
+			if s.inBounds(w) {
+				return w, true
+			}
+		}
+		return nil, false
+	default:
+		return uniformSimplex(n), true
+	}
+}
+
+func (s *WeightSampler) inBounds(w []float64) bool {
+	for j := range w {
+		if w[j] < s.lo[j] || w[j] > s.hi[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// CollectWeightSampler зчитує вид інформації про ваги критеріїв
+func (m *MCDMSystem) CollectWeightSampler(ir *inputReader) *WeightSampler {
+	s := &WeightSampler{kind: ir.readChoice(promptSMAAWeights, smaaWeightsInterval)}
+	switch s.kind {
+	case smaaWeightsOrdinal:
+		s.ranks = ir.readRanks(m.criterionNames())
+	case smaaWeightsInterval:
+		s.lo, s.hi = m.readWeightIntervals(ir)
+	}
+	return s
+}
+
+// readWeightIntervals зчитує межі ваг, доки інтервали не перетинатимуть симплекс
+func (m *MCDMSystem) readWeightIntervals(ir *inputReader) (lo, hi []float64) {
+	n := len(m.criteria)
+	for {
+		lo, hi = make([]float64, n), make([]float64, n)
+		loSum, hiSum := 0.0, 0.0
+		for j, c := range m.criteria {
+			lo[j] = ir.readValidatedFloat(fmt.Sprintf(promptWeightLo, c.name), 0, 1)
+			hi[j] = ir.readValidatedFloat(fmt.Sprintf(promptWeightHi, c.name), lo[j], 1)
+			loSum += lo[j]
+			hiSum += hi[j]
+		}
+
+		if loSum <= 1 && hiSum >= 1 {
+			return lo, hi
+		}
+		fmt.Println(errWeightIntervals)
+	}
+}
+
+// perturbMatrix повертає матрицю, значення якої рівномірно відхиляються
+// від заданих на ±deviation (відносно)
+func (m *MCDMSystem) perturbMatrix(deviation float64) [][]float64 {
+	if deviation == 0 {
+		return m.matrix
+	}
+	matrix := make([][]float64, len(m.matrix))
+	for i, row := range m.matrix {
+		matrix[i] = make([]float64, len(row))
+		for j, v := range row {
+			matrix[i][j] = v * (1 + deviation*(2*rng.Float64()-1))
+		}
+	}
+	return matrix
+}
+
+// sampleScores розраховує оцінки SAW для випадкових значень критеріїв та заданих ваг
+func (m *MCDMSystem) sampleScores(weights []float64, deviation float64) []float64 {
+	sample := *m
+	sample.matrix = m.perturbMatrix(deviation)
+	sample.weights = weights
+	return sample.CalculateSAW()
+}
+
+// ranksOf повертає ранги альтернатив (1 – найкраща); за рівних оцінок ранги однакові
+func ranksOf(scores []float64) []int {
+	ranks := make([]int, len(scores))
+	for i := range scores {
+		ranks[i] = 1
+		for k := range scores {
+			if scores[k] > scores[i] {
+				ranks[i]++
+			}
+		}
+	}
+	return ranks
+}
+
+// SMAAResult – результати стохастичного аналізу прийнятності
+type SMAAResult struct {
+	// acceptability[i][r] – індекс прийнятності рангу b_i^r
+	acceptability [][]float64
+	// holistic[i] – цілісний індекс прийнятності a_i^h = Σ α_r·b_i^r
+	holistic []float64
+	// central[i] – центральний вектор ваг (nil, якщо альтернатива ніколи не найкраща)
+	central [][]float64
+	// confidence[i] – коефіцієнт довіри p_i^c
+	confidence []float64
+	// iterations – кількість виконаних ітерацій; менша за задану, якщо обчислення перервано
+	iterations  int
+	interrupted bool
+}
+
+// CalculateSMAA виконує метод SMAA-2 методом Монте-Карло: для випадкових ваг
+// (і, за потреби, значень критеріїв) альтернативи впорядковуються за SAW;
+// частка ітерацій, у яких альтернатива отримала ранг r, дає індекс прийнятності b_i^r,
+// середній вектор ваг, за яких вона найкраща, – центральний вектор ваг w_i^c,
+// а частка ітерацій, у яких вона найкраща за ваг w_i^c, – коефіцієнт довіри p_i^c.
+// Після скасування ctx індекси обчислюються за вже виконаними ітераціями.
+// Хід обчислень з оцінкою часу до завершення виводиться у stderr.
+func (m *MCDMSystem) CalculateSMAA(ctx context.Context, s *WeightSampler, deviation float64, iterations int) (*SMAAResult, bool) {
+	alts, n := len(m.alternatives), len(m.criteria)
+	res := &SMAAResult{
+		acceptability: make([][]float64, alts),
+		holistic:      make([]float64, alts),
+		central:       make([][]float64, alts),
+		confidence:    make([]float64, alts),
+	}
+	sums := make([][]float64, alts)
+	for i := range alts {
+		res.acceptability[i] = make([]float64, alts)
+		sums[i] = make([]float64, n)
+	}
+
+	bar := progress.New("Індекси прийнятності", iterations)
+	for range iterations {
+		if ctx.Err() != nil {
+			res.interrupted = true
+			break
+		}
+		w, ok := s.Sample(n)
+		if !ok {
+			return nil, false
+		}
+		for i, r := range ranksOf(m.sampleScores(w, deviation)) {
+			res.acceptability[i][r-1]++
+			if r == 1 {
+				for j := range n {
+					sums[i][j] += w[j]
+				}
+			}
+		}
+		res.iterations++
+		bar.Update(res.iterations)
+	}
+	bar.Done(res.iterations)
+	if res.iterations == 0 {
+		return res, true
+	}
+
+	for i := range alts {
+		first := res.acceptability[i][0]
+		if first > 0 {
+			res.central[i] = make([]float64, n)
+			for j := range n {
+				res.central[i][j] = sums[i][j] / first
+			}
+		}
+		for r := range alts {
+			res.acceptability[i][r] /= float64(res.iterations)
+			// Лінійні метаваги: α_1 = 1, …, α_m = 0
+			if alts > 1 {
+				res.holistic[i] += float64(alts-1-r) / float64(alts-1) * res.acceptability[i][r]
+			} else {
+				res.holistic[i] = res.acceptability[i][r]
+			}
+		}
+	}
+
+	candidates := 0
+	for _, w := range res.central {
+		if w != nil {
+			candidates++
+		}
+	}
+	bar = progress.New("Коефіцієнти довіри", candidates*iterations)
+	total := 0
+	for i, w := range res.central {
+		if w == nil {
+			continue
+		}
+		best, done := 0, 0
+		for ; done < iterations && ctx.Err() == nil; done++ {
+			if ranksOf(m.sampleScores(w, deviation))[i] == 1 {
+				best++
+			}
+			bar.Update(total + done + 1)
+		}
+		total += done
+		if done < iterations {
+			res.interrupted = true
+		}
+		if done > 0 {
+			res.confidence[i] = float64(best) / float64(done)
+		}
+	}
+	bar.Done(total)
+	return res, true
+}
+
+func (m *MCDMSystem) PrintSMAA(res *SMAAResult) {
+	fmt.Println("\nІндекси прийнятності рангів b_i^r (%):")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for r := range m.alternatives {
+		fmt.Printf(critHeaderFormat, fmt.Sprintf("Ранг %d", r+1))
+	}
+	fmt.Printf(critHeaderFormat, "a^h")
+	fmt.Println()
+
+	for i, alt := range m.alternatives {
+		fmt.Printf(headerFormat, alt)
+		for _, b := range res.acceptability[i] {
+			fmt.Printf(scoreFormat, b*100)
+		}
+		fmt.Printf(scoreFormat, res.holistic[i]*100)
+		fmt.Println()
+	}
+
+	fmt.Println("\nЦентральні вектори ваг та коефіцієнти довіри:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for _, c := range m.criteria {
+		fmt.Printf(critHeaderFormat, c.name)
+	}
+	fmt.Printf(critHeaderFormat, "p^c")
+	fmt.Println()
+
+	for i, alt := range m.alternatives {
+		fmt.Printf(headerFormat, alt)
+		if res.central[i] == nil {
+			fmt.Println("ніколи не буває найкращою")
+			continue
+		}
+		for _, w := range res.central[i] {
+			fmt.Printf(weightFormat, w)
+		}
+		fmt.Printf(weightFormat, res.confidence[i])
+		fmt.Println()
+	}
+}
+
+// RunSMAA виконує стохастичний аналіз прийнятності за невідомих ваг
+func (m *MCDMSystem) RunSMAA(ir *inputReader) {
+	s := m.CollectWeightSampler(ir)
+	deviation := ir.readValidatedFloat(promptSMAADeviation, 0, 0.99)
+	iterations := ir.readIntInRange(promptSMAAIterations, 100, 1000000)
+	fmt.Printf(promptSMAASeed, seed, seed)
+
+	// Ctrl+C під час обчислень зупиняє лише їх, а не всю програму
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	res, ok := m.CalculateSMAA(ctx, s, deviation, iterations)
+	stop()
+	if !ok {
+		fmt.Println(errSMAANoWeights)
+		return
+	}
+	if res.interrupted {
+		fmt.Printf("\n"+errSMAAInterrupted+"\n", res.iterations, iterations)
+		if res.iterations == 0 {
+			return
+		}
+	}
+	m.PrintSMAA(res)
+
+	first := make([]float64, len(m.alternatives))
+	for i := range first {
+		first[i] = res.acceptability[i][0]
+	}
+	PrintRanking("SMAA-2", sortAltValues(m.alternatives, first), "b^1")
+}
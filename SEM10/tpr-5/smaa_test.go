@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCalculateSMAA(t *testing.T) {
+	const iterations = 20000
+	for _, tt := range []struct {
+		name          string
+		matrix        [][]float64
+		acceptability [][]float64
+		holistic      []float64
+		confidence    []float64
+		central       [][]float64
+		tol           float64
+	}{
+		// A краща за обома критеріями за будь-яких ваг
+		{"домінування", [][]float64{{2, 2}, {1, 1}},
+			[][]float64{{1, 0}, {0, 1}}, []float64{1, 0}, []float64{1, 0},
+			[][]float64{{0.5, 0.5}, nil}, 0.02},
+		// Після нормування A = (1, 0.5), B = (0.5, 1): A найкраща, коли w1 > w2, тобто з імовірністю 1/2;
+		// центральний вектор ваг A – E[w | w1 > w2] = (3/4, 1/4)
+		{"симетрія", [][]float64{{2, 1}, {1, 2}},
+			[][]float64{{0.5, 0.5}, {0.5, 0.5}}, []float64{0.5, 0.5}, []float64{1, 1},
+			[][]float64{{0.75, 0.25}, {0.25, 0.75}}, 0.02},
+	} {
+		m := &MCDMSystem{
+			alternatives: []string{"A", "B"},
+			criteria:     []Criterion{{"g1", true}, {"g2", true}},
+			matrix:       tt.matrix,
+		}
+		setSeed(1)
+		res, ok := m.CalculateSMAA(context.Background(), &WeightSampler{kind: smaaWeightsUniform}, 0, iterations)
+		if !ok || res.iterations != iterations || res.interrupted {
+			t.Fatalf("%s: виконано %d з %d ітерацій", tt.name, res.iterations, iterations)
+		}
+		for i := range tt.acceptability {
+			if !closeTo(res.acceptability[i], tt.acceptability[i], tt.tol) {
+				t.Errorf("%s: індекси прийнятності %s – %v, очікувалося %v", tt.name, m.alternatives[i], res.acceptability[i], tt.acceptability[i])
+			}
+			if (res.central[i] == nil) != (tt.central[i] == nil) || !closeTo(res.central[i], tt.central[i], tt.tol) {
+				t.Errorf("%s: центральний вектор ваг %s – %v, очікувалося %v", tt.name, m.alternatives[i], res.central[i], tt.central[i])
+			}
+		}
+		if !closeTo(res.holistic, tt.holistic, tt.tol) || !closeTo(res.confidence, tt.confidence, tt.tol) {
+			t.Errorf("%s: цілісні індекси %v, коефіцієнти довіри %v; очікувалося %v, %v", tt.name, res.holistic, res.confidence, tt.holistic, tt.confidence)
+		}
+	}
+}
+
+func TestSMAASeed(t *testing.T) {
+	// Однакове зерно дає однакові індекси прийнятності
+	m := exampleSystem()
+	sampler := &WeightSampler{kind: smaaWeightsUniform}
+	run := func() *SMAAResult {
+		setSeed(42)
+		res, _ := m.CalculateSMAA(context.Background(), sampler, 0.1, 1000)
+		return res
+	}
+	first, second := run(), run()
+	for i := range first.acceptability {
+		if !closeTo(first.acceptability[i], second.acceptability[i], 0) {
+			t.Fatalf("різні індекси з одним зерном: %v і %v", first.acceptability, second.acceptability)
+		}
+	}
+}
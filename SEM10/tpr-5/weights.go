@@ -0,0 +1,168 @@
+package main
+
+import (
+	"math"
+	"slices"
+
+	"tprlib/lp"
+)
+
+// EntropyWeights розраховує об'єктивні ваги критеріїв за ентропією Шеннона.
+// Стовпці матриці нормуються так, щоб сума дорівнювала 1 (p_ij),
+// ентропія критерію E_j = -1/ln(m) * Σ p_ij ln p_ij,
+// а вага пропорційна ступеню диверсифікації d_j = 1 - E_j.
+// Чим сильніше відрізняються значення альтернатив за критерієм,
+// тим більше інформації він несе і тим більша його вага.
+func EntropyWeights(matrix [][]float64) (weights, entropy []float64) {
+	m := len(matrix)
+	n := len(matrix[0])
+	weights = make([]float64, n)
+	entropy = make([]float64, n)
+
+	k := 0.0
+	if m > 1 {
+		k = 1 / math.Log(float64(m))
+	}
+
+	divSum := 0.0
+	for j := range n {
+		colSum := 0.0
+		for i := range m {
+			colSum += matrix[i][j]
+		}
+
+		e := 0.0
+		for i := range m {
+			p := matrix[i][j] / colSum
+			if p > 0 {
+				e -= p * math.Log(p)
+			}
+		}
+		entropy[j] = k * e
+		weights[j] = 1 - entropy[j]
+		divSum += weights[j]
+	}
+
+	// Якщо всі стовпці однакові, жоден критерій не розрізняє альтернатив
+	if divSum == 0 {
+		for j := range weights {
+			weights[j] = 1 / float64(n)
+		}
+		return weights, entropy
+	}
+
+	for j := range weights {
+		weights[j] /= divSum
+	}
+	return weights, entropy
+}
+
+// BWMWeights розраховує ваги методом найкращого-найгіршого (лінійна модель Резаї):
+// min ξ за умов |w_B - a_Bj·w_j| <= ξ, |w_j - a_jW·w_W| <= ξ, Σ w_j = 1, w_j >= 0.
+// Задача розв'язується симплекс-методом; ξ* показує відхилення від повної узгодженості.
+func BWMWeights(best, worst int, bestToOthers, othersToWorst []float64) (weights []float64, ksi float64, ok bool) {
+	n := len(bestToOthers)
+	// Змінні: w_0 … w_{n-1}, ξ
+	cost := make([]float64, n+1)
+	cost[n] = 1
+
+	constraints := make([]lp.Constraint, 0, 4*n+1)
+	addPair := func(i int, a float64, j int) {
+		// w_i - a·w_j - ξ <= 0 та -w_i + a·w_j - ξ <= 0
+		for _, sign := range []float64{1, -1} {
+			coef := make([]float64, n+1)
+			coef[i] += sign
+			coef[j] -= sign * a
+			coef[n] = -1
+			constraints = append(constraints, lp.Constraint{Coef: coef, Sense: lp.LessEqual, RHS: 0})
+		}
+	}
+	for j := range n {
+		addPair(best, bestToOthers[j], j)
+		addPair(j, othersToWorst[j], worst)
+	}
+
+	sum := make([]float64, n+1)
+	for j := range n {
+		sum[j] = 1
+	}
+	constraints = append(constraints, lp.Constraint{Coef: sum, Sense: lp.Equal, RHS: 1})
+
+	x, ksi, ok := lp.Minimize(cost, constraints)
+	if !ok {
+		return nil, 0, false
+	}
+	return x[:n], ksi, true
+}
+
+// BWMConsistencyRatio розраховує коефіцієнт узгодженості порівнянь (Лян, Брунеллі, Резаї):
+// для кожного критерію CR_j = |a_Bj·a_jW - a_BW| / (a_BW² - a_BW), результат – max CR_j.
+// Значення 0 відповідає повністю узгодженим порівнянням.
+func BWMConsistencyRatio(worst int, bestToOthers, othersToWorst []float64) float64 {
+	aBW := bestToOthers[worst]
+	if aBW <= 1 {
+		return 0
+	}
+
+	cr := 0.0
+	for j := range bestToOthers {
+		v := math.Abs(bestToOthers[j]*othersToWorst[j]-aBW) / (aBW*aBW - aBW)
+		cr = math.Max(cr, v)
+	}
+	return cr
+}
+
+const (
+	rankOrderCentroid = iota + 1
+	rankSum
+	rankReciprocal
+)
+
+// RankWeights розраховує сурогатні ваги за порядком важливості критеріїв.
+// ranks[j] – місце критерію j (1 – найважливіший). Для n критеріїв вага місця r:
+//   - ROC (центроїд рангів): w_r = 1/n · Σ_{k=r..n} 1/k
+//   - сума рангів:           w_r = 2(n + 1 - r) / (n(n + 1))
+//   - обернені ранги:        w_r = (1/r) / Σ_{k=1..n} 1/k
+func RankWeights(ranks []int, method int) []float64 {
+	n := len(ranks)
+	weights := make([]float64, n)
+
+	harmonic := 0.0
+	for k := 1; k <= n; k++ {
+		harmonic += 1 / float64(k)
+	}
+
+	for j, r := range ranks {
+		switch method {
+		case rankOrderCentroid:
+			for k := r; k <= n; k++ {
+				weights[j] += 1 / float64(k)
+			}
+			weights[j] /= float64(n)
+		case rankSum:
+			weights[j] = 2 * float64(n+1-r) / float64(n*(n+1))
+		case rankReciprocal:
+			weights[j] = 1 / float64(r) / harmonic
+		}
+	}
+	return weights
+}
+
+// normalizeWeights нормує невід'ємні ваги так, щоб сума дорівнювала 1. Ваги спершу
+// діляться на найбільшу, щоб сума великих ваг не переповнилася до +Inf і не
+// обнулила всі ваги. Повертає false, якщо всі ваги нульові.
+func normalizeWeights(weights []float64) bool {
+	top := slices.Max(weights)
+	if !(top > 0) {
+		return false
+	}
+	sum := 0.0
+	for j := range weights {
+		weights[j] /= top
+		sum += weights[j]
+	}
+	for j := range weights {
+		weights[j] /= sum
+	}
+	return true
+}
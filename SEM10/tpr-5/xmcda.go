@@ -0,0 +1,256 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+)
+
+const xmcdaNamespace = "http://www.decision-deck.org/2019/XMCDA-3.1.1"
+
+// Структури підмножини стандарту XMCDA 3: альтернативи, критерії з напрямами
+// оптимізації, таблиця оцінок та ваги критеріїв (criteriaValues mcdaConcept="weights")
+type (
+	xmcdaDocument struct {
+		XMLName          xml.Name
+		Namespace        string                `xml:"xmlns:xmcda,attr,omitempty"`
+		Alternatives     []xmcdaAlternative    `xml:"alternatives>alternative"`
+		Criteria         []xmcdaCriterion      `xml:"criteria>criterion"`
+		Scales           []xmcdaCriterionScale `xml:"criteriaScales>criterionScales"`
+		PerformanceTable []xmcdaPerformances   `xml:"performanceTable>alternativePerformances"`
+		CriteriaValues   []xmcdaCriteriaValues `xml:"criteriaValues"`
+	}
+
+	xmcdaAlternative struct {
+		ID   string `xml:"id,attr"`
+		Name string `xml:"name,attr,omitempty"`
+	}
+
+	xmcdaCriterion struct {
+		ID   string `xml:"id,attr"`
+		Name string `xml:"name,attr,omitempty"`
+	}
+
+	xmcdaCriterionScale struct {
+		CriterionID string `xml:"criterionID"`
+		Direction   string `xml:"scales>scale>quantitative>preferenceDirection"`
+	}
+
+	xmcdaPerformances struct {
+		AlternativeID string             `xml:"alternativeID"`
+		Performances  []xmcdaPerformance `xml:"performance"`
+	}
+
+	xmcdaPerformance struct {
+		CriterionID string       `xml:"criterionID"`
+		Values      []xmcdaValue `xml:"values>value"`
+	}
+
+	xmcdaCriteriaValues struct {
+		Concept string                `xml:"mcdaConcept,attr,omitempty"`
+		Values  []xmcdaCriterionValue `xml:"criterionValues"`
+	}
+
+	xmcdaCriterionValue struct {
+		CriterionID string       `xml:"criterionID"`
+		Values      []xmcdaValue `xml:"values>value"`
+	}
+
+	xmcdaValue struct {
+		Real    string `xml:"real,omitempty"`
+		Integer string `xml:"integer,omitempty"`
+	}
+)
+
+func (v xmcdaValue) float() (float64, error) {
+	if v.Integer != "" {
+		return strconv.ParseFloat(v.Integer, 64)
+	}
+	return strconv.ParseFloat(v.Real, 64)
+}
+
+func firstValue(values []xmcdaValue) (float64, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf(errXMCDANoValue)
+	}
+	return values[0].float()
+}
+
+// loadXMCDA зчитує задачу багатокритеріального вибору з файлу XMCDA
+func loadXMCDA(path string) (*MCDMSystem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return decodeXMCDA(data)
+}
+
+// decodeXMCDA розбирає документ XMCDA і перевіряє таблицю оцінок і ваги
+func decodeXMCDA(data []byte) (*MCDMSystem, error) {
+	var doc xmcdaDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Alternatives) == 0 {
+		return nil, fmt.Errorf(errInvalidCount, "альтернатив")
+	}
+	if len(doc.Criteria) == 0 {
+		return nil, fmt.Errorf(errInvalidCount, "критеріїв")
+	}
+
+	m := &MCDMSystem{
+		alternatives: make([]string, len(doc.Alternatives)),
+		criteria:     make([]Criterion, len(doc.Criteria)),
+		matrix:       make([][]float64, len(doc.Alternatives)),
+	}
+	altIndex := make(map[string]int)
+	for i, a := range doc.Alternatives {
+		if a.ID == "" {
+			return nil, fmt.Errorf(errXMCDANoID)
+		}
+		m.alternatives[i] = xmcdaName(a.ID, a.Name)
+		altIndex[a.ID] = i
+		m.matrix[i] = make([]float64, len(doc.Criteria))
+	}
+	critIndex := make(map[string]int)
+	for j, c := range doc.Criteria {
+		if c.ID == "" {
+			return nil, fmt.Errorf(errXMCDANoID)
+		}
+		// За відсутності шкали критерій вважається критерієм максимізації
+		m.criteria[j] = Criterion{name: xmcdaName(c.ID, c.Name), benefit: true}
+		critIndex[c.ID] = j
+	}
+
+	for _, s := range doc.Scales {
+		j, ok := critIndex[s.CriterionID]
+		if !ok {
+			return nil, fmt.Errorf(errXMCDAUnknownID, s.CriterionID)
+		}
+		m.criteria[j].benefit = s.Direction != "min"
+	}
+
+	// filled позначає заповнені клітинки: повторна оцінка однієї клітинки не може заступити пропущену
+	filled := make([][]bool, len(m.alternatives))
+	for i := range filled {
+		filled[i] = make([]bool, len(m.criteria))
+	}
+	for _, row := range doc.PerformanceTable {
+		i, ok := altIndex[row.AlternativeID]
+		if !ok {
+			return nil, fmt.Errorf(errXMCDAUnknownID, row.AlternativeID)
+		}
+		for _, p := range row.Performances {
+			j, ok := critIndex[p.CriterionID]
+			if !ok {
+				return nil, fmt.Errorf(errXMCDAUnknownID, p.CriterionID)
+			}
+			v, err := firstValue(p.Values)
+			if err != nil {
+				return nil, err
+			}
+			if !(v > 0) || math.IsInf(v, 0) {
+				return nil, fmt.Errorf(errXMCDANonPositive, row.AlternativeID, p.CriterionID)
+			}
+			m.matrix[i][j] = v
+			filled[i][j] = true
+		}
+	}
+	for _, row := range filled {
+		if slices.Contains(row, false) {
+			return nil, fmt.Errorf(errXMCDAIncomplete)
+		}
+	}
+
+	for _, cv := range doc.CriteriaValues {
+		if cv.Concept != "weights" {
+			continue
+		}
+		weights := make([]float64, len(m.criteria))
+		for _, v := range cv.Values {
+			j, ok := critIndex[v.CriterionID]
+			if !ok {
+				return nil, fmt.Errorf(errXMCDAUnknownID, v.CriterionID)
+			}
+			w, err := firstValue(v.Values)
+			if err != nil {
+				return nil, err
+			}
+			if !(w >= 0) || math.IsInf(w, 0) {
+				return nil, fmt.Errorf(errXMCDAWeight, v.CriterionID)
+			}
+			weights[j] = w
+		}
+		if !normalizeWeights(weights) {
+			return nil, fmt.Errorf(errZeroWeightsSum)
+		}
+		m.weights = weights
+	}
+	return m, nil
+}
+
+func xmcdaName(id, name string) string {
+	if name != "" {
+		return name
+	}
+	return id
+}
+
+// SaveXMCDA записує задачу (і ваги критеріїв, якщо їх визначено) у файл XMCDA
+func (m *MCDMSystem) SaveXMCDA(path string) error {
+	data, err := m.encodeXMCDA()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// encodeXMCDA формує документ XMCDA, який decodeXMCDA зчитує назад у ту саму задачу
+func (m *MCDMSystem) encodeXMCDA() ([]byte, error) {
+	doc := xmcdaDocument{
+		XMLName:   xml.Name{Local: "xmcda:XMCDA"},
+		Namespace: xmcdaNamespace,
+	}
+	altID := func(i int) string { return fmt.Sprintf("a%d", i+1) }
+	critID := func(j int) string { return fmt.Sprintf("g%d", j+1) }
+	realValue := func(v float64) []xmcdaValue {
+		return []xmcdaValue{{Real: strconv.FormatFloat(v, 'g', -1, 64)}}
+	}
+
+	for i, alt := range m.alternatives {
+		doc.Alternatives = append(doc.Alternatives, xmcdaAlternative{altID(i), alt})
+	}
+	for j, c := range m.criteria {
+		doc.Criteria = append(doc.Criteria, xmcdaCriterion{critID(j), c.name})
+		direction := "max"
+		if !c.benefit {
+			direction = "min"
+		}
+		doc.Scales = append(doc.Scales, xmcdaCriterionScale{critID(j), direction})
+	}
+
+	for i, row := range m.matrix {
+		perf := xmcdaPerformances{AlternativeID: altID(i)}
+		for j, v := range row {
+			perf.Performances = append(perf.Performances, xmcdaPerformance{critID(j), realValue(v)})
+		}
+		doc.PerformanceTable = append(doc.PerformanceTable, perf)
+	}
+
+	if m.weights != nil {
+		cv := xmcdaCriteriaValues{Concept: "weights"}
+		for j, w := range m.weights {
+			cv.Values = append(cv.Values, xmcdaCriterionValue{critID(j), realValue(w)})
+		}
+		doc.CriteriaValues = append(doc.CriteriaValues, cv)
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// xmcdaExample – задача exampleSystem у форматі XMCDA 3: рядки таблиці оцінок
+// переставлено, оцінки цілі, ваги не нормовано (2 : 3 = 0.4 : 0.6)
+const xmcdaExample = `<?xml version="1.0" encoding="UTF-8"?>
+<xmcda:XMCDA xmlns:xmcda="http://www.decision-deck.org/2019/XMCDA-3.1.1">
+  <alternatives>
+    <alternative id="x1" name="A"/>
+    <alternative id="x2" name="B"/>
+  </alternatives>
+  <criteria>
+    <criterion id="price" name="Ціна"/>
+    <criterion id="quality" name="Якість"/>
+  </criteria>
+  <criteriaScales>
+    <criterionScales>
+      <criterionID>price</criterionID>
+      <scales><scale><quantitative><preferenceDirection>min</preferenceDirection></quantitative></scale></scales>
+    </criterionScales>
+  </criteriaScales>
+  <performanceTable>
+    <alternativePerformances>
+      <alternativeID>x2</alternativeID>
+      <performance><criterionID>quality</criterionID><values><value><integer>5</integer></value></values></performance>
+      <performance><criterionID>price</criterionID><values><value><integer>80</integer></value></values></performance>
+    </alternativePerformances>
+    <alternativePerformances>
+      <alternativeID>x1</alternativeID>
+      <performance><criterionID>price</criterionID><values><value><real>100</real></value></values></performance>
+      <performance><criterionID>quality</criterionID><values><value><real>7</real></value></values></performance>
+    </alternativePerformances>
+  </performanceTable>
+  <criteriaValues mcdaConcept="weights">
+    <criterionValues><criterionID>price</criterionID><values><value><real>2</real></value></values></criterionValues>
+    <criterionValues><criterionID>quality</criterionID><values><value><real>3</real></value></values></criterionValues>
+  </criteriaValues>
+</xmcda:XMCDA>
+`
+
+func TestXMCDA(t *testing.T) {
+	want := exampleSystem()
+	check := func(stage string, m *MCDMSystem) {
+		t.Helper()
+		if !reflect.DeepEqual(m.alternatives, want.alternatives) || !reflect.DeepEqual(m.criteria, want.criteria) ||
+			!reflect.DeepEqual(m.matrix, want.matrix) || !closeTo(m.weights, want.weights, 1e-12) {
+			t.Fatalf("%s: %+v, очікувалося %+v", stage, m, want)
+		}
+		// Імпортована задача дає ті самі оцінки SAW, що й введена вручну (див. TestMethods)
+		if got := m.CalculateSAW(); !closeTo(got, []float64{0.92, 0.4 + 0.6*5.0/7}, 1e-12) {
+			t.Fatalf("%s: SAW = %v", stage, got)
+		}
+	}
+
+	m, err := decodeXMCDA([]byte(xmcdaExample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("імпорт", m)
+
+	path := filepath.Join(t.TempDir(), "problem.xml")
+	if err := m.SaveXMCDA(path); err != nil {
+		t.Fatal(err)
+	}
+	back, err := loadXMCDA(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("експорт та імпорт", back)
+}
@@ -0,0 +1,5 @@
+module tpr-6
+
+go 1.22.0
+
+require gopkg.in/yaml.v3 v3.0.1
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+const (
+	// Error messages
+	errUnknownFormat      = "Невідомий формат файлу %s (підтримуються .json, .yaml, .yml)"
+	errUnknownNodeType    = "Вузол '%s' має невідомий тип '%s' (decision, chance, terminal)"
+	errNoChildren         = "Вузол '%s' повинен мати хоча б одну гілку"
+	errTerminalChildren   = "Кінцевий вузол '%s' не може мати гілок"
+	errInvalidProbability = "Некоректна ймовірність гілки '%s': %.4f"
+	errProbabilitySum     = "Сума ймовірностей у вузлі '%s' дорівнює %.4f, а не 1"
+	errEmptyChild         = "Вузол '%s' містить порожню гілку"
+	errInvalidPayoff      = "Некоректний виграш вузла '%s': %v"
+	errNoInput            = "Вкажіть файл з деревом рішень: -input tree.yaml"
+)
+
+func main() {
+	input := flag.String("input", "", "файл з деревом рішень (JSON або YAML)")
+	dot := flag.String("dot", "", "експортувати дерево у формат Graphviz DOT")
+	flag.Parse()
+
+	if *input == "" {
+		fmt.Println(errNoInput)
+		os.Exit(2)
+	}
+
+	root, err := loadTree(*input)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	ev := Rollback(root)
+
+	fmt.Println("Дерево рішень після згортання:")
+	PrintTree(os.Stdout, root)
+
+	fmt.Println("\nОптимальна стратегія:")
+	for i, step := range OptimalPolicy(root) {
+		fmt.Printf("%d) У вузлі '%s' обрати '%s'\n", i+1, step[0], step[1])
+	}
+	fmt.Printf("\nОчікуване значення оптимальної стратегії: %.2f\n", ev)
+
+	if *dot != "" {
+		f, err := os.Create(*dot)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+
+		WriteDOT(f, root)
+		fmt.Printf("Дерево збережено у %s\n", *dot)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+var nodeTypeNames = map[string]string{
+	nodeDecision: "рішення",
+	nodeChance:   "випадок",
+	nodeTerminal: "результат",
+}
+
+// PrintTree виводить дерево у вигляді ASCII-схеми з очікуваними значеннями
+func PrintTree(w io.Writer, root *Node) {
+	fmt.Fprintln(w, nodeLabel(root, nil))
+	printChildren(w, root, "")
+}
+
+func printChildren(w io.Writer, parent *Node, prefix string) {
+	for i, c := range parent.Children {
+		branch, next := "├── ", "│   "
+		if i == len(parent.Children)-1 {
+			branch, next = "└── ", "    "
+		}
+		fmt.Fprintln(w, prefix+branch+nodeLabel(c, parent))
+		printChildren(w, c, prefix+next)
+	}
+}
+
+func nodeLabel(n, parent *Node) string {
+	var sb strings.Builder
+	if parent != nil && parent.Type == nodeChance {
+		fmt.Fprintf(&sb, "(p = %.2f) ", n.Probability)
+	}
+	fmt.Fprintf(&sb, "%s [%s]", n.Name, nodeTypeNames[n.Type])
+	if n.Payoff != 0 && n.Type != nodeTerminal {
+		fmt.Fprintf(&sb, " %+.2f", n.Payoff)
+	}
+	fmt.Fprintf(&sb, " EV = %.2f", n.value)
+	if parent != nil && parent.Type == nodeDecision {
+		if n.optimal {
+			sb.WriteString(" <== оптимально")
+		} else {
+			sb.WriteString(" //")
+		}
+	}
+	return sb.String()
+}
+
+// WriteDOT експортує дерево у формат Graphviz DOT; оптимальні гілки виділяються
+func WriteDOT(w io.Writer, root *Node) {
+	fmt.Fprintln(w, "digraph DecisionTree {")
+	fmt.Fprintln(w, "\trankdir=LR;")
+	fmt.Fprintln(w, "\tnode [fontname=\"Helvetica\"];")
+
+	id := 0
+	var walk func(n *Node) int
+	walk = func(n *Node) int {
+		self := id
+		id++
+
+		shape := "plaintext"
+		switch n.Type {
+		case nodeDecision:
+			shape = "box"
+		case nodeChance:
+			shape = "ellipse"
+		}
+		fmt.Fprintf(w, "\tn%d [shape=%s, label=%q];\n", self, shape,
+			fmt.Sprintf("%s\nEV = %.2f", n.Name, n.value))
+
+		for _, c := range n.Children {
+			child := walk(c)
+			attrs := []string{}
+			if n.Type == nodeChance {
+				attrs = append(attrs, fmt.Sprintf("label=\"p = %.2f\"", c.Probability))
+			}
+			if c.optimal {
+				attrs = append(attrs, "color=red", "penwidth=2")
+			}
+			fmt.Fprintf(w, "\tn%d -> n%d [%s];\n", self, child, strings.Join(attrs, ", "))
+		}
+		return self
+	}
+	walk(root)
+
+	fmt.Fprintln(w, "}")
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	nodeDecision = "decision"
+	nodeChance   = "chance"
+	nodeTerminal = "terminal"
+
+	probabilityEps = 1e-6
+)
+
+// Node – вузол дерева рішень.
+// Payoff – виграш (або витрати зі знаком мінус), що отримується при переході у вузол;
+// для кінцевих вузлів це підсумковий результат гілки.
+// Probability задається для нащадків вузлів випадку.
+type Node struct {
+	Name        string  `json:"name" yaml:"name"`
+	Type        string  `json:"type" yaml:"type"`
+	Probability float64 `json:"probability,omitempty" yaml:"probability,omitempty"`
+	Payoff      float64 `json:"payoff,omitempty" yaml:"payoff,omitempty"`
+	Children    []*Node `json:"children,omitempty" yaml:"children,omitempty"`
+
+	value   float64 // очікуване значення після згортання
+	optimal bool    // гілка обрана у батьківському вузлі рішення
+}
+
+// loadTree зчитує дерево з файлу JSON або YAML (за розширенням)
+func loadTree(path string) (*Node, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return decodeTree(data, path)
+}
+
+// decodeTree розбирає й перевіряє дерево; формат визначається за розширенням path
+func decodeTree(data []byte, path string) (*Node, error) {
+	root := &Node{}
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, root)
+	case ".json":
+		err = json.Unmarshal(data, root)
+	default:
+		return nil, fmt.Errorf(errUnknownFormat, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return root, validate(root)
+}
+
+// validate перевіряє структуру дерева та ймовірності у вузлах випадку
+func validate(n *Node) error {
+	if math.IsNaN(n.Payoff) || math.IsInf(n.Payoff, 0) {
+		return fmt.Errorf(errInvalidPayoff, n.Name, n.Payoff)
+	}
+	// Ймовірність гілки вузла рішення не використовується, але й вона має бути числом
+	if math.IsNaN(n.Probability) || math.IsInf(n.Probability, 0) {
+		return fmt.Errorf(errInvalidProbability, n.Name, n.Probability)
+	}
+	switch n.Type {
+	case nodeTerminal:
+		if len(n.Children) > 0 {
+			return fmt.Errorf(errTerminalChildren, n.Name)
+		}
+		return nil
+	case nodeDecision, nodeChance:
+		if len(n.Children) == 0 {
+			return fmt.Errorf(errNoChildren, n.Name)
+		}
+	default:
+		return fmt.Errorf(errUnknownNodeType, n.Name, n.Type)
+	}
+
+	if n.Type == nodeChance {
+		sum := 0.0
+		for _, c := range n.Children {
+			if c == nil {
+				return fmt.Errorf(errEmptyChild, n.Name)
+			}
+			// Перевірка записана так, щоб NaN також вважався некоректною ймовірністю
+			if !(c.Probability >= 0 && c.Probability <= 1) {
+				return fmt.Errorf(errInvalidProbability, c.Name, c.Probability)
+			}
+			sum += c.Probability
+		}
+		if math.Abs(sum-1) > probabilityEps {
+			return fmt.Errorf(errProbabilitySum, n.Name, sum)
+		}
+	}
+
+	for _, c := range n.Children {
+		if c == nil {
+			return fmt.Errorf(errEmptyChild, n.Name)
+		}
+		if err := validate(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback виконує згортання дерева від листків до кореня:
+// у вузлі випадку береться математичне сподівання значень нащадків,
+// у вузлі рішення – максимальне значення, а відповідна гілка позначається оптимальною.
+func Rollback(n *Node) float64 {
+	switch n.Type {
+	case nodeChance:
+		ev := 0.0
+		for _, c := range n.Children {
+			ev += c.Probability * Rollback(c)
+		}
+		n.value = n.Payoff + ev
+	case nodeDecision:
+		var best *Node
+		for _, c := range n.Children {
+			v := Rollback(c)
+			if best == nil || v > best.value {
+				best = c
+			}
+		}
+		best.optimal = true
+		n.value = n.Payoff + best.value
+	default:
+		n.value = n.Payoff
+	}
+	return n.value
+}
+
+// OptimalPolicy повертає рішення, що приймаються в досяжних вузлах рішень
+func OptimalPolicy(n *Node) [][2]string {
+	var policy [][2]string
+	for _, c := range n.Children {
+		if n.Type == nodeDecision && !c.optimal {
+			continue
+		}
+		if n.Type == nodeDecision {
+			policy = append(policy, [2]string{n.Name, c.Name})
+		}
+		policy = append(policy, OptimalPolicy(c)...)
+	}
+	return policy
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// checkOptimal перевіряє, що у кожному вузлі рішення обрано гілку з найбільшим значенням
+func checkOptimal(t *testing.T, n *Node) {
+	if n.Type == nodeDecision {
+		var best *Node
+		for _, c := range n.Children {
+			if c.optimal && (best == nil || c.value > best.value) {
+				best = c
+			}
+		}
+		if best == nil {
+			t.Fatalf("у вузлі рішення %q не обрано жодної гілки", n.Name)
+		}
+		for _, c := range n.Children {
+			if c.value > best.value {
+				t.Fatalf("у вузлі рішення %q обрано %q (%v), хоча %q має значення %v", n.Name, best.Name, best.value, c.Name, c.value)
+			}
+		}
+	}
+	for _, c := range n.Children {
+		checkOptimal(t, c)
+	}
+}
+
+func FuzzDecodeTree(f *testing.F) {
+	example, err := os.ReadFile("example.yaml")
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(example, false)
+	f.Add([]byte(`{"name": "Рішення", "type": "decision", "children": [{"name": "A", "type": "terminal", "payoff": 10}, null]}`), true)
+	f.Add([]byte(`{"name": "Випадок", "type": "chance", "children": [{"name": "A", "type": "terminal", "probability": 1, "payoff": 1e308}]}`), true)
+	f.Add([]byte("name: x\ntype: chance\nchildren:\n  - &a {name: a, type: terminal, probability: .nan}\n  - *a\n"), false)
+	f.Add([]byte("name: x\ntype: decision\nchildren:\n  - {name: a, type: terminal, probability: .inf}\n"), false)
+
+	f.Fuzz(func(t *testing.T, data []byte, isJSON bool) {
+		path := "tree.yaml"
+		if isJSON {
+			path = "tree.json"
+		}
+		root, err := decodeTree(data, path)
+		if err != nil {
+			return
+		}
+
+		// Прийняте дерево має зберігатися в JSON і зчитуватися назад без змін
+		saved, err := json.Marshal(root)
+		if err != nil {
+			t.Fatalf("дерево не збережено: %v", err)
+		}
+		back, err := decodeTree(saved, "tree.json")
+		if err != nil {
+			t.Fatalf("збережене дерево не зчитано: %v\n%s", err, saved)
+		}
+		if !reflect.DeepEqual(back, root) {
+			t.Fatalf("зчитано інше дерево:\n%s", saved)
+		}
+
+		v := Rollback(root)
+		if w := Rollback(back); v != w && !(math.IsNaN(v) && math.IsNaN(w)) {
+			t.Fatalf("очікуване значення %v, а після збереження %v", v, w)
+		}
+		checkOptimal(t, root)
+		OptimalPolicy(root)
+	})
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// BimatrixGame – гра двох гравців з ненульовою сумою;
+// a[i][j] та b[i][j] – виграші гравців A та B при стратегіях A_i та B_j
+type BimatrixGame struct {
+	a, b [][]float64
+}
+
+// bestResponses позначає найкращі відповіді гравців:
+// bestA[i][j] – A_i є найкращою відповіддю A на стратегію B_j,
+// bestB[i][j] – B_j є найкращою відповіддю B на стратегію A_i
+func (g *BimatrixGame) bestResponses() (bestA, bestB [][]bool) {
+	rows, cols := len(g.a), len(g.a[0])
+	bestA = make([][]bool, rows)
+	bestB = make([][]bool, rows)
+	for i := range rows {
+		bestA[i] = make([]bool, cols)
+		bestB[i] = make([]bool, cols)
+	}
+
+	for j := range cols {
+		maxVal := g.a[0][j]
+		for i := range rows {
+			maxVal = math.Max(maxVal, g.a[i][j])
+		}
+		for i := range rows {
+			bestA[i][j] = g.a[i][j] == maxVal
+		}
+	}
+
+	for i := range rows {
+		maxVal := g.b[i][0]
+		for j := range cols {
+			maxVal = math.Max(maxVal, g.b[i][j])
+		}
+		for j := range cols {
+			bestB[i][j] = g.b[i][j] == maxVal
+		}
+	}
+	return bestA, bestB
+}
+
+// PureEquilibria повертає всі рівноваги Неша в чистих стратегіях –
+// клітинки, де стратегії гравців є взаємними найкращими відповідями
+func (g *BimatrixGame) PureEquilibria() [][2]int {
+	bestA, bestB := g.bestResponses()
+	var eq [][2]int
+	for i := range g.a {
+		for j := range g.a[i] {
+			if bestA[i][j] && bestB[i][j] {
+				eq = append(eq, [2]int{i, j})
+			}
+		}
+	}
+	return eq
+}
+
+// MixedEquilibrium2x2 знаходить повністю змішану рівновагу гри 2×2 з умов байдужості:
+// q робить гравця A байдужим між A1 та A2, p – гравця B між B1 та B2.
+// Повертає false, якщо внутрішньої рівноваги не існує.
+func (g *BimatrixGame) MixedEquilibrium2x2() (p, q float64, ok bool) {
+	a, b := g.a, g.b
+	dA := a[0][0] - a[0][1] - a[1][0] + a[1][1]
+	dB := b[0][0] - b[0][1] - b[1][0] + b[1][1]
+	if math.Abs(dA) < gameEps || math.Abs(dB) < gameEps {
+		return 0, 0, false
+	}
+
+	q = (a[1][1] - a[0][1]) / dA
+	p = (b[1][1] - b[1][0]) / dB
+	if p <= 0 || p >= 1 || q <= 0 || q >= 1 {
+		return 0, 0, false
+	}
+	return p, q, true
+}
+
+// PrintBestResponses виводить таблицю виграшів (a, b), де зірочкою позначено
+// найкращі відповіді гравців
+func (g *BimatrixGame) PrintBestResponses() {
+	bestA, bestB := g.bestResponses()
+
+	fmt.Println("\nАналіз найкращих відповідей (* – найкраща відповідь гравця):")
+	fmt.Printf(headerFormat, "")
+	for j := range g.a[0] {
+		fmt.Printf(pairFormat, fmt.Sprintf("B%d", j+1))
+	}
+	fmt.Println()
+
+	mark := func(best bool) string {
+		if best {
+			return "*"
+		}
+		return ""
+	}
+
+	for i := range g.a {
+		fmt.Printf(headerFormat, fmt.Sprintf("A%d", i+1))
+		for j := range g.a[i] {
+			cell := fmt.Sprintf("(%.2f%s; %.2f%s)", g.a[i][j], mark(bestA[i][j]), g.b[i][j], mark(bestB[i][j]))
+			fmt.Printf(pairFormat, cell)
+		}
+		fmt.Println()
+	}
+}
+
+// Run шукає рівноваги Неша та виводить результати
+func (g *BimatrixGame) Run() {
+	g.PrintBestResponses()
+
+	eq := g.PureEquilibria()
+	if len(eq) == 0 {
+		fmt.Println("\nРівноваг Неша в чистих стратегіях немає.")
+	} else {
+		fmt.Println("\nРівноваги Неша в чистих стратегіях:")
+		for _, e := range eq {
+			i, j := e[0], e[1]
+			fmt.Printf("(A%d, B%d): виграші (%.2f; %.2f)\n", i+1, j+1, g.a[i][j], g.b[i][j])
+		}
+	}
+
+	if len(g.a) != 2 || len(g.a[0]) != 2 {
+		return
+	}
+
+	p, q, ok := g.MixedEquilibrium2x2()
+	if !ok {
+		fmt.Println("\nПовністю змішаної рівноваги немає.")
+		return
+	}
+
+	a, b := g.a, g.b
+	payoffA := p*q*a[0][0] + p*(1-q)*a[0][1] + (1-p)*q*a[1][0] + (1-p)*(1-q)*a[1][1]
+	payoffB := p*q*b[0][0] + p*(1-q)*b[0][1] + (1-p)*q*b[1][0] + (1-p)*(1-q)*b[1][1]
+
+	fmt.Println("\nРівновага Неша у змішаних стратегіях:")
+	printStrategy("A", []float64{p, 1 - p})
+	printStrategy("B", []float64{q, 1 - q})
+	fmt.Printf("Очікувані виграші: A = %.4f, B = %.4f\n", payoffA, payoffB)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// closeTo перевіряє, що вектори got і want однакової довжини й збігаються з точністю tol
+func closeTo(got, want []float64, tol float64) bool {
+	return slices.EqualFunc(got, want, func(a, b float64) bool { return math.Abs(a-b) <= tol })
+}
+
+func TestSolveMixed(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		payoff [][]float64
+		p, q   []float64
+		v      float64
+		method string
+	}{
+		// «Орлянка»: симетрична гра з нульовою ціною
+		{"орлянка", [][]float64{{1, -1}, {-1, 1}}, []float64{0.5, 0.5}, []float64{0.5, 0.5}, 0, "аналітичний розв'язок гри 2×2"},
+		// D = 3 + 1 + 1 + 2 = 7: p1 = (1 + 2)/7, q1 = (1 + 1)/7, v = (3·1 - 2)/7
+		{"2×2", [][]float64{{3, -1}, {-2, 1}}, []float64{3.0 / 7, 4.0 / 7}, []float64{2.0 / 7, 5.0 / 7}, 1.0 / 7, "аналітичний розв'язок гри 2×2"},
+		// Огинаюча min(7 - 5x, 5 - 2x, 2 + 9x) найвища в перетині 5 - 2x = 2 + 9x: x = 3/11, v = 49/11;
+		// B змішує B2 і B3 так, щоб A був байдужим: 3q + 11(1 - q) = 5q + 2(1 - q), q = 9/11
+		{"2×n", [][]float64{{2, 3, 11}, {7, 5, 2}}, []float64{3.0 / 11, 8.0 / 11}, []float64{0, 9.0 / 11, 2.0 / 11}, 49.0 / 11, "графоаналітичний метод (гра 2×n)"},
+		// Та сама гра з боку B: рядки й стовпці переставлено, виграші змінили знак
+		{"m×2", [][]float64{{-2, -7}, {-3, -5}, {-11, -2}}, []float64{0, 9.0 / 11, 2.0 / 11}, []float64{3.0 / 11, 8.0 / 11}, -49.0 / 11, "графоаналітичний метод (гра m×2)"},
+		// «Камінь, ножиці, папір»
+		{"симплекс-метод", [][]float64{{0, -1, 1}, {1, 0, -1}, {-1, 1, 0}}, []float64{1.0 / 3, 1.0 / 3, 1.0 / 3}, []float64{1.0 / 3, 1.0 / 3, 1.0 / 3}, 0, "симплекс-метод"},
+	} {
+		g := &ZeroSumGame{payoff: tt.payoff}
+		p, q, v, method, ok := g.SolveMixed()
+		if !ok || method != tt.method || !closeTo(p, tt.p, 1e-9) || !closeTo(q, tt.q, 1e-9) || math.Abs(v-tt.v) > 1e-9 {
+			t.Errorf("%s: SolveMixed = %v, %v, %v (%s, %v); очікувалося %v, %v, %v (%s)", tt.name, p, q, v, method, ok, tt.p, tt.q, tt.v, tt.method)
+		}
+		// Симплекс-метод має давати ту саму ціну для будь-якої гри
+		if _, _, v, ok := solveLP(tt.payoff); !ok || math.Abs(v-tt.v) > 1e-9 {
+			t.Errorf("%s: solveLP дає ціну %v (%v), очікувалося %v", tt.name, v, ok, tt.v)
+		}
+	}
+}
+
+func TestBimatrix(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		a, b  [][]float64
+		pure  [][2]int
+		p, q  float64
+		mixed bool
+	}{
+		// Дилема в'язня: зізнатися – домінуюча стратегія обох
+		{"дилема в'язня", [][]float64{{-1, -3}, {0, -2}}, [][]float64{{-1, 0}, {-3, -2}}, [][2]int{{1, 1}}, 0, 0, false},
+		// «Сімейна суперечка»: дві чисті рівноваги й змішана p = 2/3, q = 1/3
+		{"сімейна суперечка", [][]float64{{2, 0}, {0, 1}}, [][]float64{{1, 0}, {0, 2}}, [][2]int{{0, 0}, {1, 1}}, 2.0 / 3, 1.0 / 3, true},
+		{"орлянка", [][]float64{{1, -1}, {-1, 1}}, [][]float64{{-1, 1}, {1, -1}}, nil, 0.5, 0.5, true},
+	} {
+		g := &BimatrixGame{a: tt.a, b: tt.b}
+		if pure := g.PureEquilibria(); !reflect.DeepEqual(pure, tt.pure) {
+			t.Errorf("%s: PureEquilibria = %v, очікувалося %v", tt.name, pure, tt.pure)
+		}
+		p, q, ok := g.MixedEquilibrium2x2()
+		if ok != tt.mixed || math.Abs(p-tt.p) > 1e-12 || math.Abs(q-tt.q) > 1e-12 {
+			t.Errorf("%s: MixedEquilibrium2x2 = %v, %v, %v; очікувалося %v, %v, %v", tt.name, p, q, ok, tt.p, tt.q, tt.mixed)
+		}
+	}
+}
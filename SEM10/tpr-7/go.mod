@@ -0,0 +1,13 @@
+module tpr-7
+
+go 1.22.0
+
+require (
+	tprlib v0.0.0-00010101000000-000000000000
+	tprtest v0.0.0-00010101000000-000000000000
+)
+
+replace (
+	tprlib => ../tprlib
+	tprtest => ../tprtest
+)
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"tprtest"
+)
+
+// fuzzInput повертає читача введення з даних фазера; після них іде коректне значення valid,
+// щоб цикл повторного запиту завершився до кінця введення (на якому програма виходить)
+func fuzzInput(data, valid string) *inputReader {
+	return &inputReader{tprtest.Input(data, valid)}
+}
+
+func FuzzReadNumbers(f *testing.F) {
+	for _, s := range []string{"3", "0\n-1\n2", "abc", "7,5", "NaN\nInf\n-Inf\n1e400\n0.5", "0x1p-2", "\n\n"} {
+		f.Add(s)
+	}
+	tprtest.Silence(f)
+	f.Fuzz(func(t *testing.T, data string) {
+		// Коректна перша відповідь має бути прийнята без змін
+		first := tprtest.FirstAnswer(data)
+
+		n := fuzzInput(data, "2").readChoice("", 5)
+		if n < 1 || n > 5 {
+			t.Fatalf("readChoice повернув %d поза [1, 5]", n)
+		}
+		if want, err := strconv.Atoi(first); err == nil && want >= 1 && want <= 5 && n != want {
+			t.Fatalf("readChoice повернув %d замість %d", n, want)
+		}
+
+		v := fuzzInput(data, "1").readFloat("")
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("readFloat повернув %v", v)
+		}
+		if want, err := strconv.ParseFloat(first, 64); err == nil && !math.IsNaN(want) && !math.IsInf(want, 0) && v != want {
+			t.Fatalf("readFloat повернув %v замість %v", v, want)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Prompt templates
+	promptGameType = "Тип гри (1 – антагоністична, 2 – біматрична): "
+	promptRowCount = "Введіть кількість стратегій гравця A: "
+	promptColCount = "Введіть кількість стратегій гравця B: "
+	promptRowValue = "\nВведіть виграші гравця %s для стратегії A%d:\n"
+	promptPayoff   = "Виграш при стратегіях A%d та B%d: "
+
+	// Error messages
+	errInvalidCount = "Некоректне число %s"
+	errInvalidValue = "Некоректне значення. Будь ласка, спробуйте ще раз."
+	errInputClosed  = "Введення завершилося раніше, ніж було отримано всі дані"
+	errNoSolution   = "Не вдалося розв'язати задачу лінійного програмування"
+
+	// Table formats
+	headerFormat = "%-10s"
+	cellFormat   = "%-10.2f"
+	probFormat   = "%-10.4f"
+	pairFormat   = "%-20s"
+)
+
+const (
+	gameZeroSum = iota + 1
+	gameBimatrix
+)
+
+type (
+	inputReader struct {
+		reader *bufio.Reader
+	}
+
+	// ZeroSumGame – антагоністична гра двох гравців;
+	// payoff[i][j] – виграш гравця A (програш гравця B)
+	ZeroSumGame struct {
+		payoff [][]float64
+	}
+)
+
+func newInputReader() *inputReader {
+	return &inputReader{bufio.NewReader(os.Stdin)}
+}
+
+func (ir *inputReader) readString(prompt string) (string, error) {
+	fmt.Print(prompt)
+	input, err := ir.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(input), nil
+}
+
+func (ir *inputReader) readInt(prompt string) (int, error) {
+	str, err := ir.readString(prompt)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(str)
+}
+
+func (ir *inputReader) readChoice(prompt string, max int) int {
+	for {
+		v, err := ir.readInt(prompt)
+		var numErr *strconv.NumError
+		switch {
+		case err == nil && v >= 1 && v <= max:
+			return v
+		case err != nil && !errors.As(err, &numErr):
+			inputClosed()
+		}
+		fmt.Println(errInvalidValue)
+	}
+}
+
+func (ir *inputReader) readFloat(prompt string) float64 {
+	for {
+		str, err := ir.readString(prompt)
+		if err != nil {
+			inputClosed()
+		}
+		val, err := strconv.ParseFloat(str, 64)
+		if err == nil && !math.IsNaN(val) && !math.IsInf(val, 0) {
+			return val
+		}
+		fmt.Println(errInvalidValue)
+	}
+}
+
+// inputClosed завершує програму, якщо стандартний вхід закрито посеред введення:
+// інакше цикл повторного запиту чекав би на коректне значення безкінечно
+func inputClosed() {
+	fmt.Println("\n" + errInputClosed)
+	os.Exit(1)
+}
+
+// readSizes зчитує кількість стратегій кожного з гравців
+func readSizes(ir *inputReader) (rows, cols int, err error) {
+	rows, err = ir.readInt(promptRowCount)
+	if err != nil || rows <= 0 {
+		return 0, 0, fmt.Errorf(errInvalidCount, "стратегій гравця A")
+	}
+	cols, err = ir.readInt(promptColCount)
+	if err != nil || cols <= 0 {
+		return 0, 0, fmt.Errorf(errInvalidCount, "стратегій гравця B")
+	}
+	return rows, cols, nil
+}
+
+// readMatrix зчитує платіжну матрицю гравця player
+func readMatrix(ir *inputReader, player string, rows, cols int) [][]float64 {
+	matrix := make([][]float64, rows)
+	for i := range rows {
+		fmt.Printf(promptRowValue, player, i+1)
+		matrix[i] = make([]float64, cols)
+		for j := range cols {
+			matrix[i][j] = ir.readFloat(fmt.Sprintf(promptPayoff, i+1, j+1))
+		}
+	}
+	return matrix
+}
+
+func printStrategy(player string, probs []float64) {
+	fmt.Printf("Оптимальна змішана стратегія гравця %s:\n", player)
+	for i := range probs {
+		fmt.Printf(headerFormat, fmt.Sprintf("%s%d", player, i+1))
+	}
+	fmt.Println()
+	for _, p := range probs {
+		fmt.Printf(probFormat, p)
+	}
+	fmt.Println()
+}
+
+func main() {
+	ir := newInputReader()
+	gameType := ir.readChoice(promptGameType, gameBimatrix)
+
+	rows, cols, err := readSizes(ir)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if gameType == gameBimatrix {
+		g := &BimatrixGame{
+			a: readMatrix(ir, "A", rows, cols),
+			b: readMatrix(ir, "B", rows, cols),
+		}
+		g.Run()
+		return
+	}
+
+	g := &ZeroSumGame{payoff: readMatrix(ir, "A", rows, cols)}
+	g.Run()
+}
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"tprlib/lp"
+)
+
+const gameEps = 1e-9
+
+func (g *ZeroSumGame) rowMin(i int) float64 {
+	minVal := g.payoff[i][0]
+	for _, v := range g.payoff[i] {
+		minVal = math.Min(minVal, v)
+	}
+	return minVal
+}
+
+func (g *ZeroSumGame) colMax(j int) float64 {
+	maxVal := g.payoff[0][j]
+	for i := range g.payoff {
+		maxVal = math.Max(maxVal, g.payoff[i][j])
+	}
+	return maxVal
+}
+
+// LowerValue – нижня ціна гри α = max_i min_j a_ij (максимін гравця A)
+func (g *ZeroSumGame) LowerValue() float64 {
+	lower := g.rowMin(0)
+	for i := range g.payoff {
+		lower = math.Max(lower, g.rowMin(i))
+	}
+	return lower
+}
+
+// UpperValue – верхня ціна гри β = min_j max_i a_ij (мінімакс гравця B)
+func (g *ZeroSumGame) UpperValue() float64 {
+	upper := g.colMax(0)
+	for j := range g.payoff[0] {
+		upper = math.Min(upper, g.colMax(j))
+	}
+	return upper
+}
+
+// SaddlePoints повертає всі сідлові точки: елементи, що є мінімальними у рядку
+// та максимальними у стовпці. Вони існують лише тоді, коли α = β.
+func (g *ZeroSumGame) SaddlePoints() [][2]int {
+	var points [][2]int
+	if g.LowerValue() != g.UpperValue() {
+		return points
+	}
+	for i, row := range g.payoff {
+		for j, v := range row {
+			if v == g.rowMin(i) && v == g.colMax(j) {
+				points = append(points, [2]int{i, j})
+			}
+		}
+	}
+	return points
+}
+
+func (g *ZeroSumGame) PrintMatrix() {
+	fmt.Println("\nПлатіжна матриця гравця A:")
+	fmt.Printf(headerFormat, "")
+	for j := range g.payoff[0] {
+		fmt.Printf(headerFormat, fmt.Sprintf("B%d", j+1))
+	}
+	fmt.Printf(headerFormat, "min")
+	fmt.Println()
+
+	for i, row := range g.payoff {
+		fmt.Printf(headerFormat, fmt.Sprintf("A%d", i+1))
+		for _, v := range row {
+			fmt.Printf(cellFormat, v)
+		}
+		fmt.Printf(cellFormat, g.rowMin(i))
+		fmt.Println()
+	}
+
+	fmt.Printf(headerFormat, "max")
+	for j := range g.payoff[0] {
+		fmt.Printf(cellFormat, g.colMax(j))
+	}
+	fmt.Println()
+}
+
+// Run розв'язує гру та виводить результати
+func (g *ZeroSumGame) Run() {
+	g.PrintMatrix()
+
+	lower, upper := g.LowerValue(), g.UpperValue()
+	fmt.Printf("\nНижня ціна гри α = %.4f\n", lower)
+	fmt.Printf("Верхня ціна гри β = %.4f\n", upper)
+
+	if saddles := g.SaddlePoints(); len(saddles) > 0 {
+		fmt.Println("\nГра має сідлову точку, розв'язок у чистих стратегіях:")
+		for _, s := range saddles {
+			fmt.Printf("(A%d, B%d)\n", s[0]+1, s[1]+1)
+		}
+		fmt.Printf("Ціна гри v = %.4f\n", lower)
+		return
+	}
+
+	fmt.Println("\nСідлової точки немає, шукаємо розв'язок у змішаних стратегіях.")
+	p, q, v, method, ok := g.SolveMixed()
+	if !ok {
+		fmt.Println(errNoSolution)
+		return
+	}
+
+	fmt.Printf("Метод: %s\n\n", method)
+	printStrategy("A", p)
+	printStrategy("B", q)
+	fmt.Printf("\nЦіна гри v = %.4f\n", v)
+}
+
+// SolveMixed знаходить оптимальні змішані стратегії гравців та ціну гри.
+// Для ігор 2×2 використовуються аналітичні формули, для 2×n та m×2 –
+// графоаналітичний метод, для загального випадку – симплекс-метод.
+func (g *ZeroSumGame) SolveMixed() (p, q []float64, v float64, method string, ok bool) {
+	rows, cols := len(g.payoff), len(g.payoff[0])
+
+	switch {
+	case rows == 2 && cols == 2:
+		p, q, v, ok = solve2x2(g.payoff)
+		return p, q, v, "аналітичний розв'язок гри 2×2", ok
+	case rows == 2:
+		p, q, v = solve2xN(g.payoff)
+		return p, q, v, "графоаналітичний метод (гра 2×n)", true
+	case cols == 2:
+		// Гра m×2 зводиться до гри 2×m з точки зору гравця B
+		transposed := make([][]float64, 2)
+		for k := range 2 {
+			transposed[k] = make([]float64, rows)
+			for i := range rows {
+				transposed[k][i] = -g.payoff[i][k]
+			}
+		}
+		qB, pA, vB := solve2xN(transposed)
+		return pA, qB, -vB, "графоаналітичний метод (гра m×2)", true
+	}
+
+	p, q, v, ok = solveLP(g.payoff)
+	return p, q, v, "симплекс-метод", ok
+}
+
+// solve2x2 розв'язує гру 2×2 без сідлової точки:
+// p1 = (a22 - a21) / D, q1 = (a22 - a12) / D, v = (a11·a22 - a12·a21) / D,
+// де D = a11 + a22 - a12 - a21
+func solve2x2(a [][]float64) (p, q []float64, v float64, ok bool) {
+	d := a[0][0] + a[1][1] - a[0][1] - a[1][0]
+	if math.Abs(d) < gameEps {
+		return nil, nil, 0, false
+	}
+	p1 := (a[1][1] - a[1][0]) / d
+	q1 := (a[1][1] - a[0][1]) / d
+	v = (a[0][0]*a[1][1] - a[0][1]*a[1][0]) / d
+	return []float64{p1, 1 - p1}, []float64{q1, 1 - q1}, v, true
+}
+
+// solve2xN розв'язує гру 2×n графоаналітичним методом.
+// Кожна стратегія B_j задає пряму f_j(x) = x·a1j + (1 - x)·a2j, де x – ймовірність A1.
+// Оптимальне x* максимізує нижню огинаючу min_j f_j(x); воно лежить на кінцях
+// відрізка [0; 1] або в точці перетину двох прямих. Гравець B змішує дві активні
+// стратегії з протилежними нахилами.
+func solve2xN(a [][]float64) (p, q []float64, v float64) {
+	n := len(a[0])
+	slope := make([]float64, n)
+	for j := range n {
+		slope[j] = a[0][j] - a[1][j]
+	}
+	line := func(j int, x float64) float64 {
+		return a[1][j] + x*slope[j]
+	}
+	envelope := func(x float64) float64 {
+		minVal := line(0, x)
+		for j := range n {
+			minVal = math.Min(minVal, line(j, x))
+		}
+		return minVal
+	}
+
+	candidates := []float64{0, 1}
+	for j := range n {
+		for k := j + 1; k < n; k++ {
+			if math.Abs(slope[j]-slope[k]) < gameEps {
+				continue
+			}
+			x := (a[1][k] - a[1][j]) / (slope[j] - slope[k])
+			if x >= 0 && x <= 1 {
+				candidates = append(candidates, x)
+			}
+		}
+	}
+
+	best := candidates[0]
+	for _, x := range candidates {
+		if envelope(x) > envelope(best)+gameEps {
+			best = x
+		}
+	}
+	v = envelope(best)
+	p = []float64{best, 1 - best}
+
+	// Активні стратегії B – ті, на яких досягається мінімум огинаючої
+	q = make([]float64, n)
+	up, down := -1, -1
+	for j := range n {
+		if math.Abs(line(j, best)-v) > 1e-7 {
+			continue
+		}
+		switch {
+		case math.Abs(slope[j]) < gameEps:
+			q[j] = 1
+			return p, q, v
+		case slope[j] > 0 && up < 0:
+			up = j
+		case slope[j] < 0 && down < 0:
+			down = j
+		}
+	}
+
+	switch {
+	case up >= 0 && down >= 0:
+		q[up] = -slope[down] / (slope[up] - slope[down])
+		q[down] = 1 - q[up]
+	case up >= 0:
+		q[up] = 1
+	default:
+		q[down] = 1
+	}
+	return p, q, v
+}
+
+// solveLP зводить гру до пари двоїстих задач лінійного програмування.
+// Матриця зсувається на константу c так, щоб усі елементи були додатними, тоді
+// для гравця A: min Σ x_i при Σ_i a_ij·x_i >= 1, x >= 0, і v' = 1 / Σ x_i, p = v'·x;
+// для гравця B: max Σ y_j при Σ_j a_ij·y_j <= 1, y >= 0, і q = v'·y. Ціна гри v = v' - c.
+func solveLP(a [][]float64) (p, q []float64, v float64, ok bool) {
+	rows, cols := len(a), len(a[0])
+
+	minVal := a[0][0]
+	for _, row := range a {
+		for _, x := range row {
+			minVal = math.Min(minVal, x)
+		}
+	}
+	shift := 0.0
+	if minVal <= 0 {
+		shift = 1 - minVal
+	}
+
+	ones := func(n int, sign float64) []float64 {
+		c := make([]float64, n)
+		for k := range c {
+			c[k] = sign
+		}
+		return c
+	}
+
+	consA := make([]lp.Constraint, cols)
+	for j := range cols {
+		coef := make([]float64, rows)
+		for i := range rows {
+			coef[i] = a[i][j] + shift
+		}
+		consA[j] = lp.Constraint{Coef: coef, Sense: lp.GreaterEqual, RHS: 1}
+	}
+	x, sumX, okA := lp.Minimize(ones(rows, 1), consA)
+
+	consB := make([]lp.Constraint, rows)
+	for i := range rows {
+		coef := make([]float64, cols)
+		for j := range cols {
+			coef[j] = a[i][j] + shift
+		}
+		consB[i] = lp.Constraint{Coef: coef, Sense: lp.LessEqual, RHS: 1}
+	}
+	y, _, okB := lp.Minimize(ones(cols, -1), consB)
+
+	if !okA || !okB || sumX <= 0 {
+		return nil, nil, 0, false
+	}
+
+	value := 1 / sumX
+	p = make([]float64, rows)
+	for i := range x {
+		p[i] = x[i] * value
+	}
+	q = make([]float64, cols)
+	for j := range y {
+		q[j] = y[j] * value
+	}
+	return p, q, value - shift, true
+}
@@ -0,0 +1,10 @@
+module tpr-8
+
+go 1.22.0
+
+require (
+	gopkg.in/yaml.v3 v3.0.1
+	tprlib v0.0.0-00010101000000-000000000000
+)
+
+replace tprlib => ../tprlib
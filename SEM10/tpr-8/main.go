@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"tprlib/progress"
+)
+
+const (
+	// Error messages
+	errUnknownFormat      = "Невідомий формат файлу %s (підтримуються .json, .yaml, .yml)"
+	errInvalidDiscount    = "Коефіцієнт дисконтування повинен бути в межах [0; 1), отримано %.4f"
+	errNoStates           = "Задача не містить жодного стану"
+	errDuplicateState     = "Стан '%s' оголошено двічі"
+	errNoActions          = "Для стану '%s' не задано жодної дії"
+	errUnknownState       = "Дія '%s'/'%s' веде до невідомого стану '%s'"
+	errInvalidProbability = "Некоректна ймовірність переходу для дії '%s'/'%s': %.4f"
+	errProbabilitySum     = "Сума ймовірностей переходу для дії '%s'/'%s' дорівнює %.4f, а не 1"
+	errInvalidReward      = "Некоректна винагорода дії '%s'/'%s': %v"
+	errNoInput            = "Вкажіть файл із задачею: -input mdp.yaml"
+	errUnknownMethod      = "Невідомий метод '%s' (value, policy або both)"
+
+	// Table formats
+	headerFormat = "%-20s"
+	valueFormat  = "%-15.4f"
+)
+
+// PrintSolution виводить функцію цінності та оптимальну стратегію
+func (m *MDP) PrintSolution(title string, values []float64, policy []int, iterations int) {
+	fmt.Printf("\n%s (ітерацій: %d):\n", title, iterations)
+	fmt.Printf(headerFormat, "Стан")
+	fmt.Printf("%-15s", "V(s)")
+	fmt.Printf(headerFormat, "Оптимальна дія")
+	fmt.Println()
+
+	for i, s := range m.States {
+		fmt.Printf(headerFormat, s.Name)
+		fmt.Printf(valueFormat, values[i])
+		fmt.Printf(headerFormat, s.Actions[policy[i]].Name)
+		fmt.Println()
+	}
+}
+
+func main() {
+	input := flag.String("input", "", "файл із задачею (JSON або YAML)")
+	method := flag.String("method", "both", "метод розв'язання: value, policy або both")
+	eps := flag.Float64("eps", 1e-6, "точність ітерацій за цінністю")
+	maxIter := flag.Int("max-iter", 10000, "максимальна кількість ітерацій")
+	flag.Parse()
+
+	if *method != "value" && *method != "policy" && *method != "both" {
+		fmt.Printf(errUnknownMethod+"\n", *method)
+		os.Exit(2)
+	}
+	if *input == "" {
+		fmt.Println(errNoInput)
+		os.Exit(2)
+	}
+
+	m, err := loadMDP(*input)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Станів: %d, коефіцієнт дисконтування γ = %.2f\n", len(m.States), m.Discount)
+
+	if *method == "value" || *method == "both" {
+		bar := progress.New("Ітерації за цінністю", *maxIter)
+		values, policy, iter := m.ValueIteration(*eps, *maxIter, func(done, total int) {
+			bar.SetTotal(total)
+			bar.Update(done)
+		})
+		bar.SetTotal(iter)
+		bar.Done(iter)
+		m.PrintSolution("Ітерації за цінністю", values, policy, iter)
+	}
+	if *method == "policy" || *method == "both" {
+		values, policy, iter := m.PolicyIteration(*maxIter)
+		m.PrintSolution("Ітерації за стратегіями", values, policy, iter)
+	}
+}
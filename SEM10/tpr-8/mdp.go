@@ -0,0 +1,242 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const probabilityEps = 1e-6
+
+type (
+	// Action – дія, доступна у стані: очікувана миттєва винагорода
+	// та ймовірності переходу до наступних станів
+	Action struct {
+		Name        string             `json:"name" yaml:"name"`
+		Reward      float64            `json:"reward" yaml:"reward"`
+		Transitions map[string]float64 `json:"transitions" yaml:"transitions"`
+	}
+
+	State struct {
+		Name    string   `json:"name" yaml:"name"`
+		Actions []Action `json:"actions" yaml:"actions"`
+	}
+
+	// MDP – марковський процес прийняття рішень з коефіцієнтом дисконтування
+	MDP struct {
+		Discount float64 `json:"discount" yaml:"discount"`
+		States   []State `json:"states" yaml:"states"`
+
+		index map[string]int
+	}
+)
+
+// loadMDP зчитує задачу з файлу JSON або YAML (за розширенням)
+func loadMDP(path string) (*MDP, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMDP(data, path)
+}
+
+// decodeMDP розбирає й перевіряє задачу; формат визначається за розширенням path
+func decodeMDP(data []byte, path string) (*MDP, error) {
+	m := &MDP{}
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, m)
+	case ".json":
+		err = json.Unmarshal(data, m)
+	default:
+		return nil, fmt.Errorf(errUnknownFormat, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, m.validate()
+}
+
+func (m *MDP) validate() error {
+	// Умови записано так, щоб NaN також вважався некоректним значенням
+	if !(m.Discount >= 0 && m.Discount < 1) {
+		return fmt.Errorf(errInvalidDiscount, m.Discount)
+	}
+	if len(m.States) == 0 {
+		return fmt.Errorf(errNoStates)
+	}
+
+	m.index = make(map[string]int, len(m.States))
+	for i, s := range m.States {
+		if _, ok := m.index[s.Name]; ok {
+			return fmt.Errorf(errDuplicateState, s.Name)
+		}
+		m.index[s.Name] = i
+	}
+
+	for _, s := range m.States {
+		if len(s.Actions) == 0 {
+			return fmt.Errorf(errNoActions, s.Name)
+		}
+		for _, a := range s.Actions {
+			if math.IsNaN(a.Reward) || math.IsInf(a.Reward, 0) {
+				return fmt.Errorf(errInvalidReward, s.Name, a.Name, a.Reward)
+			}
+			sum := 0.0
+			for next, p := range a.Transitions {
+				if _, ok := m.index[next]; !ok {
+					return fmt.Errorf(errUnknownState, s.Name, a.Name, next)
+				}
+				if !(p >= 0 && p <= 1) {
+					return fmt.Errorf(errInvalidProbability, s.Name, a.Name, p)
+				}
+				sum += p
+			}
+			if math.Abs(sum-1) > probabilityEps {
+				return fmt.Errorf(errProbabilitySum, s.Name, a.Name, sum)
+			}
+		}
+	}
+	return nil
+}
+
+// qValue – цінність дії: R(s, a) + γ Σ P(s'|s, a)·V(s')
+func (m *MDP) qValue(a Action, values []float64) float64 {
+	q := a.Reward
+	for next, p := range a.Transitions {
+		q += m.Discount * p * values[m.index[next]]
+	}
+	return q
+}
+
+// greedyPolicy обирає в кожному стані дію з максимальною цінністю
+func (m *MDP) greedyPolicy(values []float64) []int {
+	policy := make([]int, len(m.States))
+	for i, s := range m.States {
+		best := math.Inf(-1)
+		for k, a := range s.Actions {
+			if q := m.qValue(a, values); q > best+1e-12 {
+				best, policy[i] = q, k
+			}
+		}
+	}
+	return policy
+}
+
+// ValueIteration розв'язує рівняння Беллмана методом ітерацій за цінністю:
+// V_{k+1}(s) = max_a [R(s, a) + γ Σ P(s'|s, a)·V_k(s')], доки max |V_{k+1} - V_k| >= eps.
+// Після кожної ітерації викликається report (якщо не nil) з кількістю виконаних
+// ітерацій і оцінкою їх загальної кількості.
+func (m *MDP) ValueIteration(eps float64, maxIter int, report func(done, total int)) (values []float64, policy []int, iterations int) {
+	values = make([]float64, len(m.States))
+	for iterations < maxIter {
+		iterations++
+		next := make([]float64, len(m.States))
+		delta := 0.0
+		for i, s := range m.States {
+			next[i] = math.Inf(-1)
+			for _, a := range s.Actions {
+				next[i] = math.Max(next[i], m.qValue(a, values))
+			}
+			delta = math.Max(delta, math.Abs(next[i]-values[i]))
+		}
+		values = next
+		if delta < eps {
+			break
+		}
+		if report != nil {
+			report(iterations, m.estimateIterations(iterations, maxIter, delta, eps))
+		}
+	}
+	return values, m.greedyPolicy(values), iterations
+}
+
+// estimateIterations оцінює загальну кількість ітерацій за цінністю, якщо після
+// iterations ітерацій зміна дорівнює delta: оператор Беллмана – стиск з коефіцієнтом γ,
+// тож за ітерацію зміна зменшується щонайменше в γ разів, доки не стане меншою за eps
+func (m *MDP) estimateIterations(iterations, maxIter int, delta, eps float64) int {
+	if m.Discount == 0 {
+		return min(iterations+1, maxIter)
+	}
+	left := math.Ceil(math.Log(eps/delta) / math.Log(m.Discount))
+	// Нескінченні чи невизначені значення (eps <= 0, переповнення) – оцінки немає
+	if !(left < float64(maxIter-iterations)) {
+		return maxIter
+	}
+	return iterations + max(int(left), 1)
+}
+
+// PolicyIteration розв'язує задачу методом ітерацій за стратегіями:
+// цінність поточної стратегії знаходиться з системи (I - γP_π)V = R_π,
+// після чого стратегія покращується жадібно, доки вона не перестане змінюватись.
+func (m *MDP) PolicyIteration(maxIter int) (values []float64, policy []int, iterations int) {
+	policy = make([]int, len(m.States))
+	for iterations < maxIter {
+		iterations++
+		values = m.evaluatePolicy(policy)
+
+		improved := m.greedyPolicy(values)
+		stable := true
+		for i := range policy {
+			// Дію змінюємо лише за строгого покращення, щоб уникнути зациклення
+			cur := m.qValue(m.States[i].Actions[policy[i]], values)
+			alt := m.qValue(m.States[i].Actions[improved[i]], values)
+			if alt > cur+1e-9 {
+				policy[i] = improved[i]
+				stable = false
+			}
+		}
+		if stable {
+			break
+		}
+	}
+	return values, policy, iterations
+}
+
+// evaluatePolicy розв'язує систему лінійних рівнянь методом Гаусса
+func (m *MDP) evaluatePolicy(policy []int) []float64 {
+	n := len(m.States)
+	a := make([][]float64, n)
+	for i, s := range m.States {
+		a[i] = make([]float64, n+1)
+		a[i][i] = 1
+		act := s.Actions[policy[i]]
+		for next, p := range act.Transitions {
+			a[i][m.index[next]] -= m.Discount * p
+		}
+		a[i][n] = act.Reward
+	}
+
+	for col := range n {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+
+		for r := range n {
+			if r == col || a[r][col] == 0 {
+				continue
+			}
+			f := a[r][col] / a[col][col]
+			for c := col; c <= n; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+		}
+	}
+
+	values := make([]float64, n)
+	for i := range n {
+		values[i] = a[i][n] / a[i][i]
+	}
+	return values
+}
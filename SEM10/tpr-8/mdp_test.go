@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func FuzzDecodeMDP(f *testing.F) {
+	example, err := os.ReadFile("example.yaml")
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(example, false)
+	f.Add([]byte(`{"discount": 0.9, "states": [{"name": "s", "actions": [{"name": "a", "reward": 1, "transitions": {"s": 1}}]}]}`), true)
+	f.Add([]byte(`{"discount": 0.99, "states": [{"name": "s", "actions": [{"name": "a", "reward": 1e308, "transitions": {"s": 1}}]}]}`), true)
+	f.Add([]byte("discount: .nan\nstates: []\n"), false)
+
+	f.Fuzz(func(t *testing.T, data []byte, isJSON bool) {
+		path := "mdp.yaml"
+		if isJSON {
+			path = "mdp.json"
+		}
+		m, err := decodeMDP(data, path)
+		if err != nil {
+			return
+		}
+
+		// Прийнята задача має зберігатися в JSON і зчитуватися назад без змін
+		saved, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("задачу не збережено: %v", err)
+		}
+		back, err := decodeMDP(saved, "mdp.json")
+		if err != nil {
+			t.Fatalf("збережену задачу не зчитано: %v\n%s", err, saved)
+		}
+		if !reflect.DeepEqual(back, m) {
+			t.Fatalf("зчитано іншу задачу:\n%s", saved)
+		}
+
+		for _, solve := range []func() ([]float64, []int, int){
+			func() ([]float64, []int, int) { return m.ValueIteration(1e-6, 100, nil) },
+			func() ([]float64, []int, int) { return m.PolicyIteration(100) },
+		} {
+			values, policy, _ := solve()
+			if len(values) != len(m.States) || len(policy) != len(m.States) {
+				t.Fatalf("розв'язок для %d станів: %d цінностей, %d дій", len(m.States), len(values), len(policy))
+			}
+			for i, k := range policy {
+				if k < 0 || k >= len(m.States[i].Actions) {
+					t.Fatalf("стан %q: дія %d поза межами", m.States[i].Name, k)
+				}
+			}
+		}
+	})
+}
+
+// TestValueIterationProgress перевіряє, що оцінка загальної кількості ітерацій не
+// перевищує -max-iter і до завершення наближається до фактичної кількості
+func TestValueIterationProgress(t *testing.T) {
+	m, err := loadMDP("example.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const maxIter = 10000
+	var last, lastTotal int
+	_, _, iterations := m.ValueIteration(1e-6, maxIter, func(done, total int) {
+		if done != last+1 || total < done || total > maxIter {
+			t.Fatalf("ітерація %d після %d, оцінка %d", done, last, total)
+		}
+		last, lastTotal = done, total
+	})
+	if last != iterations-1 || lastTotal < iterations || lastTotal > iterations+5 {
+		t.Errorf("останній звіт %d/%d, виконано ітерацій %d", last, lastTotal, iterations)
+	}
+}
+
+func TestSolveMDP(t *testing.T) {
+	// γ = 0.5. У стані «ціль» єдина дія дає 2 за крок: V = 2 / (1 - γ) = 4.
+	// Зі «старту»: чекати – V = γV, тобто 0; піти – -1 + γ·4 = 1;
+	// спробувати – V = γ(4/2 + V/2), звідки V = 4/3 – оптимальна дія
+	m, err := decodeMDP([]byte(`{"discount": 0.5, "states": [
+		{"name": "старт", "actions": [
+			{"name": "чекати", "reward": 0, "transitions": {"старт": 1}},
+			{"name": "піти", "reward": -1, "transitions": {"ціль": 1}},
+			{"name": "спробувати", "reward": 0, "transitions": {"старт": 0.5, "ціль": 0.5}}]},
+		{"name": "ціль", "actions": [{"name": "залишитися", "reward": 2, "transitions": {"ціль": 1}}]}]}`), "mdp.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantValues, wantPolicy := []float64{4.0 / 3, 4}, []int{2, 0}
+
+	for _, tt := range []struct {
+		name  string
+		solve func() ([]float64, []int, int)
+		tol   float64
+	}{
+		{"ValueIteration", func() ([]float64, []int, int) { return m.ValueIteration(1e-9, 1000, nil) }, 1e-8},
+		{"PolicyIteration", func() ([]float64, []int, int) { return m.PolicyIteration(100) }, 1e-12},
+	} {
+		values, policy, _ := tt.solve()
+		if !reflect.DeepEqual(policy, wantPolicy) {
+			t.Errorf("%s: стратегія %v, очікувалося %v", tt.name, policy, wantPolicy)
+		}
+		for i, v := range values {
+			if math.Abs(v-wantValues[i]) > tt.tol {
+				t.Errorf("%s: V(%s) = %v, очікувалося %v", tt.name, m.States[i].Name, v, wantValues[i])
+			}
+		}
+	}
+}
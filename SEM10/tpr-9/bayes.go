@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// Indicator – неідеальний прогноз (індикатор) стану середовища;
+// likelihood[j][k] = P(z_k | s_j) – ймовірність результату z_k за стану s_j
+type Indicator struct {
+	signals    []string
+	likelihood [][]float64
+}
+
+func (r *RiskDecisionSystem) CollectIndicator(ir *inputReader) *Indicator {
+	count, err := ir.readInt(promptSignalCount)
+	for err != nil || count <= 0 {
+		fmt.Println(errInvalidValue)
+		count, err = ir.readInt(promptSignalCount)
+	}
+
+	b := &Indicator{
+		signals:    make([]string, count),
+		likelihood: make([][]float64, r.statesCount),
+	}
+	for k := range count {
+		b.signals[k], _ = ir.readString(fmt.Sprintf(promptSignalName, k+1))
+	}
+
+	for j := range r.statesCount {
+		fmt.Printf(promptLikelihoodState, j+1)
+		b.likelihood[j] = ir.readDistribution(count, func(k int) string {
+			return fmt.Sprintf(promptLikelihood, b.signals[k], j+1)
+		})
+	}
+	return b
+}
+
+// Posteriors розраховує за теоремою Байєса ймовірності результатів прогнозу
+// P(z_k) = Σ_j P(z_k | s_j)·P(s_j) та апостеріорні ймовірності станів
+// P(s_j | z_k) = P(z_k | s_j)·P(s_j) / P(z_k)
+func (r *RiskDecisionSystem) Posteriors(b *Indicator) (marginal []float64, posterior [][]float64) {
+	marginal = make([]float64, len(b.signals))
+	posterior = make([][]float64, len(b.signals))
+
+	for k := range b.signals {
+		posterior[k] = make([]float64, r.statesCount)
+		for j := range r.statesCount {
+			joint := b.likelihood[j][k] * r.priors[j]
+			posterior[k][j] = joint
+			marginal[k] += joint
+		}
+		if marginal[k] == 0 {
+			continue
+		}
+		for j := range r.statesCount {
+			posterior[k][j] /= marginal[k]
+		}
+	}
+	return marginal, posterior
+}
+
+// bestValue повертає альтернативу з максимальним значенням критерію
+func (r *RiskDecisionSystem) bestValue(values map[string]float64) (string, float64) {
+	best := r.alternatives[0]
+	for _, alt := range r.alternatives {
+		if values[alt] > values[best] {
+			best = alt
+		}
+	}
+	return best, values[best]
+}
+
+// PerfectInformationValue – очікуваний виграш за досконалої інформації:
+// Σ_j P(s_j)·max_i a_ij
+func (r *RiskDecisionSystem) PerfectInformationValue() float64 {
+	ev := 0.0
+	for j := range r.statesCount {
+		best := math.Inf(-1)
+		for _, alt := range r.alternatives {
+			best = math.Max(best, r.outcomes[alt][j])
+		}
+		ev += r.priors[j] * best
+	}
+	return ev
+}
+
+func (r *RiskDecisionSystem) PrintPosteriors(b *Indicator, marginal []float64, posterior [][]float64) {
+	fmt.Println("\nАпостеріорні ймовірності станів P(стан | прогноз):")
+	fmt.Printf(headerFormat, "Прогноз")
+	fmt.Printf(stateHeaderFormat, "P(прогноз)")
+	for j := range r.statesCount {
+		fmt.Printf(stateHeaderFormat, fmt.Sprintf("Стан %d", j+1))
+	}
+	fmt.Println()
+
+	for k, signal := range b.signals {
+		fmt.Printf(headerFormat, signal)
+		fmt.Printf(probFormat, marginal[k])
+		for _, p := range posterior[k] {
+			fmt.Printf(probFormat, p)
+		}
+		fmt.Println()
+	}
+}
+
+// RunBayes повторно застосовує критерій очікуваного значення до апостеріорних
+// ймовірностей для кожного результату прогнозу та оцінює цінність вибіркової
+// інформації EVSI = Σ_k P(z_k)·max_i EV_i(z_k) - max_i EV_i
+func (r *RiskDecisionSystem) RunBayes(b *Indicator) {
+	marginal, posterior := r.Posteriors(b)
+	r.PrintPosteriors(b, marginal, posterior)
+
+	withInfo := 0.0
+	decisions := make([]string, len(b.signals))
+	for k, signal := range b.signals {
+		if marginal[k] == 0 {
+			continue
+		}
+		ev := r.ExpectedValues(posterior[k])
+		PrintRanking(fmt.Sprintf("Байєса за прогнозу '%s'", signal), r.sortAltValues(ev), "Очік. виграш")
+
+		best, value := r.bestValue(ev)
+		decisions[k] = best
+		withInfo += marginal[k] * value
+	}
+
+	_, withoutInfo := r.bestValue(r.ExpectedValues(r.priors))
+	evsi := withInfo - withoutInfo
+	evpi := r.PerfectInformationValue() - withoutInfo
+
+	fmt.Println("\nОптимальне правило прийняття рішень:")
+	for k, signal := range b.signals {
+		if decisions[k] != "" {
+			fmt.Printf("Прогноз '%s' → %s\n", signal, decisions[k])
+		}
+	}
+
+	fmt.Printf("\nОчікуваний виграш без прогнозу: %.4f\n", withoutInfo)
+	fmt.Printf("Очікуваний виграш з прогнозом: %.4f\n", withInfo)
+	fmt.Printf("Очікувана цінність вибіркової інформації EVSI: %.4f\n", evsi)
+	fmt.Printf("Очікувана цінність досконалої інформації EVPI: %.4f\n", evpi)
+	if evpi > 0 {
+		fmt.Printf("Ефективність прогнозу EVSI / EVPI: %.2f%%\n", evsi/evpi*100)
+	}
+}
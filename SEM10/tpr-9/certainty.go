@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// toleranceFactors – множники толерантності до ризику для аналізу чутливості
+var toleranceFactors = []float64{0.25, 0.5, 1, 2, 4}
+
+// CertaintyEquivalents розраховує детерміновані еквіваленти альтернатив
+// за експоненційної функції корисності u(x) = 1 - exp(-x/R):
+// CE = -R·ln Σ p_j·exp(-a_ij/R). Сума обчислюється як log-sum-exp: виграші
+// зсуваються на найменший серед станів з ненульовою ймовірністю, тож його доданок
+// дорівнює p_j > 0, а решта експонент не перевищують 1. Стани з нульовою
+// ймовірністю пропускаються: їхній виграш не впливає на CE.
+func (r *RiskDecisionSystem) CertaintyEquivalents(tolerance float64) map[string]float64 {
+	ce := make(map[string]float64)
+	for _, alt := range r.alternatives {
+		low := math.Inf(1)
+		for j, x := range r.outcomes[alt] {
+			if r.priors[j] > 0 {
+				low = math.Min(low, x)
+			}
+		}
+
+		sum := 0.0
+		for j, x := range r.outcomes[alt] {
+			if r.priors[j] > 0 {
+				sum += r.priors[j] * math.Exp(-(x-low)/tolerance)
+			}
+		}
+		// За дуже малої R експоненти інших станів обнуляються, але доданок
+		// найменшого виграшу лишається; нульова сума можлива лише без ймовірностей
+		if sum == 0 {
+			ce[alt] = low
+			continue
+		}
+		ce[alt] = low - tolerance*math.Log(sum)
+	}
+	return ce
+}
+
+func (r *RiskDecisionSystem) PrintCertaintyEquivalents(tolerance float64, ce map[string]float64) {
+	ev := r.ExpectedValues(r.priors)
+	fmt.Printf("\nДетерміновані еквіваленти за толерантності до ризику R = %.2f:\n", tolerance)
+	fmt.Printf(headerFormat, "Альтернатива")
+	fmt.Printf(stateHeaderFormat, "Очік. виграш")
+	fmt.Printf(stateHeaderFormat, "CE")
+	fmt.Printf(stateHeaderFormat, "Премія за ризик")
+	fmt.Println()
+
+	for _, alt := range r.alternatives {
+		fmt.Printf(headerFormat, alt)
+		fmt.Printf(probFormat, ev[alt])
+		fmt.Printf(probFormat, ce[alt])
+		fmt.Printf(probFormat, ev[alt]-ce[alt])
+		fmt.Println()
+	}
+}
+
+// PrintToleranceSensitivity показує, як змінюється ранжування за CE
+// зі зміною толерантності до ризику; R → ∞ відповідає нейтральності до ризику
+func (r *RiskDecisionSystem) PrintToleranceSensitivity(tolerance float64) {
+	fmt.Println("\nЧутливість ранжування до толерантності до ризику:")
+	fmt.Printf(stateHeaderFormat, "R")
+	fmt.Println("Ранжування за CE")
+
+	for _, f := range toleranceFactors {
+		fmt.Printf("%-15.2f", tolerance*f)
+		fmt.Println(rankingLine(r.sortAltValues(r.CertaintyEquivalents(tolerance * f))))
+	}
+	fmt.Printf(stateHeaderFormat, "∞")
+	fmt.Println(rankingLine(r.sortAltValues(r.ExpectedValues(r.priors))))
+}
+
+func rankingLine(altValues []AltValue) string {
+	names := make([]string, len(altValues))
+	for i, item := range altValues {
+		names[i] = item.alt
+	}
+	return strings.Join(names, " > ")
+}
+
+// RunCertaintyEquivalents ранжує альтернативи за детермінованим еквівалентом
+func (r *RiskDecisionSystem) RunCertaintyEquivalents(ir *inputReader) {
+	tolerance := ir.readValidatedFloat(promptTolerance, math.SmallestNonzeroFloat64, math.MaxFloat64)
+	ce := r.CertaintyEquivalents(tolerance)
+	r.PrintCertaintyEquivalents(tolerance, ce)
+	PrintRanking("детермінованого еквівалента", r.sortAltValues(ce), "CE")
+	r.PrintToleranceSensitivity(tolerance)
+}
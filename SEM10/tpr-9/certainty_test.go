@@ -0,0 +1,36 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCertaintyEquivalents(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		outcomes  []float64
+		priors    []float64
+		tolerance float64
+		want      float64
+	}{
+		// CE = −100·ln(0.5 + 0.5·e^(−1))
+		{"лотерея 0/100", []float64{0, 100}, []float64{0.5, 0.5}, 100, 37.988549304172246},
+		// Найгірший виграш у стані з нульовою ймовірністю не впливає на CE
+		{"найгірший стан з p = 0", []float64{-1000, 0, 100}, []float64{0, 0.5, 0.5}, 100, 37.988549304172246},
+		{"нульова ймовірність і мала R", []float64{-1e6, 0, 100}, []float64{0, 0.5, 0.5}, 1e-3, 1e-3 * math.Ln2},
+		// Експонента кращого виграшу обнуляється, CE прямує до найгіршого виграшу
+		{"мала R", []float64{0, 100}, []float64{0.5, 0.5}, 1e-300, 0},
+		{"детермінований виграш", []float64{42, 7}, []float64{1, 0}, 5, 42},
+	} {
+		r := &RiskDecisionSystem{
+			alternatives: []string{"A"},
+			statesCount:  len(tt.priors),
+			outcomes:     map[string][]float64{"A": tt.outcomes},
+			priors:       tt.priors,
+		}
+		got := r.CertaintyEquivalents(tt.tolerance)["A"]
+		if math.IsNaN(got) || math.IsInf(got, 0) || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: CE = %v, очікувалося %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// criterionColumn – стовпець зведеної таблиці: значення критерію для кожної
+// альтернативи (більше – краще)
+type criterionColumn struct {
+	title  string
+	values map[string]float64
+}
+
+// MostLikelyState повертає номер найімовірнішого стану (з нуля); якщо таких
+// кілька, обирається перший, а решта повертаються в ties
+func (r *RiskDecisionSystem) MostLikelyState() (state int, ties []int) {
+	for j, p := range r.priors {
+		switch {
+		case p > r.priors[state]+probabilityEps:
+			state, ties = j, nil
+		case j != state && p >= r.priors[state]-probabilityEps:
+			ties = append(ties, j)
+		}
+	}
+	return state, ties
+}
+
+// MaxLikelihood розраховує критерій максимальної правдоподібності:
+// альтернативи порівнюються лише за виграшем у найімовірнішому стані
+func (r *RiskDecisionSystem) MaxLikelihood(state int) map[string]float64 {
+	ml := make(map[string]float64)
+	for _, alt := range r.alternatives {
+		ml[alt] = r.outcomes[alt][state]
+	}
+	return ml
+}
+
+// RunMaxLikelihood виводить ранжування за критерієм максимальної правдоподібності
+func (r *RiskDecisionSystem) RunMaxLikelihood() map[string]float64 {
+	state, ties := r.MostLikelyState()
+	ml := r.MaxLikelihood(state)
+	title := fmt.Sprintf("максимальної правдоподібності (стан %s, p = %.4f)", r.stateLabel(state), r.priors[state])
+	PrintRanking(title, r.sortAltValues(ml), "Виграш")
+	if len(ties) > 0 {
+		names := make([]string, len(ties))
+		for k, j := range ties {
+			names[k] = fmt.Sprint(j + 1)
+		}
+		fmt.Printf("Стани %s мають таку саму ймовірність; використано перший з найімовірніших\n", strings.Join(names, ", "))
+	}
+	return ml
+}
+
+// Quantile повертає p-квантиль виграшу альтернативи (VaR): найменший виграш x,
+// для якого P(X <= x) >= p, а також умовне сподівання виграшу в найгірших
+// p·100 % випадків (CVaR). Якщо квантиль припадає на стан, ймовірність якого
+// частково виходить за межі p, до CVaR входить лише потрібна частка.
+func (p RiskProfile) Quantile(level float64) (valueAtRisk, conditional float64) {
+	prev, tail := 0.0, 0.0
+	for k, x := range p.values {
+		if p.cum[k] >= level-probabilityEps {
+			tail += x * (level - prev)
+			return x, tail / level
+		}
+		tail += x * (p.cum[k] - prev)
+		prev = p.cum[k]
+	}
+	last := p.values[len(p.values)-1]
+	return last, (tail + last*(level-prev)) / level
+}
+
+// QuantileCriteria розраховує VaR і CVaR рівня level для всіх альтернатив
+func (r *RiskDecisionSystem) QuantileCriteria(level float64) (valueAtRisk, conditional map[string]float64) {
+	valueAtRisk, conditional = make(map[string]float64), make(map[string]float64)
+	for _, alt := range r.alternatives {
+		valueAtRisk[alt], conditional[alt] = r.RiskProfile(alt).Quantile(level)
+	}
+	return valueAtRisk, conditional
+}
+
+// RunQuantileCriteria виводить ранжування за p-квантилем виграшу та CVaR
+func (r *RiskDecisionSystem) RunQuantileCriteria(level float64) (valueAtRisk, conditional map[string]float64) {
+	valueAtRisk, conditional = r.QuantileCriteria(level)
+	PrintRanking(fmt.Sprintf("%.2f-квантиля виграшу (VaR)", level), r.sortAltValues(valueAtRisk), "VaR")
+	PrintRanking(fmt.Sprintf("умовного сподівання в найгірших %.0f %% випадків (CVaR)", level*100),
+		r.sortAltValues(conditional), "CVaR")
+	return valueAtRisk, conditional
+}
+
+// PrintCombinedReport виводить зведену таблицю значень критеріїв і найкращу
+// альтернативу за кожним з них
+func (r *RiskDecisionSystem) PrintCombinedReport(columns []criterionColumn) {
+	fmt.Println("\nЗведена таблиця критеріїв:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for _, c := range columns {
+		fmt.Printf(stateHeaderFormat, c.title)
+	}
+	fmt.Println()
+
+	for _, alt := range r.alternatives {
+		fmt.Printf(headerFormat, alt)
+		for _, c := range columns {
+			fmt.Printf(probFormat, c.values[alt])
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf(headerFormat, "Найкраща")
+	for _, c := range columns {
+		fmt.Printf(stateHeaderFormat, r.sortAltValues(c.values)[0].alt)
+	}
+	fmt.Println()
+}
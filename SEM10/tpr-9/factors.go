@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Factor – незалежний невизначений фактор (попит, ціна сировини, погода)
+// з рівнями та їх ймовірностями
+type Factor struct {
+	name   string
+	levels []string
+	probs  []float64
+}
+
+// CollectFactors зчитує фактори, їх рівні та ймовірності рівнів
+func CollectFactors(ir *inputReader) ([]Factor, error) {
+	count, err := ir.readInt(promptFactorCount)
+	if err != nil || count <= 0 {
+		return nil, fmt.Errorf(errInvalidCount, "факторів")
+	}
+
+	factors := make([]Factor, count)
+	for f := range factors {
+		factors[f].name, _ = ir.readString(fmt.Sprintf(promptFactorName, f+1))
+		levels, err := ir.readInt(fmt.Sprintf(promptLevelCount, factors[f].name))
+		if err != nil || levels <= 0 {
+			return nil, fmt.Errorf(errInvalidCount, "рівнів")
+		}
+		factors[f].levels = make([]string, levels)
+		for k := range levels {
+			factors[f].levels[k], _ = ir.readString(fmt.Sprintf(promptLevelName, k+1, factors[f].name))
+		}
+		factors[f].probs = ir.readDistribution(levels, func(k int) string {
+			return fmt.Sprintf(promptLevelProb, factors[f].levels[k], factors[f].name)
+		})
+	}
+	return factors, nil
+}
+
+// ExpandScenarios будує стани як декартів добуток рівнів факторів; ймовірність
+// стану – добуток ймовірностей його рівнів, оскільки фактори незалежні.
+// Останній фактор змінюється найчастіше.
+func ExpandScenarios(factors []Factor) (names []string, probs []float64) {
+	names, probs = []string{""}, []float64{1}
+	for _, f := range factors {
+		var nextNames []string
+		var nextProbs []float64
+		for s, name := range names {
+			for k, level := range f.levels {
+				label := f.name + "=" + level
+				if name != "" {
+					label = name + ", " + label
+				}
+				nextNames = append(nextNames, label)
+				nextProbs = append(nextProbs, probs[s]*f.probs[k])
+			}
+		}
+		names, probs = nextNames, nextProbs
+	}
+	return names, probs
+}
+
+// stateLabel повертає опис стану j для підказок: комбінацію рівнів факторів або номер
+func (r *RiskDecisionSystem) stateLabel(j int) string {
+	if r.scenarios != nil {
+		return fmt.Sprintf("%d (%s)", j+1, r.scenarios[j])
+	}
+	return fmt.Sprint(j + 1)
+}
+
+// PrintScenarios виводить склад і ймовірність кожного стану, побудованого з факторів
+func (r *RiskDecisionSystem) PrintScenarios() {
+	fmt.Println("\nСтани (комбінації факторів):")
+	width := 0
+	for _, s := range r.scenarios {
+		width = max(width, len([]rune(s)))
+	}
+	for j, s := range r.scenarios {
+		fmt.Printf("  Стан %-4d %s%s  p = %.4f\n", j+1, s, strings.Repeat(" ", width-len([]rune(s))), r.priors[j])
+	}
+}
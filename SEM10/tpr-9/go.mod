@@ -0,0 +1,7 @@
+module tpr-9
+
+go 1.22.0
+
+require tprtest v0.0.0-00010101000000-000000000000
+
+replace tprtest => ../tprtest
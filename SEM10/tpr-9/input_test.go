@@ -0,0 +1,51 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"tprtest"
+)
+
+// fuzzInput повертає читача введення з даних фазера; після них іде коректне значення valid,
+// щоб цикл повторного запиту завершився до кінця введення (на якому програма виходить)
+func fuzzInput(data, valid string) *inputReader {
+	return &inputReader{tprtest.Input(data, valid)}
+}
+
+func FuzzReadNumbers(f *testing.F) {
+	for _, s := range []string{"3", "0\n-1\n2", "abc", "7,5", "NaN\nInf\n-Inf\n1e400\n0.5", "0x1p-2", "\n\n"} {
+		f.Add(s)
+	}
+	tprtest.Silence(f)
+	f.Fuzz(func(t *testing.T, data string) {
+		// Коректна перша відповідь має бути прийнята без змін
+		first := tprtest.FirstAnswer(data)
+		want, floatErr := strconv.ParseFloat(first, 64)
+
+		n := fuzzInput(data, "2").readIntInRange("", 1, 5)
+		if n < 1 || n > 5 {
+			t.Fatalf("readIntInRange повернув %d поза [1, 5]", n)
+		}
+		if wantInt, err := strconv.Atoi(first); err == nil && wantInt >= 1 && wantInt <= 5 && n != wantInt {
+			t.Fatalf("readIntInRange повернув %d замість %d", n, wantInt)
+		}
+
+		v := fuzzInput(data, "0.5").readValidatedFloat("", 0, 1)
+		if !(v >= 0 && v <= 1) {
+			t.Fatalf("readValidatedFloat повернув %v поза [0, 1]", v)
+		}
+		if floatErr == nil && want >= 0 && want <= 1 && v != want {
+			t.Fatalf("readValidatedFloat повернув %v замість %v", v, want)
+		}
+
+		v = fuzzInput(data, "1").readValidatedFloat("", -math.MaxFloat64, math.MaxFloat64)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("readValidatedFloat повернув %v", v)
+		}
+		if floatErr == nil && !math.IsNaN(want) && !math.IsInf(want, 0) && v != want {
+			t.Fatalf("readValidatedFloat повернув %v замість %v", v, want)
+		}
+	})
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Prompt templates
+	promptAltCount         = "Введіть кількість альтернатив: "
+	promptAltName          = "Введіть назву альтернативи %d: "
+	promptStateCount       = "Введіть кількість зовнішніх умов (станів): "
+	promptAltValue         = "\nВведіть виграші для альтернативи '%s':\n"
+	promptStateValue       = "Виграш альтернативи '%s' при стані %s: "
+	promptFactorCount      = "Введіть кількість незалежних факторів: "
+	promptFactorName       = "Введіть назву фактора %d: "
+	promptLevelCount       = "Кількість рівнів фактора '%s': "
+	promptLevelName        = "Назва рівня %d фактора '%s': "
+	promptLevelProb        = "Ймовірність рівня '%s' фактора '%s': "
+	promptPrior            = "Апріорна ймовірність стану %d: "
+	promptAnalysis         = "\nДодатковий аналіз (1 – прогноз (індикатор) за Байєсом, 2 – теорія перспектив, 3 – профілі ризику, 4 – детерміновані еквіваленти, 5 – діаграма-торнадо для критерію Байєса, 0 – завершити): "
+	promptSignalCount      = "Введіть кількість можливих результатів прогнозу: "
+	promptSignalName       = "Введіть назву результату прогнозу %d: "
+	promptLikelihoodState  = "\nЙмовірності результатів прогнозу за умови стану %d:\n"
+	promptLikelihood       = "P('%s' | стан %d): "
+	promptReference        = "\nТочка відліку (виграш, нижче якого наслідок сприймається як втрата): "
+	promptDefaultParams    = "Використати параметри Тверські–Канемана (α = β = 0.88, λ = 2.25, γ = 0.61, δ = 0.69)? (1 – так, 2 – ні): "
+	promptAlpha            = "Кривизна функції цінності для здобутків α (від 0.01 до 1): "
+	promptBeta             = "Кривизна функції цінності для втрат β (від 0.01 до 1): "
+	promptLambda           = "Коефіцієнт несхильності до втрат λ (від 1 до 10): "
+	promptGamma            = "Параметр зважування ймовірностей здобутків γ (від 0.28 до 1): "
+	promptDelta            = "Параметр зважування ймовірностей втрат δ (від 0.28 до 1): "
+	promptTolerance        = "\nТолерантність до ризику R для u(x) = 1 - exp(-x/R) (R > 0): "
+	promptTornadoPayoff    = "\nВідносний діапазон виграшів (від 0 до 1, наприклад 0.2 – ±20 %): "
+	promptTornadoProb      = "Відносний діапазон ймовірностей станів (від 0 до 1): "
+	promptTornadoSVG       = "Файл SVG для діаграми (порожній рядок – не зберігати): "
+	promptCriterionResults = "\nРезультати за критерієм %s:\n"
+
+	// Error messages
+	errInvalidCount   = "Некоректне число %s"
+	errInvalidValue   = "Некоректне значення. Будь ласка, спробуйте ще раз."
+	errInputClosed    = "Введення завершилося раніше, ніж було отримано всі дані"
+	errProbabilitySum = "Сума ймовірностей дорівнює %.4f, а повинна дорівнювати 1. Введіть ймовірності ще раз.\n"
+	errQuantileLevel  = "Рівень квантиля повинен бути від 0 до 1 (не включно), а не %g"
+
+	// Table formats
+	headerFormat      = "%-20s"
+	stateHeaderFormat = "%-15s"
+	scoreFormat       = "%-15.2f"
+	probFormat        = "%-15.4f"
+	resultRankFormat  = "%-5s %-20s %-15s\n"
+	resultItemFormat  = "%-5d %-20s %-15.4f\n"
+
+	probabilityEps = 1e-6
+)
+
+const (
+	analysisExit = iota
+	analysisBayes
+	analysisProspect
+	analysisProfile
+	analysisCertainty
+	analysisTornado
+)
+
+type (
+	inputReader struct {
+		reader *bufio.Reader
+	}
+
+	// RiskDecisionSystem – задача прийняття рішень в умовах ризику:
+	// матриця виграшів та ймовірності станів зовнішнього середовища
+	RiskDecisionSystem struct {
+		alternatives []string
+		statesCount  int
+		outcomes     map[string][]float64
+		priors       []float64
+		// scenarios – опис кожного стану як комбінації рівнів факторів (nil, якщо стани введено переліком)
+		scenarios []string
+	}
+
+	// AltValue використовується для сортування альтернатив
+	// по обчисленій величині критерію
+	AltValue struct {
+		alt   string
+		value float64
+	}
+)
+
+func newInputReader() *inputReader {
+	return &inputReader{bufio.NewReader(os.Stdin)}
+}
+
+func (ir *inputReader) readString(prompt string) (string, error) {
+	fmt.Print(prompt)
+	input, err := ir.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(input), nil
+}
+
+func (ir *inputReader) readInt(prompt string) (int, error) {
+	str, err := ir.readString(prompt)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(str)
+}
+
+func (ir *inputReader) readChoice(prompt string, max int) int {
+	return ir.readIntInRange(prompt, 1, max)
+}
+
+func (ir *inputReader) readIntInRange(prompt string, min, max int) int {
+	for {
+		v, err := ir.readInt(prompt)
+		var numErr *strconv.NumError
+		switch {
+		case err == nil && v >= min && v <= max:
+			return v
+		case err != nil && !errors.As(err, &numErr):
+			inputClosed()
+		}
+		fmt.Println(errInvalidValue)
+	}
+}
+
+// inputClosed завершує програму, якщо стандартний вхід закрито посеред введення:
+// інакше цикл повторного запиту чекав би на коректне значення безкінечно
+func inputClosed() {
+	fmt.Println("\n" + errInputClosed)
+	os.Exit(1)
+}
+
+func (ir *inputReader) readValidatedFloat(prompt string, min, max float64) float64 {
+	for {
+		str, err := ir.readString(prompt)
+		if err != nil {
+			inputClosed()
+		}
+		val, err := strconv.ParseFloat(str, 64)
+		if err == nil && val >= min && val <= max {
+			return val
+		}
+		fmt.Println(errInvalidValue)
+	}
+}
+
+// readDistribution зчитує ймовірності, доки їх сума не дорівнюватиме 1
+func (ir *inputReader) readDistribution(count int, prompt func(k int) string) []float64 {
+	for {
+		probs := make([]float64, count)
+		sum := 0.0
+		for k := range count {
+			probs[k] = ir.readValidatedFloat(prompt(k), 0, 1)
+			sum += probs[k]
+		}
+		if math.Abs(sum-1) <= probabilityEps {
+			return probs
+		}
+		fmt.Printf(errProbabilitySum, sum)
+	}
+}
+
+// newRiskDecisionSystem зчитує альтернативи та стани; з withFactors стани
+// будуються з факторів, і їх ймовірності обчислюються одразу
+func newRiskDecisionSystem(ir *inputReader, withFactors bool) (*RiskDecisionSystem, error) {
+	altCount, err := ir.readInt(promptAltCount)
+	if err != nil || altCount <= 0 {
+		return nil, fmt.Errorf(errInvalidCount, "альтернатив")
+	}
+
+	alts := make([]string, altCount)
+	for i := range altCount {
+		alts[i], _ = ir.readString(fmt.Sprintf(promptAltName, i+1))
+	}
+
+	r := &RiskDecisionSystem{
+		alternatives: alts,
+		outcomes:     make(map[string][]float64),
+	}
+	if withFactors {
+		factors, err := CollectFactors(ir)
+		if err != nil {
+			return nil, err
+		}
+		r.scenarios, r.priors = ExpandScenarios(factors)
+		r.statesCount = len(r.scenarios)
+		return r, nil
+	}
+
+	r.statesCount, err = ir.readInt(promptStateCount)
+	if err != nil || r.statesCount <= 0 {
+		return nil, fmt.Errorf(errInvalidCount, "зовнішніх умов")
+	}
+	return r, nil
+}
+
+func (r *RiskDecisionSystem) CollectOutcomes(ir *inputReader) {
+	for _, alt := range r.alternatives {
+		fmt.Printf(promptAltValue, alt)
+		values := make([]float64, r.statesCount)
+
+		for j := range r.statesCount {
+			prompt := fmt.Sprintf(promptStateValue, alt, r.stateLabel(j))
+			values[j] = ir.readValidatedFloat(prompt, -math.MaxFloat64, math.MaxFloat64)
+		}
+
+		r.outcomes[alt] = values
+	}
+}
+
+func (r *RiskDecisionSystem) CollectPriors(ir *inputReader) {
+	fmt.Println()
+	r.priors = ir.readDistribution(r.statesCount, func(j int) string {
+		return fmt.Sprintf(promptPrior, j+1)
+	})
+}
+
+func (r *RiskDecisionSystem) PrintOutcomesMatrix() {
+	fmt.Println("\nМатриця виграшів:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for j := range r.statesCount {
+		fmt.Printf(stateHeaderFormat, fmt.Sprintf("Стан %d", j+1))
+	}
+	fmt.Println()
+
+	for _, alt := range r.alternatives {
+		fmt.Printf(headerFormat, alt)
+		for _, outcome := range r.outcomes[alt] {
+			fmt.Printf(scoreFormat, outcome)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf(headerFormat, "Ймовірність")
+	for _, p := range r.priors {
+		fmt.Printf(probFormat, p)
+	}
+	fmt.Println()
+}
+
+// ExpectedValues розраховує критерій Байєса (очікуваного значення)
+// для заданого розподілу ймовірностей станів: EV_i = Σ p_j·a_ij
+func (r *RiskDecisionSystem) ExpectedValues(probs []float64) map[string]float64 {
+	ev := make(map[string]float64)
+	for _, alt := range r.alternatives {
+		sum := 0.0
+		for j, outcome := range r.outcomes[alt] {
+			sum += probs[j] * outcome
+		}
+		ev[alt] = sum
+	}
+	return ev
+}
+
+// sortAltValues впорядковує альтернативи за спаданням значення критерію;
+// за рівних значень зберігається порядок введення
+func (r *RiskDecisionSystem) sortAltValues(data map[string]float64) []AltValue {
+	arr := make([]AltValue, 0, len(data))
+	for _, alt := range r.alternatives {
+		arr = append(arr, AltValue{alt, data[alt]})
+	}
+	sort.SliceStable(arr, func(i, j int) bool {
+		return arr[i].value > arr[j].value
+	})
+	return arr
+}
+
+func PrintRanking(title string, altValues []AltValue, valueLabel string) {
+	fmt.Printf(promptCriterionResults, title)
+	fmt.Printf(resultRankFormat, "Ранг", "Альтернатива", valueLabel)
+	for i, item := range altValues {
+		fmt.Printf(resultItemFormat, i+1, item.alt, item.value)
+	}
+}
+
+func main() {
+	level := flag.Float64("quantile", 0.1, "рівень p для квантильного критерію (VaR) і CVaR – частка найгірших випадків")
+	withFactors := flag.Bool("factors", false, "задати стани як комбінації рівнів незалежних факторів із їх ймовірностями")
+	flag.Parse()
+	if *level <= 0 || *level >= 1 {
+		fmt.Printf(errQuantileLevel+"\n", *level)
+		return
+	}
+
+	ir := newInputReader()
+	r, err := newRiskDecisionSystem(ir, *withFactors)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if r.scenarios != nil {
+		r.PrintScenarios()
+	}
+	r.CollectOutcomes(ir)
+	if r.priors == nil {
+		r.CollectPriors(ir)
+	}
+	r.PrintOutcomesMatrix()
+
+	ev := r.ExpectedValues(r.priors)
+	PrintRanking("Байєса (апріорні ймовірності)", r.sortAltValues(ev), "Очік. виграш")
+	ml := r.RunMaxLikelihood()
+	valueAtRisk, conditional := r.RunQuantileCriteria(*level)
+	r.PrintCombinedReport([]criterionColumn{
+		{"Байєса", ev},
+		{"Макс. правд.", ml},
+		{fmt.Sprintf("VaR %.2f", *level), valueAtRisk},
+		{fmt.Sprintf("CVaR %.2f", *level), conditional},
+	})
+
+	for {
+		switch ir.readIntInRange(promptAnalysis, analysisExit, analysisTornado) {
+		case analysisBayes:
+			b := r.CollectIndicator(ir)
+			r.RunBayes(b)
+		case analysisProspect:
+			r.RunProspect(ir)
+		case analysisProfile:
+			r.RunRiskProfiles()
+		case analysisCertainty:
+			r.RunCertaintyEquivalents(ir)
+		case analysisTornado:
+			r.RunTornado(ir)
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	plotWidth = 40
+	plotChars = "*#o+x@%&"
+)
+
+// RiskProfile – функція розподілу виграшу альтернативи F(x) = P(X <= x),
+// задана у точках зростання values з накопиченими ймовірностями cum
+type RiskProfile struct {
+	values []float64
+	cum    []float64
+}
+
+// RiskProfile будує профіль ризику альтернативи за апріорними ймовірностями станів
+func (r *RiskDecisionSystem) RiskProfile(alt string) RiskProfile {
+	probs := make(map[float64]float64)
+	for j, x := range r.outcomes[alt] {
+		probs[x] += r.priors[j]
+	}
+
+	profile := RiskProfile{}
+	for x := range probs {
+		profile.values = append(profile.values, x)
+	}
+	sort.Float64s(profile.values)
+
+	total := 0.0
+	for _, x := range profile.values {
+		total += probs[x]
+		profile.cum = append(profile.cum, total)
+	}
+	return profile
+}
+
+// At повертає значення функції розподілу F(x)
+func (p RiskProfile) At(x float64) float64 {
+	f := 0.0
+	for k, v := range p.values {
+		if v > x {
+			break
+		}
+		f = p.cum[k]
+	}
+	return f
+}
+
+// profilePoints повертає всі різні виграші з матриці у порядку зростання
+func (r *RiskDecisionSystem) profilePoints() []float64 {
+	seen := make(map[float64]bool)
+	var points []float64
+	for _, alt := range r.alternatives {
+		for _, x := range r.outcomes[alt] {
+			if !seen[x] {
+				seen[x] = true
+				points = append(points, x)
+			}
+		}
+	}
+	sort.Float64s(points)
+	return points
+}
+
+// StochasticDominance перевіряє, чи домінує профіль a профіль b.
+// Домінування першого порядку: F_a(x) <= F_b(x) для всіх x;
+// другого порядку: ∫F_a <= ∫F_b для всіх x. Хоча б одна нерівність має бути строгою.
+func StochasticDominance(a, b RiskProfile, points []float64) (first, second bool) {
+	first, second = true, true
+	strictFirst, strictSecond := false, false
+	intA, intB := 0.0, 0.0
+
+	for k, x := range points {
+		fa, fb := a.At(x), b.At(x)
+		if fa > fb+probabilityEps {
+			first = false
+		}
+		if fa < fb-probabilityEps {
+			strictFirst = true
+		}
+
+		if k+1 < len(points) {
+			step := points[k+1] - x
+			intA += fa * step
+			intB += fb * step
+			if intA > intB+probabilityEps {
+				second = false
+			}
+			if intA < intB-probabilityEps {
+				strictSecond = true
+			}
+		}
+	}
+	return first && strictFirst, second && (strictSecond || strictFirst)
+}
+
+func (r *RiskDecisionSystem) PrintRiskProfiles(profiles map[string]RiskProfile, points []float64) {
+	fmt.Println("\nПрофілі ризику P(X <= x):")
+	fmt.Printf(headerFormat, "x")
+	for _, alt := range r.alternatives {
+		fmt.Printf(stateHeaderFormat, alt)
+	}
+	fmt.Println()
+
+	for _, x := range points {
+		fmt.Printf("%-20.2f", x)
+		for _, alt := range r.alternatives {
+			fmt.Printf(probFormat, profiles[alt].At(x))
+		}
+		fmt.Println()
+	}
+}
+
+// PlotRiskProfiles будує ASCII-графік ступінчастих функцій розподілу:
+// рядки відповідають рівням ймовірності, стовпці – виграшам
+func (r *RiskDecisionSystem) PlotRiskProfiles(profiles map[string]RiskProfile, points []float64) {
+	lo, hi := points[0], points[len(points)-1]
+	if hi == lo {
+		hi = lo + 1
+	}
+	const height = 10
+
+	grid := make([][]rune, height+1)
+	for row := range grid {
+		grid[row] = []rune(strings.Repeat(" ", plotWidth+1))
+	}
+	marks := []rune(plotChars)
+	for k, alt := range r.alternatives {
+		mark := marks[k%len(marks)]
+		for col := range plotWidth + 1 {
+			x := lo + (hi-lo)*float64(col)/plotWidth
+			row := height - int(profiles[alt].At(x)*height+0.5)
+			if grid[row][col] == ' ' {
+				grid[row][col] = mark
+			}
+		}
+	}
+
+	fmt.Println("\nГрафік профілів ризику:")
+	for row, line := range grid {
+		fmt.Printf("%5.2f |%s\n", 1-float64(row)/height, string(line))
+	}
+	fmt.Printf("      +%s\n", strings.Repeat("-", plotWidth+1))
+	fmt.Printf("       %-*.2f%.2f\n", plotWidth-4, lo, hi)
+	for k, alt := range r.alternatives {
+		fmt.Printf("  %c – %s\n", marks[k%len(marks)], alt)
+	}
+}
+
+// RunRiskProfiles виводить профілі ризику та відношення стохастичного домінування
+func (r *RiskDecisionSystem) RunRiskProfiles() {
+	points := r.profilePoints()
+	profiles := make(map[string]RiskProfile)
+	for _, alt := range r.alternatives {
+		profiles[alt] = r.RiskProfile(alt)
+	}
+
+	r.PrintRiskProfiles(profiles, points)
+	r.PlotRiskProfiles(profiles, points)
+
+	fmt.Println("\nСтохастичне домінування:")
+	found := false
+	for _, a := range r.alternatives {
+		for _, b := range r.alternatives {
+			if a == b {
+				continue
+			}
+			first, second := StochasticDominance(profiles[a], profiles[b], points)
+			switch {
+			case first:
+				fmt.Printf("'%s' домінує '%s' (першого порядку)\n", a, b)
+				found = true
+			case second:
+				fmt.Printf("'%s' домінує '%s' (другого порядку)\n", a, b)
+				found = true
+			}
+		}
+	}
+	if !found {
+		fmt.Println("Профілі ризику перетинаються, домінування відсутнє")
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRiskProfiles(t *testing.T) {
+	r := &RiskDecisionSystem{
+		alternatives: []string{"A", "B", "C", "D"},
+		statesCount:  3,
+		outcomes: map[string][]float64{
+			"A": {10, 20, 30},
+			// B відрізняється від A лише гіршим виграшем у першому стані
+			"B": {0, 20, 30},
+			"C": {20, 20, 20},
+			// D – розкид навколо того самого середнього 20, що й у C
+			"D": {10, 30, 20},
+		},
+		priors: []float64{0.25, 0.25, 0.5},
+	}
+
+	a := r.RiskProfile("A")
+	if !reflect.DeepEqual(a, RiskProfile{values: []float64{10, 20, 30}, cum: []float64{0.25, 0.5, 1}}) {
+		t.Errorf("RiskProfile(A) = %+v", a)
+	}
+	for x, want := range map[float64]float64{5: 0, 10: 0.25, 15: 0.25, 29.9: 0.5, 30: 1, 100: 1} {
+		if got := a.At(x); got != want {
+			t.Errorf("F_A(%v) = %v, очікувалося %v", x, got, want)
+		}
+	}
+
+	points := r.profilePoints()
+	for _, tt := range []struct {
+		a, b          string
+		first, second bool
+	}{
+		{"A", "B", true, true},
+		{"B", "A", false, false},
+		// C не домінує D першого порядку (F_C(20) = 1 > F_D(20) = 0.75), але ∫F_C <= ∫F_D скрізь
+		{"C", "D", false, true},
+		{"D", "C", false, false},
+		{"A", "A", false, false},
+	} {
+		first, second := StochasticDominance(r.RiskProfile(tt.a), r.RiskProfile(tt.b), points)
+		if first != tt.first || second != tt.second {
+			t.Errorf("StochasticDominance(%s, %s) = %v, %v; очікувалося %v, %v", tt.a, tt.b, first, second, tt.first, tt.second)
+		}
+	}
+}
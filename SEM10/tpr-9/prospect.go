@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ProspectParams – параметри кумулятивної теорії перспектив
+type ProspectParams struct {
+	reference float64 // точка відліку: виграші вище неї – здобутки, нижче – втрати
+	alpha     float64 // кривизна функції цінності для здобутків
+	beta      float64 // кривизна функції цінності для втрат
+	lambda    float64 // коефіцієнт несхильності до втрат
+	gamma     float64 // параметр зважування ймовірностей здобутків
+	delta     float64 // параметр зважування ймовірностей втрат
+}
+
+// defaultProspectParams – оцінки Тверські та Канемана (1992)
+var defaultProspectParams = ProspectParams{
+	alpha:  0.88,
+	beta:   0.88,
+	lambda: 2.25,
+	gamma:  0.61,
+	delta:  0.69,
+}
+
+func CollectProspectParams(ir *inputReader) ProspectParams {
+	p := defaultProspectParams
+	p.reference = ir.readValidatedFloat(promptReference, -math.MaxFloat64, math.MaxFloat64)
+	if ir.readChoice(promptDefaultParams, 2) == 1 {
+		return p
+	}
+
+	p.alpha = ir.readValidatedFloat(promptAlpha, 0.01, 1)
+	p.beta = ir.readValidatedFloat(promptBeta, 0.01, 1)
+	p.lambda = ir.readValidatedFloat(promptLambda, 1, 10)
+	p.gamma = ir.readValidatedFloat(promptGamma, 0.28, 1)
+	p.delta = ir.readValidatedFloat(promptDelta, 0.28, 1)
+	return p
+}
+
+// Value – функція цінності: (x - r)^α для здобутків та -λ(r - x)^β для втрат
+func (p ProspectParams) Value(x float64) float64 {
+	if x >= p.reference {
+		return math.Pow(x-p.reference, p.alpha)
+	}
+	return -p.lambda * math.Pow(p.reference-x, p.beta)
+}
+
+// weighting – функція зважування ймовірностей w(q) = q^c / (q^c + (1 - q)^c)^(1/c)
+func weighting(q, c float64) float64 {
+	if q <= 0 {
+		return 0
+	}
+	if q >= 1 {
+		return 1
+	}
+	qc := math.Pow(q, c)
+	return qc / math.Pow(qc+math.Pow(1-q, c), 1/c)
+}
+
+// DecisionWeights розраховує рангово-залежні ваги рішень π_j кумулятивної теорії
+// перспектив: для здобутків ймовірності накопичуються від найкращого наслідку,
+// для втрат – від найгіршого, і до накопичених ймовірностей застосовується w(q)
+func (p ProspectParams) DecisionWeights(outcomes, probs []float64) []float64 {
+	order := make([]int, len(outcomes))
+	for j := range order {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return outcomes[order[a]] > outcomes[order[b]]
+	})
+
+	weights := make([]float64, len(outcomes))
+	cum := 0.0
+	for _, j := range order {
+		if outcomes[j] < p.reference {
+			break
+		}
+		weights[j] = weighting(cum+probs[j], p.gamma) - weighting(cum, p.gamma)
+		cum += probs[j]
+	}
+
+	cum = 0.0
+	for k := len(order) - 1; k >= 0; k-- {
+		j := order[k]
+		if outcomes[j] >= p.reference {
+			break
+		}
+		weights[j] = weighting(cum+probs[j], p.delta) - weighting(cum, p.delta)
+		cum += probs[j]
+	}
+	return weights
+}
+
+// ProspectValues розраховує цінність перспективи кожної альтернативи V_i = Σ π_ij·v(a_ij)
+func (r *RiskDecisionSystem) ProspectValues(p ProspectParams) map[string]float64 {
+	values := make(map[string]float64)
+	for _, alt := range r.alternatives {
+		weights := p.DecisionWeights(r.outcomes[alt], r.priors)
+		for j, x := range r.outcomes[alt] {
+			values[alt] += weights[j] * p.Value(x)
+		}
+	}
+	return values
+}
+
+func (r *RiskDecisionSystem) PrintProspectValues(p ProspectParams) {
+	fmt.Printf("\nПараметри: r = %.2f, α = %.2f, β = %.2f, λ = %.2f, γ = %.2f, δ = %.2f\n",
+		p.reference, p.alpha, p.beta, p.lambda, p.gamma, p.delta)
+	fmt.Println("Цінності наслідків v(x) / ваги рішень π:")
+	fmt.Printf(headerFormat, "Альтернатива")
+	for j := range r.statesCount {
+		fmt.Printf(stateHeaderFormat, fmt.Sprintf("Стан %d", j+1))
+	}
+	fmt.Println()
+
+	for _, alt := range r.alternatives {
+		weights := p.DecisionWeights(r.outcomes[alt], r.priors)
+		fmt.Printf(headerFormat, alt)
+		for j, x := range r.outcomes[alt] {
+			fmt.Printf(stateHeaderFormat, fmt.Sprintf("%.2f / %.3f", p.Value(x), weights[j]))
+		}
+		fmt.Println()
+	}
+}
+
+// RunProspect ранжує альтернативи за кумулятивною теорією перспектив
+func (r *RiskDecisionSystem) RunProspect(ir *inputReader) {
+	p := CollectProspectParams(ir)
+	r.PrintProspectValues(p)
+	PrintRanking("теорії перспектив", r.sortAltValues(r.ProspectValues(p)), "Цінність")
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProspectValues(t *testing.T) {
+	p := defaultProspectParams
+	// Ваги Тверські та Канемана (1992) для ймовірності 0.5: w⁺(0.5) ≈ 0.421, w⁻(0.5) ≈ 0.454
+	if got := p.DecisionWeights([]float64{-100, 100}, []float64{0.5, 0.5}); math.Abs(got[0]-0.454) > 5e-4 || math.Abs(got[1]-0.421) > 5e-4 {
+		t.Errorf("DecisionWeights = %v, очікувалося [0.454 0.421]", got)
+	}
+	if got, want := p.Value(-100), -2.25*math.Pow(100, 0.88); math.Abs(got-want) > 1e-12 {
+		t.Errorf("Value(-100) = %v, очікувалося %v", got, want)
+	}
+
+	for _, tt := range []struct {
+		name     string
+		outcomes []float64
+		want     float64
+	}{
+		// V = w⁺(0.5)·100^0.88; нульовий виграш у точці відліку нічого не додає
+		{"лотерея 0/100", []float64{0, 100}, 24.20526837005097},
+		// V = (w⁺(0.5) - 2.25·w⁻(0.5))·100^0.88 < 0: несхильність до втрат відкидає симетричну лотерею
+		{"лотерея ±100", []float64{-100, 100}, -34.57430921618936},
+	} {
+		r := &RiskDecisionSystem{
+			alternatives: []string{"A"},
+			statesCount:  2,
+			outcomes:     map[string][]float64{"A": tt.outcomes},
+			priors:       []float64{0.5, 0.5},
+		}
+		if got := r.ProspectValues(p)["A"]; math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: V = %v, очікувалося %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	tornadoWidth     = 760
+	tornadoRowHeight = 32
+	tornadoMargin    = 60
+	tornadoLabels    = 190
+)
+
+// TornadoBar – вплив одного вхідного параметра на очікуваний виграш найкращої
+// альтернативи: значення критерію Байєса на нижній і верхній межі діапазону
+// параметра та найкращі альтернативи за цих значень
+type TornadoBar struct {
+	label               string
+	low, high           float64
+	lowBest, highBest   string
+	lowInput, highInput float64
+}
+
+// swing – ширина смуги діаграми (розмах очікуваного виграшу)
+func (t TornadoBar) swing() float64 {
+	return math.Abs(t.high - t.low)
+}
+
+// withPrior повертає ймовірності станів, у яких ймовірність стану j дорівнює p,
+// а решта змінена пропорційно так, щоб сума лишалася 1 (якщо решта нульові – порівну)
+func withPrior(priors []float64, j int, p float64) []float64 {
+	out := make([]float64, len(priors))
+	rest := 1 - priors[j]
+	for k, v := range priors {
+		switch {
+		case k == j:
+			out[k] = p
+		case rest > probabilityEps:
+			out[k] = v * (1 - p) / rest
+		default:
+			out[k] = (1 - p) / float64(len(priors)-1)
+		}
+	}
+	return out
+}
+
+// bestEV повертає найкращу альтернативу за критерієм Байєса і очікуваний виграш alt
+func (r *RiskDecisionSystem) bestEV(outcomes map[string][]float64, probs []float64, alt string) (string, float64) {
+	c := *r
+	c.outcomes = outcomes
+	ev := c.ExpectedValues(probs)
+	return c.sortAltValues(ev)[0].alt, ev[alt]
+}
+
+// Tornado виконує односторонній аналіз чутливості критерію Байєса: кожен виграш
+// найкращої альтернативи змінюється на ±payoffRange від свого значення (відносно),
+// а кожна ймовірність стану – на ±probRange (у межах [0; 1], з перенормуванням решти),
+// поки інші параметри лишаються базовими. Смуги впорядковано за спаданням впливу.
+func (r *RiskDecisionSystem) Tornado(payoffRange, probRange float64) (best string, base float64, bars []TornadoBar) {
+	ev := r.ExpectedValues(r.priors)
+	best = r.sortAltValues(ev)[0].alt
+	base = ev[best]
+
+	for j := range r.statesCount {
+		value := r.outcomes[best][j]
+		bar := TornadoBar{label: fmt.Sprintf("Виграш %s, стан %s", best, r.stateLabel(j))}
+		for side, v := range []float64{value - math.Abs(value)*payoffRange, value + math.Abs(value)*payoffRange} {
+			outcomes := make(map[string][]float64, len(r.outcomes))
+			for alt, row := range r.outcomes {
+				outcomes[alt] = row
+			}
+			outcomes[best] = append([]float64(nil), r.outcomes[best]...)
+			outcomes[best][j] = v
+			alt, ev := r.bestEV(outcomes, r.priors, best)
+			if side == 0 {
+				bar.low, bar.lowBest, bar.lowInput = ev, alt, v
+			} else {
+				bar.high, bar.highBest, bar.highInput = ev, alt, v
+			}
+		}
+		bars = append(bars, bar)
+	}
+
+	if r.statesCount > 1 {
+		for j, p := range r.priors {
+			bar := TornadoBar{label: fmt.Sprintf("Ймовірність стану %s", r.stateLabel(j))}
+			for side, v := range []float64{math.Max(p*(1-probRange), 0), math.Min(p*(1+probRange), 1)} {
+				alt, ev := r.bestEV(r.outcomes, withPrior(r.priors, j, v), best)
+				if side == 0 {
+					bar.low, bar.lowBest, bar.lowInput = ev, alt, v
+				} else {
+					bar.high, bar.highBest, bar.highInput = ev, alt, v
+				}
+			}
+			bars = append(bars, bar)
+		}
+	}
+
+	sort.SliceStable(bars, func(a, b int) bool {
+		return bars[a].swing() > bars[b].swing()
+	})
+	return best, base, bars
+}
+
+// WriteTornadoSVG записує діаграму-торнадо: горизонтальні смуги від значення критерію
+// на нижній межі параметра до значення на верхній, навколо базового значення
+func WriteTornadoSVG(w io.Writer, title string, base float64, bars []TornadoBar) error {
+	height := 2*tornadoMargin + len(bars)*tornadoRowHeight
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Arial, sans-serif" font-size="12">`+"\n",
+		tornadoWidth, height, tornadoWidth, height)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="#fff"/>`+"\n")
+	fmt.Fprintf(&b, `<text x="%d" y="30" text-anchor="middle" font-size="16">%s</text>`+"\n", tornadoWidth/2, html.EscapeString(title))
+
+	lo, hi := base, base
+	for _, bar := range bars {
+		lo, hi = math.Min(lo, math.Min(bar.low, bar.high)), math.Max(hi, math.Max(bar.low, bar.high))
+	}
+	if hi == lo {
+		hi = lo + 1
+	}
+	left, right := float64(tornadoLabels+20), float64(tornadoWidth-tornadoMargin)
+	x := func(v float64) float64 {
+		return left + (v-lo)/(hi-lo)*(right-left)
+	}
+
+	for i, bar := range bars {
+		y := float64(tornadoMargin + i*tornadoRowHeight)
+		mid := y + tornadoRowHeight/2
+		// Нижня межа параметра – синя частина смуги, верхня – помаранчева
+		for _, part := range []struct {
+			value float64
+			color string
+		}{{bar.low, "#4e79a7"}, {bar.high, "#f28e2b"}} {
+			x0, x1 := math.Min(x(base), x(part.value)), math.Max(x(base), x(part.value))
+			fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`+"\n",
+				x0, y+4, x1-x0, float64(tornadoRowHeight-8), part.color)
+		}
+		fmt.Fprintf(&b, `<text x="%d" y="%.1f" text-anchor="end" dominant-baseline="middle">%s</text>`+"\n",
+			tornadoLabels+12, mid, html.EscapeString(bar.label))
+	}
+	bottom := tornadoMargin + len(bars)*tornadoRowHeight
+	fmt.Fprintf(&b, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#333"/>`+"\n", x(base), tornadoMargin-6, x(base), bottom+6)
+	fmt.Fprintf(&b, `<text x="%.1f" y="%d" text-anchor="middle">%.2f</text>`+"\n", x(base), bottom+22, base)
+	b.WriteString("</svg>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RunTornado зчитує діапазони параметрів, виводить дані діаграми-торнадо для
+// найкращої за критерієм Байєса альтернативи і за бажанням зберігає SVG
+func (r *RiskDecisionSystem) RunTornado(ir *inputReader) {
+	payoffRange := ir.readValidatedFloat(promptTornadoPayoff, 0, 1)
+	probRange := ir.readValidatedFloat(promptTornadoProb, 0, 1)
+	best, base, bars := r.Tornado(payoffRange, probRange)
+
+	fmt.Printf("\nДіаграма-торнадо: очікуваний виграш альтернативи %s (базове значення %.2f)\n", best, base)
+	fmt.Printf("%-40s%-24s%-24s%-12s%s\n", "Параметр", "Нижня межа → EV", "Верхня межа → EV", "Розмах", "Найкраща альтернатива")
+	for _, bar := range bars {
+		winners := bar.lowBest
+		if bar.highBest != bar.lowBest {
+			winners += " / " + bar.highBest
+		}
+		fmt.Printf("%-40s%-24s%-24s%-12.2f%s\n", bar.label,
+			fmt.Sprintf("%.4g → %.2f", bar.lowInput, bar.low), fmt.Sprintf("%.4g → %.2f", bar.highInput, bar.high), bar.swing(), winners)
+	}
+
+	path, _ := ir.readString(promptTornadoSVG)
+	if path == "" {
+		return
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer f.Close()
+	if err := WriteTornadoSVG(f, fmt.Sprintf("Чутливість очікуваного виграшу альтернативи %s", best), base, bars); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Діаграму збережено у файл %s\n", path)
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errAnalyzeFile  = "Вкажіть файл задачі: tpr analyze <файл.xlsx|csv|json|yaml|tpr> [прапорці]"
+	errAnalyzeStdin = "З прапорцем -stdin-json задача зчитується зі стандартного входу: файл і -watch не вказуються"
+
+	exactUsage = "обчислювати середні (Лаплас), зважену суму Гурвіца й жаль (Севідж) у точних раціональних числах, " +
+		"щоб результати збігалися з обчисленнями вручну"
+
+	// stdinProblem – назва задачі зі стандартного входу без поля problem у результатах і базі
+	stdinProblem = "stdin"
+)
+
+// analyzeFile зчитує задачу й аналізує її; тип задачі визначається автоматично, якщо kind = auto
+func analyzeFile(path string, opts batchOptions) (*decision.Result, error) {
+	p, err := loadProblem(path, opts.sheet, opts.rate)
+	if err != nil {
+		return nil, err
+	}
+	return analyzeProblem(p, path, opts)
+}
+
+// analyzeStdin зчитує задачу у форматі JSON (як для tpr validate) зі стандартного входу й аналізує її
+func analyzeStdin(r io.Reader, opts batchOptions) (*decision.Result, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	p, diags := checkProblemJSON(data)
+	if len(diags) > 0 {
+		lines := make([]string, len(diags))
+		for i, d := range diags {
+			lines[i] = d.format(stdinProblem)
+		}
+		return nil, fmt.Errorf(errProblemFormat, stdinProblem, strings.Join(lines, "\n"))
+	}
+	name := stdinProblem
+	if p.Problem != "" {
+		name = p.Problem
+	}
+	logProblem(name, &p.Matrix)
+	return analyzeProblem(p, name, opts)
+}
+
+// analyzeProblem аналізує зчитану задачу; name – назва задачі в результатах і базі
+func analyzeProblem(p *problemFile, name string, opts batchOptions) (*decision.Result, error) {
+	m := &p.Matrix
+	kind := opts.kind
+	if kind == kindAuto && p.Kind != "" {
+		kind = p.Kind
+	}
+	if kind == kindAuto {
+		kind = kindPayoff
+		if m.IsRanking() {
+			kind = kindRanking
+		}
+	}
+	logger.Info("параметри аналізу", "problem", name, "params", opts.runParams(kind), "criteria_workers", decision.Workers())
+	if kind != kindRanking {
+		if err := checkLexicographic(m.Columns); err != nil {
+			return nil, err
+		}
+	}
+	done := logStage("aspiration", "problem", name)
+	analyzed, screened, err := screenAspiration(m, kind, opts)
+	done()
+	if err != nil {
+		return nil, err
+	}
+	if opts.normalize != "" {
+		if kind == kindRanking {
+			return nil, fmt.Errorf(errNormalizeRanking)
+		}
+		done = logStage("normalize", "problem", name, "method", opts.normalize)
+		analyzed, err = analyzed.Normalize(opts.normalize)
+		done()
+		if err != nil {
+			return nil, err
+		}
+	}
+	done = logStage("criteria", "problem", name)
+	r := decision.AnalyzeWith(analyzed, opts.params(kind))
+	done()
+	r.Problem = name
+	r.Screened = screened
+	logResult(r)
+	done = logStage("meta", "problem", name)
+	err = addMeta(r, opts)
+	done()
+	if err != nil {
+		return nil, err
+	}
+	done = logStage("groups", "problem", name)
+	err = analyzeGroups(r, m, analyzed, kind, opts)
+	done()
+	if err != nil {
+		return nil, err
+	}
+	if opts.db != nil {
+		defer logStage("save", "problem", name)()
+		if _, err := opts.db.Save(name, opts.runParams(kind), m, r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// PrintResult виводить значення критеріїв, ранжування та множину Парето
+func PrintResult(r *decision.Result) {
+	if len(r.Criteria) > 0 {
+		fmt.Printf("\n%-20s", "Альтернатива")
+		for _, c := range r.Criteria {
+			fmt.Printf("%12s", c.Name)
+		}
+		fmt.Println()
+		flagged := screenedAlternatives(r)
+		for i, alt := range r.Alternatives {
+			if slices.Contains(flagged, alt) {
+				alt += " *"
+			}
+			fmt.Printf("%-20s", alt)
+			for _, c := range r.Criteria {
+				fmt.Printf("%12.4f", c.Values[i])
+			}
+			fmt.Println()
+		}
+
+		fmt.Println("\nРанжування:")
+		for _, c := range r.Criteria {
+			fmt.Printf("  %-8s %s\n", c.Name, strings.Join(c.Ranking, " ≻ "))
+		}
+
+		if len(r.Criteria) > 1 {
+			fmt.Println("\nВідстані Кендалла τ між ранжуваннями критеріїв (частка пар, упорядкованих протилежно):")
+			fmt.Printf("%-10s", "")
+			for _, c := range r.Criteria {
+				fmt.Printf("%10s", c.Name)
+			}
+			fmt.Println()
+			for i, row := range r.KendallDistances() {
+				fmt.Printf("%-10s", r.Criteria[i].Name)
+				for _, d := range row {
+					fmt.Printf("%10.3f", d)
+				}
+				fmt.Println()
+			}
+		}
+	}
+	fmt.Printf("\nМножина Парето: %s\n", strings.Join(r.Pareto, ", "))
+	printScreening(r)
+	printGroups(r)
+}
+
+// watchFile перевіряє час зміни та розмір файлу з інтервалом interval і після кожної
+// зміни повторює аналіз, виводячи відмінності від попереднього результату.
+// Опитування замість сповіщень файлової системи коректно обробляє редактори,
+// що зберігають файл через перейменування тимчасового.
+func watchFile(path string, opts batchOptions, interval time.Duration, prev *decision.Result) {
+	info, err := os.Stat(path)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	lastMod, lastSize := info.ModTime(), info.Size()
+	fmt.Printf("\nСтеження за файлом %s (Ctrl+C – вихід)\n", path)
+
+	for {
+		time.Sleep(interval)
+		info, err := os.Stat(path)
+		if err != nil || (info.ModTime().Equal(lastMod) && info.Size() == lastSize) {
+			continue
+		}
+		lastMod, lastSize = info.ModTime(), info.Size()
+
+		r, cached, err := analyzeCached(path, opts)
+		if err != nil {
+			// Файл міг бути зчитаний під час запису – наступна зміна запустить аналіз знову
+			fmt.Printf("\n[%s] %v\n", time.Now().Format("15:04:05"), err)
+			continue
+		}
+		if cached {
+			fmt.Printf("\n[%s] Файл змінено, але такий самий вміст уже аналізувався – результати з кешу\n", time.Now().Format("15:04:05"))
+		} else {
+			fmt.Printf("\n[%s] Файл змінено, результати перераховано\n", time.Now().Format("15:04:05"))
+		}
+		PrintResult(r)
+		if prev != nil {
+			printChanges(DiffResults(prev, r))
+		}
+		prev = r
+	}
+}
+
+func printChanges(changes []string) {
+	if len(changes) == 0 {
+		fmt.Println("\nЗмін у результатах немає")
+		return
+	}
+	fmt.Println("\nЗміни порівняно з попереднім запуском:")
+	for _, c := range changes {
+		fmt.Println("  " + c)
+	}
+}
+
+func runAnalyze(args []string) error {
+	fs := flag.NewFlagSet("analyze", flag.ExitOnError)
+	kind := fs.String("kind", kindAuto, "тип задачі: auto, payoff (матриця корисності) або ranking (ранжування експертів)")
+	alpha := fs.Float64("alpha", 0.5, "коефіцієнт оптимізму α для критерію Гурвіца")
+	sheet := fs.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	watch := fs.Bool("watch", false, "стежити за файлом і перераховувати результати після кожної зміни")
+	interval := fs.Duration("interval", time.Second, "інтервал перевірки файлу в режимі -watch")
+	dbPath := fs.String("db", "", "зберегти задачу, параметри та результати в базі SQLite (tpr history, tpr show)")
+	meta := fs.String("meta", "", metaUsage)
+	metaNormalize := fs.String("meta-normalize", decision.NormMinMax, metaNormalizeUsage)
+	normalize := fs.String("normalize", "", normalizeUsage)
+	rate := fs.Float64("rate", 0, rateUsage)
+	exact := fs.Bool("exact", false, exactUsage)
+	aspiration := fs.String("aspiration", "", aspirationUsage)
+	aspirationMode := fs.String("aspiration-mode", aspirationExclude, aspirationModeUsage)
+	fs.Var(criterionFlag{}, "criterion", criterionUsage)
+	fs.Var(scriptFlag{}, "script", scriptUsage)
+	fs.Var(lexFlag{}, "lex", lexUsage)
+	fs.Var(criteriaWorkersFlag{}, "criteria-workers", workersUsage)
+	addLogFlags(fs)
+	groups := fs.String("groups", "", groupsUsage)
+	cacheDir := fs.String("cache", "", cacheUsage)
+	stdinJSON := fs.Bool("stdin-json", false, "зчитати задачу в JSON зі стандартного входу й вивести результати в JSON без таблиць")
+	positional := parseInterspersed(fs, args)
+
+	if *stdinJSON && (len(positional) != 0 || *watch) {
+		return fmt.Errorf(errAnalyzeStdin)
+	}
+	if len(positional) != 1 && !*stdinJSON {
+		return fmt.Errorf(errAnalyzeFile)
+	}
+	if *kind != kindAuto && *kind != kindPayoff && *kind != kindRanking {
+		return fmt.Errorf(errGenerateKind, *kind, kindPayoff, kindRanking)
+	}
+
+	if err := checkNormalize(*normalize); err != nil {
+		return err
+	}
+	if err := decision.ValidateRate(*rate); err != nil {
+		return err
+	}
+	if err := decision.ValidateAlpha(*alpha); err != nil {
+		return err
+	}
+	opts := batchOptions{kind: *kind, alpha: *alpha, sheet: *sheet, normalize: *normalize, rate: *rate, exact: *exact}
+	if err := opts.setMeta(*meta, *metaNormalize); err != nil {
+		return err
+	}
+	if err := opts.setAspiration(*aspiration, *aspirationMode); err != nil {
+		return err
+	}
+	if err := opts.setGroups(*groups); err != nil {
+		return err
+	}
+	var err error
+	if opts.cache, err = openCache(*cacheDir); err != nil {
+		return err
+	}
+	if *dbPath != "" {
+		if opts.db, err = openStore(*dbPath, false); err != nil {
+			return err
+		}
+		defer opts.db.Close()
+	}
+	if *stdinJSON {
+		r, err := analyzeStdin(os.Stdin, opts)
+		if err != nil {
+			return err
+		}
+		return writeResultJSON(os.Stdout, r, nil)
+	}
+
+	path := positional[0]
+	r, cached, err := analyzeCached(path, opts)
+	if err != nil && !*watch {
+		return err
+	}
+	if err != nil {
+		fmt.Println(err)
+	} else {
+		if cached {
+			fmt.Printf("Результат узято з кешу %s: файл і параметри не змінилися\n", opts.cache.dir)
+		}
+		PrintResult(r)
+	}
+
+	if *watch {
+		watchFile(path, opts, *interval, r)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errAspirationLevel   = "Некоректний рівень домагань '%s': потрібні числа для всіх станів через кому (10,20,15) або стан=рівень (Посуха=10)"
+	errAspirationState   = "Рівень домагань задано для невідомого стану '%s'"
+	errAspirationCount   = "Рівнів домагань %d, а станів у матриці %d"
+	errAspirationMode    = "Невідомий режим відбору '%s': потрібен %s або %s"
+	errAspirationRanking = "Рівні домагань застосовуються лише до матриці корисності, а не до ранжування експертів"
+	errAspirationNone    = "Жодна альтернатива не досягає рівнів домагань"
+
+	aspirationUsage = "мінімально прийнятні виграші за станами для кон'юнктивного відбору: для всіх станів через кому " +
+		"(10,20,15) або лише для деяких (Посуха=10,Дощ=5)"
+	aspirationModeUsage = "що робити з альтернативами, які не досягають рівнів домагань: exclude – виключити перед ранжуванням, " +
+		"flag – лише позначити"
+
+	aspirationExclude = "exclude"
+	aspirationFlag    = "flag"
+)
+
+// setAspiration перевіряє прапорці -aspiration та -aspiration-mode і записує їх у параметри аналізу;
+// самі рівні зіставляються зі станами під час аналізу кожної задачі
+func (opts *batchOptions) setAspiration(spec, mode string) error {
+	if mode != aspirationExclude && mode != aspirationFlag {
+		return fmt.Errorf(errAspirationMode, mode, aspirationExclude, aspirationFlag)
+	}
+	for _, item := range splitList(spec) {
+		_, value, ok := strings.Cut(item, "=")
+		if !ok {
+			value = item
+		}
+		if _, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err != nil {
+			return fmt.Errorf(errAspirationLevel, item)
+		}
+	}
+	opts.aspiration, opts.aspirationMode = spec, mode
+	return nil
+}
+
+// aspirationLevels зіставляє рівні домагань зі станами матриці; стани без рівня отримують NaN
+func aspirationLevels(spec string, columns []string) ([]float64, error) {
+	items := splitList(spec)
+	levels := make([]float64, len(columns))
+	for j := range levels {
+		levels[j] = math.NaN()
+	}
+	named := slices.ContainsFunc(items, func(s string) bool { return strings.Contains(s, "=") })
+	if !named && len(items) != len(columns) {
+		return nil, fmt.Errorf(errAspirationCount, len(items), len(columns))
+	}
+	for i, item := range items {
+		j := i
+		value := item
+		if named {
+			var state string
+			var ok bool
+			if state, value, ok = strings.Cut(item, "="); !ok {
+				return nil, fmt.Errorf(errAspirationLevel, item)
+			}
+			if j = slices.Index(columns, strings.TrimSpace(state)); j < 0 {
+				return nil, fmt.Errorf(errAspirationState, strings.TrimSpace(state))
+			}
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf(errAspirationLevel, item)
+		}
+		levels[j] = v
+	}
+	return levels, nil
+}
+
+// screenAspiration виконує кон'юнктивний відбір матриці за рівнями домагань opts.aspiration:
+// повертає матрицю для ранжування (у режимі flag – незмінну) і недосягнуті рівні
+func screenAspiration(m *decision.Matrix, kind string, opts batchOptions) (*decision.Matrix, []decision.Shortfall, error) {
+	if opts.aspiration == "" {
+		return m, nil, nil
+	}
+	if kind == kindRanking {
+		return nil, nil, fmt.Errorf(errAspirationRanking)
+	}
+	levels, err := aspirationLevels(opts.aspiration, m.Columns)
+	if err != nil {
+		return nil, nil, err
+	}
+	passed, shortfalls, err := decision.Screen(m, levels)
+	if err != nil {
+		return nil, nil, err
+	}
+	if opts.aspirationMode == aspirationFlag {
+		return m, shortfalls, nil
+	}
+	if len(passed.Alternatives) == 0 {
+		return nil, nil, fmt.Errorf(errAspirationNone)
+	}
+	return passed, shortfalls, nil
+}
+
+// screenedAlternatives повертає альтернативи, що не досягли рівнів домагань, у порядку першого порушення
+func screenedAlternatives(r *decision.Result) []string {
+	var out []string
+	for _, s := range r.Screened {
+		if !slices.Contains(out, s.Alternative) {
+			out = append(out, s.Alternative)
+		}
+	}
+	return out
+}
+
+// printScreening виводить результати кон'юнктивного відбору
+func printScreening(r *decision.Result) {
+	if len(r.Screened) == 0 {
+		return
+	}
+	fmt.Println("\nКон'юнктивний відбір – недосягнуті рівні домагань:")
+	for _, alt := range screenedAlternatives(r) {
+		var misses []string
+		for _, s := range r.Screened {
+			if s.Alternative == alt {
+				misses = append(misses, fmt.Sprintf("%s: %g < %g", s.Column, s.Value, s.Level))
+			}
+		}
+		status := "виключено"
+		if slices.Contains(r.Alternatives, alt) {
+			status = "позначено *"
+		}
+		fmt.Printf("  %s (%s) – %s\n", alt, status, strings.Join(misses, "; "))
+	}
+}
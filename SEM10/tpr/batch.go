@@ -0,0 +1,435 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	formatJSON     = "json"
+	formatMarkdown = "md"
+	kindAuto       = "auto"
+
+	errBatchDir    = "Вкажіть каталог із задачами: tpr batch <каталог> [прапорці]"
+	errBatchFormat = "Невідомий формат '%s': потрібен %s або %s"
+	errBatchNone   = "У каталозі %s немає файлів задач: підтримуються " + inputFormats
+	errBatchFailed = "Задачі, оброблені з помилками:\n%w"
+	// errBatchCancelled позначає в індексі задачі, обробку яких не розпочато через переривання
+	errBatchCancelled   = "обробку скасовано"
+	errBatchInterrupted = "Обробку перервано: оброблено задач – %d з %d, індекс містить часткові результати"
+)
+
+type (
+	// IndexEntry – рядок зведеного індексу пакетної обробки
+	IndexEntry struct {
+		Problem string              `json:"problem"`
+		Result  string              `json:"result,omitempty"`
+		SHA256  string              `json:"sha256,omitempty"`
+		Kind    string              `json:"kind,omitempty"`
+		Best    map[string][]string `json:"best,omitempty"`
+		Pareto  []string            `json:"pareto,omitempty"`
+		Error   string              `json:"error,omitempty"`
+		// Cached – результат узято з кешу (-cache) без повторного аналізу
+		Cached bool `json:"cached,omitempty"`
+	}
+
+	batchOptions struct {
+		kind   string
+		alpha  float64
+		sheet  string
+		format string
+		// db – база, у яку записується кожен аналіз (nil – не записувати)
+		db *store
+		// meta – ваги критеріїв для метаоцінки (nil – без метаоцінки), metaSpec –
+		// ті самі ваги у вигляді прапорця -meta, metaNormalize – спосіб нормалізації
+		meta          map[string]float64
+		metaSpec      string
+		metaNormalize string
+		// normalize – спосіб нормалізації матриці перед обчисленням критеріїв ("" – без нормалізації)
+		normalize string
+		// rate – ставка дисконтування клітинок з грошовими потоками за періодами
+		rate float64
+		// exact – точна раціональна арифметика критеріїв Лапласа, Гурвіца й Севіджа (-exact)
+		exact bool
+		// aspiration – рівні домагань кон'юнктивного відбору ("" – без відбору),
+		// aspirationMode – виключати (exclude) чи лише позначати (flag) альтернативи, що їх не досягають
+		aspiration     string
+		aspirationMode string
+		// groups – групи альтернатив для аналізу за групами (nil – без груп), groupsSpec – ті самі групи у вигляді прапорця
+		groups     []decision.Group
+		groupsSpec string
+		// cache – кеш результатів (nil – аналізувати кожну задачу)
+		cache *resultCache
+	}
+)
+
+// runParams повертає параметри запуску для маніфесту й бази разом із власними
+// критеріями, зареєстрованими прапорцями; kind – визначений тип задачі
+func (opts batchOptions) runParams(kind string) runParams {
+	return runParams{Kind: kind, Alpha: opts.alpha, Sheet: opts.sheet, Criteria: customCriteria, Scripts: customScripts,
+		Lexicographic: lexPriority, Rate: opts.rate, Normalize: opts.normalize, Meta: opts.metaSpec,
+		MetaNormalize: opts.metaNormalize, Aspiration: opts.aspiration, AspirationMode: opts.aspirationMode,
+		Groups: opts.groupsSpec, Exact: opts.exact}
+}
+
+// params повертає параметри критеріїв для задачі типу kind
+func (opts batchOptions) params(kind string) decision.Params {
+	return decision.Params{Alpha: opts.alpha, Kind: kind, Exact: opts.exact}
+}
+
+// parseInterspersed розбирає прапорці, що можуть стояти як до, так і після
+// позиційних аргументів (tpr batch ./problems --format json), і повертає позиційні аргументи
+func parseInterspersed(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		if fs.NArg() == 0 {
+			return positional
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// problemFiles повертає впорядкований перелік файлів задач з відомим розширенням
+// (xlsx, csv, json, yaml, tpr) у каталозі (тимчасові файли Excel "~$..." пропускаються)
+func problemFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || strings.HasPrefix(name, "~$") || formatByExt(name) == "" {
+			continue
+		}
+		files = append(files, filepath.Join(dir, name))
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+// processProblem аналізує одну задачу і записує файл результатів у каталог out
+func processProblem(path, out string, opts batchOptions) IndexEntry {
+	entry := IndexEntry{Problem: filepath.Base(path)}
+	r, cached, err := analyzeCached(path, opts)
+	if err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+	entry.Cached = cached
+	r.Problem = entry.Problem
+	manifest, err := newManifest(path, opts.runParams(r.Kind))
+	if err != nil {
+		entry.Error = err.Error()
+		return entry
+	}
+	entry.SHA256 = manifest.InputSHA256
+
+	// Ім'я результату зберігає розширення задачі: t.json і t.yaml в одному
+	// каталозі дають t.json.json і t.yaml.json, а не перезаписують один файл
+	entry.Result = entry.Problem + "." + opts.format
+	write := writeResultJSON
+	if opts.format == formatMarkdown {
+		write = writeResultMarkdown
+	}
+	if err := saveFile(filepath.Join(out, entry.Result), func(w io.Writer) error { return write(w, r, manifest) }); err != nil {
+		entry.Result = ""
+		entry.Error = err.Error()
+		return entry
+	}
+
+	entry.Kind = r.Kind
+	entry.Pareto = r.Pareto
+	if len(r.Criteria) > 0 {
+		entry.Best = make(map[string][]string)
+		for _, c := range r.Criteria {
+			entry.Best[c.Name] = c.Best
+		}
+	}
+	return entry
+}
+
+// processConcurrently обробляє задачі пулом із workers обробників; порядок записів
+// індексу відповідає порядку файлів незалежно від того, яка задача завершилась першою.
+// Після скасування ctx нові задачі не починаються, а в індексі вони позначаються як скасовані.
+func processConcurrently(ctx context.Context, files []string, out string, opts batchOptions, workers int) []IndexEntry {
+	type job struct {
+		i    int
+		path string
+	}
+	type done struct {
+		i     int
+		entry IndexEntry
+	}
+
+	jobs := make(chan job)
+	results := make(chan done)
+	var wg sync.WaitGroup
+	for range min(workers, len(files)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results <- done{j.i, processProblem(j.path, out, opts)}
+			}
+		}()
+	}
+	go func() {
+	feed:
+		for i, path := range files {
+			select {
+			case jobs <- job{i, path}:
+			case <-ctx.Done():
+				break feed
+			}
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	index := make([]IndexEntry, len(files))
+	for i, path := range files {
+		index[i] = IndexEntry{Problem: filepath.Base(path), Error: errBatchCancelled}
+	}
+	for d := range results {
+		index[d.i] = d.entry
+		switch {
+		case d.entry.Error != "":
+			fmt.Printf("  %s: помилка\n", d.entry.Problem)
+		case d.entry.Cached:
+			fmt.Printf("  %s → %s (з кешу)\n", d.entry.Problem, d.entry.Result)
+		default:
+			fmt.Printf("  %s → %s\n", d.entry.Problem, d.entry.Result)
+		}
+	}
+	return index
+}
+
+func runBatch(args []string) error {
+	fs := flag.NewFlagSet("batch", flag.ExitOnError)
+	out := fs.String("out", "", "каталог для результатів (за замовчуванням <каталог>/results)")
+	format := fs.String("format", formatJSON, "формат файлів результатів: json або md")
+	kind := fs.String("kind", kindAuto, "тип задач: auto, payoff (матриця корисності) або ranking (ранжування експертів)")
+	alpha := fs.Float64("alpha", 0.5, "коефіцієнт оптимізму α для критерію Гурвіца")
+	sheet := fs.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	workers := fs.Int("workers", runtime.NumCPU(), "кількість задач, що обробляються одночасно")
+	dbPath := fs.String("db", "", "зберегти кожну задачу, параметри та результати в базі SQLite (tpr history, tpr show)")
+	meta := fs.String("meta", "", metaUsage)
+	metaNormalize := fs.String("meta-normalize", decision.NormMinMax, metaNormalizeUsage)
+	normalize := fs.String("normalize", "", normalizeUsage)
+	rate := fs.Float64("rate", 0, rateUsage)
+	exact := fs.Bool("exact", false, exactUsage)
+	aspiration := fs.String("aspiration", "", aspirationUsage)
+	aspirationMode := fs.String("aspiration-mode", aspirationExclude, aspirationModeUsage)
+	fs.Var(criterionFlag{}, "criterion", criterionUsage)
+	fs.Var(scriptFlag{}, "script", scriptUsage)
+	fs.Var(lexFlag{}, "lex", lexUsage)
+	fs.Var(criteriaWorkersFlag{}, "criteria-workers", workersUsage)
+	addLogFlags(fs)
+	groups := fs.String("groups", "", groupsUsage)
+	cacheDir := fs.String("cache", "", cacheUsage)
+	positional := parseInterspersed(fs, args)
+
+	if len(positional) != 1 {
+		return fmt.Errorf(errBatchDir)
+	}
+	if *format != formatJSON && *format != formatMarkdown {
+		return fmt.Errorf(errBatchFormat, *format, formatJSON, formatMarkdown)
+	}
+	if *workers < 1 {
+		return fmt.Errorf(errGenerateCount, "обробників", *workers)
+	}
+	if *kind != kindAuto && *kind != kindPayoff && *kind != kindRanking {
+		return fmt.Errorf(errGenerateKind, *kind, kindPayoff, kindRanking)
+	}
+
+	dir := positional[0]
+	files, err := problemFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf(errBatchNone, dir)
+	}
+	if *out == "" {
+		*out = filepath.Join(dir, "results")
+	}
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+
+	if err := checkNormalize(*normalize); err != nil {
+		return err
+	}
+	if err := decision.ValidateRate(*rate); err != nil {
+		return err
+	}
+	if err := decision.ValidateAlpha(*alpha); err != nil {
+		return err
+	}
+	opts := batchOptions{kind: *kind, alpha: *alpha, sheet: *sheet, format: *format, normalize: *normalize, rate: *rate,
+		exact: *exact}
+	if err := opts.setMeta(*meta, *metaNormalize); err != nil {
+		return err
+	}
+	if err := opts.setAspiration(*aspiration, *aspirationMode); err != nil {
+		return err
+	}
+	if err := opts.setGroups(*groups); err != nil {
+		return err
+	}
+	if opts.cache, err = openCache(*cacheDir); err != nil {
+		return err
+	}
+	if *dbPath != "" {
+		if opts.db, err = openStore(*dbPath, false); err != nil {
+			return err
+		}
+		defer opts.db.Close()
+	}
+	// Ctrl+C зупиняє видачу нових задач; розпочаті завершуються, і індекс усе одно записується
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	index := processConcurrently(ctx, files, *out, opts, *workers)
+
+	var errs []error
+	cancelled := 0
+	for _, entry := range index {
+		if entry.Error == errBatchCancelled {
+			cancelled++
+		} else if entry.Error != "" {
+			errs = append(errs, fmt.Errorf("%s: %s", entry.Problem, entry.Error))
+		}
+	}
+
+	indexPath := filepath.Join(*out, "index."+*format)
+	write := func(w io.Writer) error { return writeIndexJSON(w, index) }
+	if *format == formatMarkdown {
+		write = func(w io.Writer) error { return writeIndexMarkdown(w, index) }
+	}
+	if err := saveFile(indexPath, write); err != nil {
+		return err
+	}
+	if cancelled > 0 {
+		return fmt.Errorf(errBatchInterrupted, len(files)-cancelled, len(files))
+	}
+	fmt.Printf("Оброблено задач: %d, з помилками: %d. Зведений індекс: %s\n", len(files), len(errs), indexPath)
+	if len(errs) > 0 {
+		return fmt.Errorf(errBatchFailed, errors.Join(errs...))
+	}
+	return nil
+}
+
+func saveFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func writeResultJSON(w io.Writer, r *decision.Result, m *Manifest) error {
+	return writeJSON(w, resultFile{SchemaVersion: resultSchemaVersion, Result: r, Manifest: m})
+}
+
+// writeResultMarkdown записує значення критеріїв, ранжування та множину Парето у форматі Markdown,
+// а наприкінці – маніфест із хешем вхідного файлу та параметрами
+func writeResultMarkdown(w io.Writer, r *decision.Result, m *Manifest) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", r.Problem)
+	if len(r.Criteria) > 0 {
+		b.WriteString("| Альтернатива |")
+		for _, c := range r.Criteria {
+			fmt.Fprintf(&b, " %s |", c.Name)
+		}
+		b.WriteString("\n| --- |" + strings.Repeat(" ---: |", len(r.Criteria)) + "\n")
+		for i, alt := range r.Alternatives {
+			fmt.Fprintf(&b, "| %s |", alt)
+			for _, c := range r.Criteria {
+				fmt.Fprintf(&b, " %.4f |", c.Values[i])
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		for _, c := range r.Criteria {
+			fmt.Fprintf(&b, "- **%s**: %s\n", c.Name, strings.Join(c.Ranking, " ≻ "))
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "Множина Парето: %s\n", strings.Join(r.Pareto, ", "))
+	if len(r.Screened) > 0 {
+		b.WriteString("\nНедосягнуті рівні домагань:\n\n")
+		for _, s := range r.Screened {
+			fmt.Fprintf(&b, "- %s, %s: %g < %g\n", s.Alternative, s.Column, s.Value, s.Level)
+		}
+	}
+	for _, g := range r.Groups {
+		fmt.Fprintf(&b, "\n## Група «%s»\n\n", g.Name)
+		for _, c := range g.Criteria {
+			fmt.Fprintf(&b, "- **%s**: %s\n", c.Name, strings.Join(c.Ranking, " ≻ "))
+		}
+		fmt.Fprintf(&b, "\nМножина Парето групи: %s\n", strings.Join(g.Pareto, ", "))
+	}
+	if len(r.GroupBest) > 0 {
+		b.WriteString("\n## Порівняння груп за найкращими альтернативами\n\n")
+		for _, c := range r.GroupBest {
+			fmt.Fprintf(&b, "- **%s**: %s\n", c.Name, strings.Join(c.Ranking, " ≻ "))
+		}
+	}
+
+	b.WriteString("\n## Маніфест\n\n")
+	fmt.Fprintf(&b, "- Вхідний файл: %s\n", m.Input)
+	fmt.Fprintf(&b, "- SHA-256: `%s`\n", m.InputSHA256)
+	fmt.Fprintf(&b, "- Параметри: тип %s, α = %g", m.Params.Kind, m.Params.Alpha)
+	if m.Params.Sheet != "" {
+		fmt.Fprintf(&b, ", аркуш %s", m.Params.Sheet)
+	}
+	fmt.Fprintf(&b, "\n- %s %s, %s\n", m.Tool, m.Version, m.Created.Format(time.RFC3339))
+	fmt.Fprintf(&b, "- Версія формату результатів: %d\n", resultSchemaVersion)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeIndexJSON(w io.Writer, index []IndexEntry) error {
+	return writeJSON(w, index)
+}
+
+func writeIndexMarkdown(w io.Writer, index []IndexEntry) error {
+	var b strings.Builder
+	b.WriteString("| Задача | Тип | Результат | Множина Парето | SHA-256 | Помилка |\n| --- | --- | --- | --- | --- | --- |\n")
+	for _, e := range index {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+			e.Problem, e.Kind, e.Result, strings.Join(e.Pareto, ", "), e.SHA256, strings.ReplaceAll(e.Error, "|", `\|`))
+	}
+	fmt.Fprintf(&b, "\ntpr %s, %s\n", toolVersion(), time.Now().UTC().Format(time.RFC3339))
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestBatchResultNames перевіряє, що задачі з однаковою назвою в різних форматах
+// дають окремі файли результатів, а не перезаписують один
+func TestBatchResultNames(t *testing.T) {
+	dir := t.TempDir()
+	problems := map[string]string{
+		"t.json": `{"kind":"payoff","alternatives":["A","B"],"columns":["s1"],"values":[[1],[2]]}`,
+		"t.csv":  "Альтернатива,s1\nC,3\nD,4\n",
+	}
+	for name, data := range problems {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := problemFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "results")
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	opts := batchOptions{kind: kindAuto, alpha: 0.5, format: formatJSON}
+
+	index := processConcurrently(context.Background(), files, out, opts, 2)
+	want := map[string]string{"t.csv": "t.csv.json", "t.json": "t.json.json"}
+	for _, e := range index {
+		if e.Error != "" {
+			t.Fatalf("%s: %s", e.Problem, e.Error)
+		}
+		if e.Result != want[e.Problem] {
+			t.Errorf("%s: результат %q, потрібно %q", e.Problem, e.Result, want[e.Problem])
+		}
+		if _, err := os.Stat(filepath.Join(out, e.Result)); err != nil {
+			t.Error(err)
+		}
+	}
+	if len(index) != len(want) {
+		t.Fatalf("у індексі %d задач замість %d", len(index), len(want))
+	}
+}
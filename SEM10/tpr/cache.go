@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"tpr/pkg/decision"
+)
+
+const cacheUsage = "каталог кешу результатів: задача, вхідний файл і параметри якої не змінилися з попереднього " +
+	"запуску, не аналізується повторно (порожньо – без кешу)"
+
+type (
+	// resultCache – каталог з результатами аналізу, що зберігаються у файлах <ключ>.json;
+	// ключ – SHA-256 вмісту вхідного файлу, параметрів запуску та версії tpr
+	resultCache struct {
+		dir string
+	}
+
+	// cacheEntry – запис кешу: результат разом із вхідною матрицею для бази (-db)
+	cacheEntry struct {
+		SchemaVersion int              `json:"schema_version"`
+		Input         decision.Matrix  `json:"input"`
+		Result        *decision.Result `json:"result"`
+	}
+)
+
+// openCache створює каталог кешу; порожній dir – кеш вимкнено (nil)
+func openCache(dir string) (*resultCache, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &resultCache{dir: dir}, nil
+}
+
+// key обчислює ключ задачі з файлу path. Скрипти критеріїв хешуються за вмістом,
+// щоб зміна скрипту без зміни шляху також скидала кеш.
+func (c *resultCache) key(path string, opts batchOptions) (string, error) {
+	h := sha256.New()
+	params, _ := json.Marshal(opts.runParams(opts.kind))
+	h.Write([]byte(toolVersion()))
+	h.Write(params)
+	for _, p := range append([]string{path}, customScripts...) {
+		sum, err := fileSHA256(p)
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte(sum))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (c *resultCache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// Get повертає запис за ключем; nil – записи немає або її записано іншою версією формату
+func (c *resultCache) Get(key string) *cacheEntry {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil
+	}
+	var e cacheEntry
+	if json.Unmarshal(data, &e) != nil || e.SchemaVersion != resultSchemaVersion || e.Result == nil {
+		return nil
+	}
+	return &e
+}
+
+// Put записує результат у кеш. Файл спершу записується під тимчасовою назвою,
+// щоб паралельні обробники batch не прочитали його частково.
+func (c *resultCache) Put(key string, m *decision.Matrix, r *decision.Result) error {
+	data, err := json.Marshal(cacheEntry{SchemaVersion: resultSchemaVersion, Input: *m, Result: r})
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(c.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), c.path(key))
+}
+
+// analyzeCached аналізує задачу з файлу path, беручи результат з кешу opts.cache,
+// якщо файл і параметри не змінилися; cached повідомляє, що аналіз пропущено.
+// Запуск, узятий з кешу, так само записується в базу -db.
+func analyzeCached(path string, opts batchOptions) (r *decision.Result, cached bool, err error) {
+	c := opts.cache
+	if c == nil {
+		r, err = analyzeFile(path, opts)
+		return r, false, err
+	}
+	key, err := c.key(path, opts)
+	if err != nil {
+		return nil, false, err
+	}
+	if e := c.Get(key); e != nil {
+		logger.Info("результат узято з кешу", "problem", path, "key", key)
+		e.Result.Problem = path
+		if opts.db != nil {
+			if _, err := opts.db.Save(path, opts.runParams(e.Result.Kind), &e.Input, e.Result); err != nil {
+				return nil, false, err
+			}
+		}
+		return e.Result, true, nil
+	}
+
+	p, err := loadProblem(path, opts.sheet, opts.rate)
+	if err != nil {
+		return nil, false, err
+	}
+	input := p.Matrix
+	if r, err = analyzeProblem(p, path, opts); err != nil {
+		return nil, false, err
+	}
+	// Результат, який не вдалося записати (наприклад, з нескінченними значеннями), просто не кешується
+	c.Put(key, &input, r)
+	return r, false, nil
+}
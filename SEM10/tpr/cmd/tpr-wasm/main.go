@@ -0,0 +1,19 @@
+//go:build js && wasm
+
+// tpr-wasm – збірка обчислювального ядра tpr для браузера. Після запуску модуль
+// реєструє функції computeCriteria і computePareto та лишається активним.
+//
+// Збірка статичної сторінки:
+//
+//	GOOS=js GOARCH=wasm go build -o cmd/tpr-wasm/tpr.wasm ./cmd/tpr-wasm
+//	cp "$(go env GOROOT)/lib/wasm/wasm_exec.js" cmd/tpr-wasm/
+//
+// Далі каталог cmd/tpr-wasm можна віддавати будь-яким статичним сервером.
+package main
+
+import "tpr/pkg/decision"
+
+func main() {
+	decision.RegisterJS()
+	select {}
+}
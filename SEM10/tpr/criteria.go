@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errCriterionSyntax = "Некоректне визначення критерію '%s': потрібно назва=вираз або назва:min=вираз"
+	errCriterionName   = "Некоректна назва критерію '%s': допускаються літери, цифри, '_' та '-'"
+
+	criterionUsage = "власний критерій назва=вираз над агрегатами рядка (min, max, sum, mean, median, stddev, range, n, alpha), " +
+		"наприклад score=0.6*max+0.3*mean-0.1*stddev; назва:min=… – найкраща альтернатива має найменше значення; прапорець можна повторювати"
+)
+
+// customCriteria – визначення власних критеріїв з прапорців -criterion у порядку
+// реєстрації; записуються в параметри запуску, щоб tpr verify міг їх відтворити
+var customCriteria []string
+
+// criterionFlag реєструє критерій-вираз для кожного прапорця -criterion
+type criterionFlag struct{}
+
+func (criterionFlag) String() string { return "" }
+
+func (criterionFlag) Set(value string) error {
+	return registerCriterion(value)
+}
+
+// registerCriterion розбирає визначення назва[:min|:max]=вираз і реєструє критерій
+func registerCriterion(def string) error {
+	head, source, ok := strings.Cut(def, "=")
+	if !ok || strings.TrimSpace(source) == "" {
+		return fmt.Errorf(errCriterionSyntax, def)
+	}
+	name, dir, _ := strings.Cut(strings.TrimSpace(head), ":")
+	if !validCriterionName(name) {
+		return fmt.Errorf(errCriterionName, name)
+	}
+	direction := decision.Maximize
+	switch dir {
+	case "", "max":
+	case "min":
+		direction = decision.Minimize
+	default:
+		return fmt.Errorf(errCriterionSyntax, def)
+	}
+	if err := decision.RegisterExpression(name, source, direction); err != nil {
+		return err
+	}
+	customCriteria = append(customCriteria, def)
+	return nil
+}
+
+func validCriterionName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if !(r == '_' || r == '-' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,829 @@
+// Повідомлення та сервіс gRPC для обчислень утиліти tpr (tpr grpc).
+// Після зміни файлу код Go генерується заново:
+//   protoc --go_out=. --go_opt=paths=source_relative \
+//          --go-grpc_out=. --go-grpc_opt=paths=source_relative decisionpb/decision.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: decisionpb/decision.proto
+
+package decisionpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Рядок матриці: значення альтернативи в кожному стовпці
+type Row struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float64              `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Row) Reset() {
+	*x = Row{}
+	mi := &file_decisionpb_decision_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Row) ProtoMessage() {}
+
+func (x *Row) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Row.ProtoReflect.Descriptor instead.
+func (*Row) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Row) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Матриця корисності: rows[i].values[j] – корисність альтернативи i за стану j
+type PayoffMatrix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Problem       string                 `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+	Alternatives  []string               `protobuf:"bytes,2,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	States        []string               `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	Rows          []*Row                 `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoffMatrix) Reset() {
+	*x = PayoffMatrix{}
+	mi := &file_decisionpb_decision_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoffMatrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoffMatrix) ProtoMessage() {}
+
+func (x *PayoffMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoffMatrix.ProtoReflect.Descriptor instead.
+func (*PayoffMatrix) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayoffMatrix) GetProblem() string {
+	if x != nil {
+		return x.Problem
+	}
+	return ""
+}
+
+func (x *PayoffMatrix) GetAlternatives() []string {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *PayoffMatrix) GetStates() []string {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *PayoffMatrix) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Ранжування експертів: rows[i].values[k] – ранг альтернативи i від експерта k (1 – найкраща)
+type Rankings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Problem       string                 `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+	Alternatives  []string               `protobuf:"bytes,2,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	Experts       []string               `protobuf:"bytes,3,rep,name=experts,proto3" json:"experts,omitempty"`
+	Rows          []*Row                 `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rankings) Reset() {
+	*x = Rankings{}
+	mi := &file_decisionpb_decision_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rankings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rankings) ProtoMessage() {}
+
+func (x *Rankings) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rankings.ProtoReflect.Descriptor instead.
+func (*Rankings) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Rankings) GetProblem() string {
+	if x != nil {
+		return x.Problem
+	}
+	return ""
+}
+
+func (x *Rankings) GetAlternatives() []string {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *Rankings) GetExperts() []string {
+	if x != nil {
+		return x.Experts
+	}
+	return nil
+}
+
+func (x *Rankings) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type CriteriaRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Matrix *PayoffMatrix          `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	// Коефіцієнт оптимізму для критерію Гурвіца; якщо не задано – 0.5
+	Alpha         *float64 `protobuf:"fixed64,2,opt,name=alpha,proto3,oneof" json:"alpha,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriteriaRequest) Reset() {
+	*x = CriteriaRequest{}
+	mi := &file_decisionpb_decision_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriteriaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriteriaRequest) ProtoMessage() {}
+
+func (x *CriteriaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriteriaRequest.ProtoReflect.Descriptor instead.
+func (*CriteriaRequest) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CriteriaRequest) GetMatrix() *PayoffMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *CriteriaRequest) GetAlpha() float64 {
+	if x != nil && x.Alpha != nil {
+		return *x.Alpha
+	}
+	return 0
+}
+
+// Значення критерію в порядку альтернатив задачі та ранжування від найкращої
+type CriterionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []float64              `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Ranking       []string               `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	Best          []string               `protobuf:"bytes,4,rep,name=best,proto3" json:"best,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriterionResult) Reset() {
+	*x = CriterionResult{}
+	mi := &file_decisionpb_decision_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriterionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionResult) ProtoMessage() {}
+
+func (x *CriterionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionResult.ProtoReflect.Descriptor instead.
+func (*CriterionResult) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CriterionResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CriterionResult) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *CriterionResult) GetRanking() []string {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+func (x *CriterionResult) GetBest() []string {
+	if x != nil {
+		return x.Best
+	}
+	return nil
+}
+
+type CriteriaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Problem       string                 `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+	Criteria      []*CriterionResult     `protobuf:"bytes,2,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	Pareto        []string               `protobuf:"bytes,3,rep,name=pareto,proto3" json:"pareto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriteriaResponse) Reset() {
+	*x = CriteriaResponse{}
+	mi := &file_decisionpb_decision_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriteriaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriteriaResponse) ProtoMessage() {}
+
+func (x *CriteriaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriteriaResponse.ProtoReflect.Descriptor instead.
+func (*CriteriaResponse) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CriteriaResponse) GetProblem() string {
+	if x != nil {
+		return x.Problem
+	}
+	return ""
+}
+
+func (x *CriteriaResponse) GetCriteria() []*CriterionResult {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *CriteriaResponse) GetPareto() []string {
+	if x != nil {
+		return x.Pareto
+	}
+	return nil
+}
+
+type ParetoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Problem       string                 `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+	Pareto        []string               `protobuf:"bytes,2,rep,name=pareto,proto3" json:"pareto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParetoResponse) Reset() {
+	*x = ParetoResponse{}
+	mi := &file_decisionpb_decision_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParetoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParetoResponse) ProtoMessage() {}
+
+func (x *ParetoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParetoResponse.ProtoReflect.Descriptor instead.
+func (*ParetoResponse) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ParetoResponse) GetProblem() string {
+	if x != nil {
+		return x.Problem
+	}
+	return ""
+}
+
+func (x *ParetoResponse) GetPareto() []string {
+	if x != nil {
+		return x.Pareto
+	}
+	return nil
+}
+
+type MonteCarloRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Matrix *PayoffMatrix          `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Alpha  *float64               `protobuf:"fixed64,2,opt,name=alpha,proto3,oneof" json:"alpha,omitempty"`
+	// Відносне відхилення значень матриці: кожне значення рівномірно змінюється на ±deviation
+	Deviation  float64 `protobuf:"fixed64,3,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Iterations int32   `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// Зерно генератора; однакове зерно дає однакові результати, 0 – зерно сервера (tpr grpc -seed)
+	Seed uint64 `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Як часто надсилати проміжний результат (кожні progress_every ітерацій)
+	ProgressEvery int32 `protobuf:"varint,6,opt,name=progress_every,json=progressEvery,proto3" json:"progress_every,omitempty"`
+	// Кореляційна матриця збурень станів: correlation[j].values[k] – кореляція станів j і k.
+	// Порожня – стани збурюються незалежно
+	Correlation   []*Row `protobuf:"bytes,7,rep,name=correlation,proto3" json:"correlation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonteCarloRequest) Reset() {
+	*x = MonteCarloRequest{}
+	mi := &file_decisionpb_decision_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonteCarloRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonteCarloRequest) ProtoMessage() {}
+
+func (x *MonteCarloRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonteCarloRequest.ProtoReflect.Descriptor instead.
+func (*MonteCarloRequest) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MonteCarloRequest) GetMatrix() *PayoffMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *MonteCarloRequest) GetAlpha() float64 {
+	if x != nil && x.Alpha != nil {
+		return *x.Alpha
+	}
+	return 0
+}
+
+func (x *MonteCarloRequest) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *MonteCarloRequest) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *MonteCarloRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *MonteCarloRequest) GetProgressEvery() int32 {
+	if x != nil {
+		return x.ProgressEvery
+	}
+	return 0
+}
+
+func (x *MonteCarloRequest) GetCorrelation() []*Row {
+	if x != nil {
+		return x.Correlation
+	}
+	return nil
+}
+
+// Частка ітерацій, у яких альтернатива була найкращою за критерієм (у порядку альтернатив)
+type CriterionShares struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BestShare     []float64              `protobuf:"fixed64,2,rep,packed,name=best_share,json=bestShare,proto3" json:"best_share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriterionShares) Reset() {
+	*x = CriterionShares{}
+	mi := &file_decisionpb_decision_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriterionShares) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionShares) ProtoMessage() {}
+
+func (x *CriterionShares) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionShares.ProtoReflect.Descriptor instead.
+func (*CriterionShares) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CriterionShares) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CriterionShares) GetBestShare() []float64 {
+	if x != nil {
+		return x.BestShare
+	}
+	return nil
+}
+
+type MonteCarloProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Done          int32                  `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Shares        []*CriterionShares     `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonteCarloProgress) Reset() {
+	*x = MonteCarloProgress{}
+	mi := &file_decisionpb_decision_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonteCarloProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonteCarloProgress) ProtoMessage() {}
+
+func (x *MonteCarloProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_decisionpb_decision_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonteCarloProgress.ProtoReflect.Descriptor instead.
+func (*MonteCarloProgress) Descriptor() ([]byte, []int) {
+	return file_decisionpb_decision_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MonteCarloProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MonteCarloProgress) GetShares() []*CriterionShares {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+var File_decisionpb_decision_proto protoreflect.FileDescriptor
+
+var file_decisionpb_decision_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2f, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x74, 0x70, 0x72,
+	0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0x1d, 0x0a, 0x03,
+	0x52, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0c,
+	0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x8c, 0x01, 0x0a,
+	0x08, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x65, 0x72, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x6d, 0x0a, 0x0f, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
+	0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x88, 0x01, 0x01,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x22, 0x6b, 0x0a, 0x0f, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x0e,
+	0x50, 0x61, 0x72, 0x65, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x74, 0x6f,
+	0x22, 0xa0, 0x02, 0x0a, 0x11, 0x4d, 0x6f, 0x6e, 0x74, 0x65, 0x43, 0x61, 0x72, 0x6c, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x4d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x19, 0x0a,
+	0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x76,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x0b, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x22, 0x44, 0x0a, 0x0f, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65,
+	0x73, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09,
+	0x62, 0x65, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0x78, 0x0a, 0x12, 0x4d, 0x6f, 0x6e,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x6c, 0x6f, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64,
+	0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x70, 0x72, 0x2e,
+	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x32, 0x8f, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x20, 0x2e, 0x74, 0x70, 0x72,
+	0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74,
+	0x70, 0x72, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x50, 0x61, 0x72, 0x65, 0x74, 0x6f,
+	0x12, 0x19, 0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x1f, 0x2e, 0x74, 0x70,
+	0x72, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x65, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0a,
+	0x4d, 0x6f, 0x6e, 0x74, 0x65, 0x43, 0x61, 0x72, 0x6c, 0x6f, 0x12, 0x22, 0x2e, 0x74, 0x70, 0x72,
+	0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x74, 0x70, 0x72, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x74, 0x65, 0x43, 0x61, 0x72, 0x6c, 0x6f, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x30, 0x01, 0x42, 0x10, 0x5a, 0x0e, 0x74, 0x70, 0x72, 0x2f, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_decisionpb_decision_proto_rawDescOnce sync.Once
+	file_decisionpb_decision_proto_rawDescData []byte
+)
+
+func file_decisionpb_decision_proto_rawDescGZIP() []byte {
+	file_decisionpb_decision_proto_rawDescOnce.Do(func() {
+		file_decisionpb_decision_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_decisionpb_decision_proto_rawDesc), len(file_decisionpb_decision_proto_rawDesc)))
+	})
+	return file_decisionpb_decision_proto_rawDescData
+}
+
+var file_decisionpb_decision_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_decisionpb_decision_proto_goTypes = []any{
+	(*Row)(nil),                // 0: tpr.decision.v1.Row
+	(*PayoffMatrix)(nil),       // 1: tpr.decision.v1.PayoffMatrix
+	(*Rankings)(nil),           // 2: tpr.decision.v1.Rankings
+	(*CriteriaRequest)(nil),    // 3: tpr.decision.v1.CriteriaRequest
+	(*CriterionResult)(nil),    // 4: tpr.decision.v1.CriterionResult
+	(*CriteriaResponse)(nil),   // 5: tpr.decision.v1.CriteriaResponse
+	(*ParetoResponse)(nil),     // 6: tpr.decision.v1.ParetoResponse
+	(*MonteCarloRequest)(nil),  // 7: tpr.decision.v1.MonteCarloRequest
+	(*CriterionShares)(nil),    // 8: tpr.decision.v1.CriterionShares
+	(*MonteCarloProgress)(nil), // 9: tpr.decision.v1.MonteCarloProgress
+}
+var file_decisionpb_decision_proto_depIdxs = []int32{
+	0,  // 0: tpr.decision.v1.PayoffMatrix.rows:type_name -> tpr.decision.v1.Row
+	0,  // 1: tpr.decision.v1.Rankings.rows:type_name -> tpr.decision.v1.Row
+	1,  // 2: tpr.decision.v1.CriteriaRequest.matrix:type_name -> tpr.decision.v1.PayoffMatrix
+	4,  // 3: tpr.decision.v1.CriteriaResponse.criteria:type_name -> tpr.decision.v1.CriterionResult
+	1,  // 4: tpr.decision.v1.MonteCarloRequest.matrix:type_name -> tpr.decision.v1.PayoffMatrix
+	0,  // 5: tpr.decision.v1.MonteCarloRequest.correlation:type_name -> tpr.decision.v1.Row
+	8,  // 6: tpr.decision.v1.MonteCarloProgress.shares:type_name -> tpr.decision.v1.CriterionShares
+	3,  // 7: tpr.decision.v1.DecisionService.ComputeCriteria:input_type -> tpr.decision.v1.CriteriaRequest
+	2,  // 8: tpr.decision.v1.DecisionService.ComputePareto:input_type -> tpr.decision.v1.Rankings
+	7,  // 9: tpr.decision.v1.DecisionService.MonteCarlo:input_type -> tpr.decision.v1.MonteCarloRequest
+	5,  // 10: tpr.decision.v1.DecisionService.ComputeCriteria:output_type -> tpr.decision.v1.CriteriaResponse
+	6,  // 11: tpr.decision.v1.DecisionService.ComputePareto:output_type -> tpr.decision.v1.ParetoResponse
+	9,  // 12: tpr.decision.v1.DecisionService.MonteCarlo:output_type -> tpr.decision.v1.MonteCarloProgress
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_decisionpb_decision_proto_init() }
+func file_decisionpb_decision_proto_init() {
+	if File_decisionpb_decision_proto != nil {
+		return
+	}
+	file_decisionpb_decision_proto_msgTypes[3].OneofWrappers = []any{}
+	file_decisionpb_decision_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_decisionpb_decision_proto_rawDesc), len(file_decisionpb_decision_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_decisionpb_decision_proto_goTypes,
+		DependencyIndexes: file_decisionpb_decision_proto_depIdxs,
+		MessageInfos:      file_decisionpb_decision_proto_msgTypes,
+	}.Build()
+	File_decisionpb_decision_proto = out.File
+	file_decisionpb_decision_proto_goTypes = nil
+	file_decisionpb_decision_proto_depIdxs = nil
+}
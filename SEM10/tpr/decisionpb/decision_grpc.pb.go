@@ -0,0 +1,208 @@
+// Повідомлення та сервіс gRPC для обчислень утиліти tpr (tpr grpc).
+// Після зміни файлу код Go генерується заново:
+//   protoc --go_out=. --go_opt=paths=source_relative \
+//          --go-grpc_out=. --go-grpc_opt=paths=source_relative decisionpb/decision.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: decisionpb/decision.proto
+
+package decisionpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DecisionService_ComputeCriteria_FullMethodName = "/tpr.decision.v1.DecisionService/ComputeCriteria"
+	DecisionService_ComputePareto_FullMethodName   = "/tpr.decision.v1.DecisionService/ComputePareto"
+	DecisionService_MonteCarlo_FullMethodName      = "/tpr.decision.v1.DecisionService/MonteCarlo"
+)
+
+// DecisionServiceClient is the client API for DecisionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DecisionServiceClient interface {
+	ComputeCriteria(ctx context.Context, in *CriteriaRequest, opts ...grpc.CallOption) (*CriteriaResponse, error)
+	ComputePareto(ctx context.Context, in *Rankings, opts ...grpc.CallOption) (*ParetoResponse, error)
+	// Аналіз стійкості методом Монте-Карло з потоком проміжних результатів
+	MonteCarlo(ctx context.Context, in *MonteCarloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MonteCarloProgress], error)
+}
+
+type decisionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDecisionServiceClient(cc grpc.ClientConnInterface) DecisionServiceClient {
+	return &decisionServiceClient{cc}
+}
+
+func (c *decisionServiceClient) ComputeCriteria(ctx context.Context, in *CriteriaRequest, opts ...grpc.CallOption) (*CriteriaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CriteriaResponse)
+	err := c.cc.Invoke(ctx, DecisionService_ComputeCriteria_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decisionServiceClient) ComputePareto(ctx context.Context, in *Rankings, opts ...grpc.CallOption) (*ParetoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParetoResponse)
+	err := c.cc.Invoke(ctx, DecisionService_ComputePareto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decisionServiceClient) MonteCarlo(ctx context.Context, in *MonteCarloRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MonteCarloProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DecisionService_ServiceDesc.Streams[0], DecisionService_MonteCarlo_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[MonteCarloRequest, MonteCarloProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DecisionService_MonteCarloClient = grpc.ServerStreamingClient[MonteCarloProgress]
+
+// DecisionServiceServer is the server API for DecisionService service.
+// All implementations must embed UnimplementedDecisionServiceServer
+// for forward compatibility.
+type DecisionServiceServer interface {
+	ComputeCriteria(context.Context, *CriteriaRequest) (*CriteriaResponse, error)
+	ComputePareto(context.Context, *Rankings) (*ParetoResponse, error)
+	// Аналіз стійкості методом Монте-Карло з потоком проміжних результатів
+	MonteCarlo(*MonteCarloRequest, grpc.ServerStreamingServer[MonteCarloProgress]) error
+	mustEmbedUnimplementedDecisionServiceServer()
+}
+
+// UnimplementedDecisionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDecisionServiceServer struct{}
+
+func (UnimplementedDecisionServiceServer) ComputeCriteria(context.Context, *CriteriaRequest) (*CriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeCriteria not implemented")
+}
+func (UnimplementedDecisionServiceServer) ComputePareto(context.Context, *Rankings) (*ParetoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputePareto not implemented")
+}
+func (UnimplementedDecisionServiceServer) MonteCarlo(*MonteCarloRequest, grpc.ServerStreamingServer[MonteCarloProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method MonteCarlo not implemented")
+}
+func (UnimplementedDecisionServiceServer) mustEmbedUnimplementedDecisionServiceServer() {}
+func (UnimplementedDecisionServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeDecisionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DecisionServiceServer will
+// result in compilation errors.
+type UnsafeDecisionServiceServer interface {
+	mustEmbedUnimplementedDecisionServiceServer()
+}
+
+func RegisterDecisionServiceServer(s grpc.ServiceRegistrar, srv DecisionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDecisionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DecisionService_ServiceDesc, srv)
+}
+
+func _DecisionService_ComputeCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CriteriaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecisionServiceServer).ComputeCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DecisionService_ComputeCriteria_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecisionServiceServer).ComputeCriteria(ctx, req.(*CriteriaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecisionService_ComputePareto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rankings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DecisionServiceServer).ComputePareto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DecisionService_ComputePareto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DecisionServiceServer).ComputePareto(ctx, req.(*Rankings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DecisionService_MonteCarlo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonteCarloRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DecisionServiceServer).MonteCarlo(m, &grpc.GenericServerStream[MonteCarloRequest, MonteCarloProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DecisionService_MonteCarloServer = grpc.ServerStreamingServer[MonteCarloProgress]
+
+// DecisionService_ServiceDesc is the grpc.ServiceDesc for DecisionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DecisionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tpr.decision.v1.DecisionService",
+	HandlerType: (*DecisionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ComputeCriteria",
+			Handler:    _DecisionService_ComputeCriteria_Handler,
+		},
+		{
+			MethodName: "ComputePareto",
+			Handler:    _DecisionService_ComputePareto_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "MonteCarlo",
+			Handler:       _DecisionService_MonteCarlo_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "decisionpb/decision.proto",
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	// diffEpsilon – зміни значень критеріїв, менші за цю величину, не вважаються змінами
+	diffEpsilon = 1e-9
+
+	errDiffFiles   = "Вкажіть два файли результатів: tpr diff <старий.json> <новий.json>"
+	errDiffFormat  = "Файл %s не є файлом результатів: %v"
+	errDiffChanges = "Результати відрізняються: змін – %d"
+)
+
+// DiffResults порівнює два результати аналізу і повертає опис змін: ранжувань,
+// значень критеріїв кожної альтернативи та складу множини Парето
+func DiffResults(old, cur *decision.Result) []string {
+	var changes []string
+
+	oldIndex := make(map[string]int)
+	for i, alt := range old.Alternatives {
+		oldIndex[alt] = i
+	}
+	for _, alt := range cur.Alternatives {
+		if _, ok := oldIndex[alt]; !ok {
+			changes = append(changes, fmt.Sprintf("+ альтернатива %s", alt))
+		}
+	}
+	for _, alt := range old.Alternatives {
+		if !slices.Contains(cur.Alternatives, alt) {
+			changes = append(changes, fmt.Sprintf("- альтернатива %s", alt))
+		}
+	}
+
+	for _, c := range cur.Criteria {
+		i := slices.IndexFunc(old.Criteria, func(o decision.CriterionResult) bool { return o.Name == c.Name })
+		if i < 0 {
+			changes = append(changes, fmt.Sprintf("+ критерій %s", c.Name))
+			continue
+		}
+		o := old.Criteria[i]
+		if !slices.Equal(o.Ranking, c.Ranking) {
+			changes = append(changes, fmt.Sprintf("%s: ранжування %s → %s",
+				c.Name, strings.Join(o.Ranking, " ≻ "), strings.Join(c.Ranking, " ≻ ")))
+		}
+		if !slices.Equal(o.Best, c.Best) {
+			changes = append(changes, fmt.Sprintf("%s: найкращі %s → %s",
+				c.Name, strings.Join(o.Best, ", "), strings.Join(c.Best, ", ")))
+		}
+		for k, alt := range cur.Alternatives {
+			j, ok := oldIndex[alt]
+			if !ok || j >= len(o.Values) || k >= len(c.Values) {
+				continue
+			}
+			if delta := c.Values[k] - o.Values[j]; math.Abs(delta) > diffEpsilon {
+				changes = append(changes, fmt.Sprintf("%s(%s): %.4f → %.4f (%+.4f)",
+					c.Name, alt, o.Values[j], c.Values[k], delta))
+			}
+		}
+	}
+	for _, o := range old.Criteria {
+		if !slices.ContainsFunc(cur.Criteria, func(c decision.CriterionResult) bool { return c.Name == o.Name }) {
+			changes = append(changes, fmt.Sprintf("- критерій %s", o.Name))
+		}
+	}
+
+	for _, alt := range cur.Pareto {
+		if !slices.Contains(old.Pareto, alt) {
+			changes = append(changes, fmt.Sprintf("Парето: + %s", alt))
+		}
+	}
+	for _, alt := range old.Pareto {
+		if !slices.Contains(cur.Pareto, alt) {
+			changes = append(changes, fmt.Sprintf("Парето: - %s", alt))
+		}
+	}
+	return changes
+}
+
+// loadResult зчитує файл результатів, записаний командою batch у форматі JSON
+func loadResult(path string) (*decision.Result, error) {
+	rf, err := readResultFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return rf.Result, nil
+}
+
+// runDiff порівнює два файли результатів; як і diff(1), завершується з кодом 1,
+// якщо результати відрізняються, що зручно для перевірки повторних здач у скриптах
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 2 {
+		return fmt.Errorf(errDiffFiles)
+	}
+
+	old, err := loadResult(positional[0])
+	if err != nil {
+		return err
+	}
+	cur, err := loadResult(positional[1])
+	if err != nil {
+		return err
+	}
+
+	changes := DiffResults(old, cur)
+	fmt.Printf("--- %s\n+++ %s\n", positional[0], positional[1])
+	if len(changes) == 0 {
+		fmt.Println("Результати однакові")
+		return nil
+	}
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+	return fmt.Errorf(errDiffChanges, len(changes))
+}
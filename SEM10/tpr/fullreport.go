@@ -0,0 +1,347 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errFullReportFile = "Вкажіть комбінований файл задачі: tpr full-report <задача.json|yaml> [-o звіт.md] [-alpha α]"
+
+	// hurwiczStep – крок α у таблиці чутливості критерію Гурвіца
+	hurwiczStep = 0.1
+	// hurwiczEps – допустима похибка порівняння значень критерію Гурвіца
+	hurwiczEps = 1e-9
+)
+
+// combinedProblem – комбінований файл задачі для tpr full-report: матриця
+// корисності (лабораторні з критеріїв Вальда, Гурвіца, Севіджа, Лапласа) і
+// ранжування експертів (лабораторна з множини Парето) в одному документі
+type combinedProblem struct {
+	Problem  string          `json:"problem"`
+	Alpha    *float64        `json:"alpha"`
+	Payoff   decision.Matrix `json:"payoff"`
+	Rankings decision.Matrix `json:"rankings"`
+}
+
+var combinedSchema = &schema{
+	Type:                 "object",
+	Description:          "комбінована задача: матриця корисності та ранжування експертів",
+	Required:             []string{"payoff", "rankings"},
+	AdditionalProperties: ptr(false),
+	Properties: map[string]*schema{
+		"problem": {Type: "string", Description: "назва задачі для заголовка звіту"},
+		"alpha":   uncertaintySchema.Properties["alpha"],
+		"payoff": {
+			Type:                 "object",
+			Description:          "матриця корисності: values[i][j] – корисність альтернативи i за стану j",
+			Required:             []string{"alternatives", "columns", "values"},
+			AdditionalProperties: ptr(false),
+			Properties:           matrixProperties("назви станів", "рядок значень корисності для кожної альтернативи"),
+		},
+		"rankings": paretoSchema,
+	},
+}
+
+// checkCombined перевіряє комбінований файл задачі (JSON або YAML) за схемою,
+// розмірами обох матриць і рангами експертів; помилки вказують на рядки файлу
+func checkCombined(path string, data []byte) (*combinedProblem, []diagnostic) {
+	lines := fieldLines
+	if formatByExt(path) == formatYAML {
+		js, err := yamlToJSON(data)
+		if err != nil {
+			return nil, []diagnostic{{1, fieldError{Message: fmt.Sprintf(errValidateYAML, err)}}}
+		}
+		source := data
+		data, lines = js, func([]byte) map[string]int { return yamlFieldLines(source) }
+	}
+
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, []diagnostic{{syntaxLine(data), fieldError{Message: fmt.Sprintf(errValidateSyntax, err)}}}
+	}
+	errs := validateValue(raw, combinedSchema, "")
+	var p combinedProblem
+	if len(errs) == 0 {
+		json.Unmarshal(data, &p)
+		prefix := func(field string, fe []fieldError) []fieldError {
+			for i := range fe {
+				fe[i].Field = joinPath(field, fe[i].Field)
+			}
+			return fe
+		}
+		errs = append(errs, prefix("payoff", validateMatrix(&p.Payoff))...)
+		rankErrs := validateMatrix(&p.Rankings)
+		if len(rankErrs) == 0 {
+			rankErrs = validateRanks(&p.Rankings)
+		}
+		errs = append(errs, prefix("rankings", rankErrs)...)
+	}
+	if len(errs) > 0 {
+		return nil, locateErrors(errs, lines(data), 1)
+	}
+	return &p, nil
+}
+
+// hurwiczInterval – відрізок значень α, на якому оптимальні ті самі альтернативи
+type hurwiczInterval struct {
+	from, to float64
+	best     []string
+}
+
+// hurwiczIntervals ділить [0; 1] на відрізки з однаковими оптимальними за
+// Гурвіцом альтернативами. H(a, α) = min + α·(max − min) лінійна за α, тож
+// оптимальна альтернатива може змінитися лише в точці перетину двох прямих.
+func hurwiczIntervals(m *decision.Matrix) []hurwiczInterval {
+	lo, hi := make([]float64, len(m.Values)), make([]float64, len(m.Values))
+	for i, row := range m.Values {
+		lo[i], hi[i] = slices.Min(row), slices.Max(row)
+	}
+	points := []float64{0, 1}
+	for i := range lo {
+		for k := i + 1; k < len(lo); k++ {
+			if d := (hi[i] - lo[i]) - (hi[k] - lo[k]); d != 0 {
+				if a := (lo[k] - lo[i]) / d; a > 0 && a < 1 {
+					points = append(points, a)
+				}
+			}
+		}
+	}
+	sort.Float64s(points)
+	points = slices.Compact(points)
+
+	var out []hurwiczInterval
+	for p := 1; p < len(points); p++ {
+		mid := (points[p-1] + points[p]) / 2
+		best := math.Inf(-1)
+		for i := range lo {
+			best = max(best, lo[i]+mid*(hi[i]-lo[i]))
+		}
+		var alts []string
+		for i, alt := range m.Alternatives {
+			if lo[i]+mid*(hi[i]-lo[i]) >= best-hurwiczEps {
+				alts = append(alts, alt)
+			}
+		}
+		if n := len(out); n > 0 && slices.Equal(out[n-1].best, alts) {
+			out[n-1].to = points[p]
+			continue
+		}
+		out = append(out, hurwiczInterval{points[p-1], points[p], alts})
+	}
+	return out
+}
+
+// criterionByName повертає результат критерію з аналізу за назвою
+func criterionByName(r *decision.Result, name string) decision.CriterionResult {
+	for _, c := range r.Criteria {
+		if c.Name == name {
+			return c
+		}
+	}
+	return decision.CriterionResult{}
+}
+
+// markdownMatrix записує матрицю як таблицю Markdown; format – формат значень
+func markdownMatrix(b *strings.Builder, m *decision.Matrix, format string) {
+	fmt.Fprintf(b, "| Альтернатива | %s |\n", strings.Join(m.Columns, " | "))
+	b.WriteString("| --- |" + strings.Repeat(" ---: |", len(m.Columns)) + "\n")
+	for i, alt := range m.Alternatives {
+		fmt.Fprintf(b, "| %s |", alt)
+		for _, v := range m.Values[i] {
+			fmt.Fprintf(b, " "+format+" |", v)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+}
+
+// markdownCriteria записує значення кількох критеріїв, їх ранжування й оптимальні альтернативи
+func markdownCriteria(b *strings.Builder, alts []string, criteria []decision.CriterionResult, titles []string) {
+	fmt.Fprintf(b, "| Альтернатива | %s |\n", strings.Join(titles, " | "))
+	b.WriteString("| --- |" + strings.Repeat(" ---: |", len(criteria)) + "\n")
+	for i, alt := range alts {
+		fmt.Fprintf(b, "| %s |", alt)
+		for _, c := range criteria {
+			fmt.Fprintf(b, " %.4f |", c.Values[i])
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	for k, c := range criteria {
+		fmt.Fprintf(b, "- **%s**: %s; оптимальна: %s\n", titles[k], strings.Join(c.Ranking, " ≻ "), strings.Join(c.Best, ", "))
+	}
+	b.WriteString("\n")
+}
+
+// rankDominance повертає пари «a домінує b за Парето»: жоден експерт не ставить a
+// нижче за b, а хоча б один ставить вище
+func rankDominance(m *decision.Matrix) []string {
+	var out []string
+	for a := range m.Alternatives {
+		for c := range m.Alternatives {
+			better, worse := false, false
+			for k := range m.Columns {
+				better = better || m.Values[a][k] < m.Values[c][k]
+				worse = worse || m.Values[a][k] > m.Values[c][k]
+			}
+			if better && !worse {
+				out = append(out, m.Alternatives[a]+" ≻ "+m.Alternatives[c])
+			}
+		}
+	}
+	return out
+}
+
+// WriteFullReport записує зведений звіт за структурою підсумкового звіту курсу:
+// вихідні дані, критерії Вальда, maxmax і Гурвіца, чутливість до α, критерії
+// Севіджа й Лапласа, аналіз ранжувань експертів і висновки
+func WriteFullReport(w io.Writer, p *combinedProblem, alpha float64) error {
+	done := logStage("criteria", "problem", p.Problem)
+	payoff := decision.Analyze(&p.Payoff, kindPayoff, alpha)
+	ranking := decision.Analyze(&p.Rankings, kindRanking, alpha)
+	done()
+	logResult(payoff)
+	logResult(ranking)
+	wald, maxmax, hurwicz := criterionByName(payoff, "wald"), criterionByName(payoff, "maxmax"), criterionByName(payoff, "hurwicz")
+	savage, laplace := criterionByName(payoff, "savage"), criterionByName(payoff, "laplace")
+
+	var b strings.Builder
+	title := p.Problem
+	if title == "" {
+		title = "Теорія прийняття рішень"
+	}
+	fmt.Fprintf(&b, "# %s\n\n", title)
+
+	b.WriteString("## 1. Вихідні дані\n\n### Матриця корисності\n\n")
+	markdownMatrix(&b, &p.Payoff, "%g")
+	b.WriteString("### Ранжування експертів\n\n")
+	markdownMatrix(&b, &p.Rankings, "%g")
+
+	fmt.Fprintf(&b, "## 2. Критерії Вальда, maxmax і Гурвіца (α = %g)\n\n", alpha)
+	markdownCriteria(&b, p.Payoff.Alternatives, []decision.CriterionResult{wald, maxmax, hurwicz},
+		[]string{"Вальда", "maxmax", "Гурвіца"})
+
+	done = logStage("hurwicz-sensitivity", "problem", p.Problem)
+	b.WriteString("## 3. Чутливість критерію Гурвіца до α\n\n")
+	fmt.Fprintf(&b, "| α | %s | Оптимальна |\n", strings.Join(p.Payoff.Alternatives, " | "))
+	b.WriteString("| ---: |" + strings.Repeat(" ---: |", len(p.Payoff.Alternatives)) + " --- |\n")
+	for step := 0; step <= int(math.Round(1/hurwiczStep)); step++ {
+		a := float64(step) * hurwiczStep
+		h := criterionByName(decision.Analyze(&p.Payoff, kindPayoff, a), "hurwicz")
+		fmt.Fprintf(&b, "| %.1f |", a)
+		for _, v := range h.Values {
+			fmt.Fprintf(&b, " %.4f |", v)
+		}
+		fmt.Fprintf(&b, " %s |\n", strings.Join(h.Best, ", "))
+	}
+	b.WriteString("\nОптимальні альтернативи за відрізками значень α:\n\n")
+	for _, in := range hurwiczIntervals(&p.Payoff) {
+		fmt.Fprintf(&b, "- α ∈ [%.4f; %.4f]: %s\n", in.from, in.to, strings.Join(in.best, ", "))
+	}
+	b.WriteString("\n")
+	done()
+
+	b.WriteString("## 4. Критерії Севіджа та Лапласа\n\n### Матриця жалю\n\n")
+	markdownMatrix(&b, decision.RegretMatrix(&p.Payoff), "%g")
+	markdownCriteria(&b, p.Payoff.Alternatives, []decision.CriterionResult{savage, laplace},
+		[]string{"Севіджа (макс. жаль)", "Лапласа (середня корисність)"})
+
+	b.WriteString("## 5. Ранжування експертів і множина Парето\n\n")
+	if pairs := rankDominance(&p.Rankings); len(pairs) > 0 {
+		b.WriteString("Домінування за Парето (жоден експерт не ставить першу альтернативу нижче за другу):\n\n")
+		for _, pair := range pairs {
+			fmt.Fprintf(&b, "- %s\n", pair)
+		}
+		b.WriteString("\n")
+	} else {
+		b.WriteString("Жодна альтернатива не домінує над іншою за Парето.\n\n")
+	}
+	fmt.Fprintf(&b, "Множина Парето: %s\n\n", strings.Join(ranking.Pareto, ", "))
+
+	b.WriteString("## 6. Висновки\n\n")
+	b.WriteString("Відстані Кендалла τ між ранжуваннями критеріїв – частка пар альтернатив, які критерії " +
+		"впорядковують протилежно (0 – ранжування збігаються, 1 – обернені):\n\n")
+	kendall := &decision.Result{Alternatives: payoff.Alternatives,
+		Criteria: []decision.CriterionResult{wald, maxmax, hurwicz, savage, laplace}}
+	titles := []string{"Вальда", "maxmax", "Гурвіца", "Севіджа", "Лапласа"}
+	fmt.Fprintf(&b, "| | %s |\n", strings.Join(titles, " | "))
+	b.WriteString("| --- |" + strings.Repeat(" ---: |", len(titles)) + "\n")
+	for i, row := range kendall.KendallDistances() {
+		fmt.Fprintf(&b, "| %s |", titles[i])
+		for _, d := range row {
+			fmt.Fprintf(&b, " %.3f |", d)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	for _, c := range []struct {
+		title string
+		c     decision.CriterionResult
+	}{{"Вальда", wald}, {"maxmax", maxmax}, {fmt.Sprintf("Гурвіца (α = %g)", alpha), hurwicz}, {"Севіджа", savage}, {"Лапласа", laplace}} {
+		fmt.Fprintf(&b, "- За критерієм %s оптимальна альтернатива: %s\n", c.title, strings.Join(c.c.Best, ", "))
+	}
+	fmt.Fprintf(&b, "- Множина Парето матриці корисності: %s\n", strings.Join(payoff.Pareto, ", "))
+	fmt.Fprintf(&b, "- Множина Парето за ранжуваннями експертів: %s\n", strings.Join(ranking.Pareto, ", "))
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func runFullReport(args []string) error {
+	fs := flag.NewFlagSet("full-report", flag.ExitOnError)
+	alpha := fs.Float64("alpha", 0.5, "коефіцієнт оптимізму α для критерію Гурвіца (за замовчуванням – з файлу, інакше 0.5)")
+	output := fs.String("o", "", "файл Markdown для звіту (за замовчуванням – стандартний вивід)")
+	fs.Var(criteriaWorkersFlag{}, "criteria-workers", workersUsage)
+	addLogFlags(fs)
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf(errFullReportFile)
+	}
+
+	path := positional[0]
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	done := logStage("read", "problem", path)
+	p, diags := checkCombined(path, data)
+	done()
+	if len(diags) > 0 {
+		lines := make([]string, len(diags))
+		for i, d := range diags {
+			lines[i] = d.format(path)
+		}
+		return fmt.Errorf(errProblemFormat, path, strings.Join(lines, "\n"))
+	}
+	logProblem(path+" (payoff)", &p.Payoff)
+	logProblem(path+" (rankings)", &p.Rankings)
+
+	alphaSet := false
+	fs.Visit(func(f *flag.Flag) { alphaSet = alphaSet || f.Name == "alpha" })
+	if !alphaSet && p.Alpha != nil {
+		*alpha = *p.Alpha
+	}
+	if err := decision.ValidateAlpha(*alpha); err != nil {
+		return err
+	}
+	logger.Info("параметри звіту", "problem", path, "alpha", *alpha, "criteria_workers", decision.Workers())
+
+	write := func(w io.Writer) error { return WriteFullReport(w, p, *alpha) }
+	if *output == "" {
+		return write(os.Stdout)
+	}
+	if err := saveFile(*output, write); err != nil {
+		return err
+	}
+	fmt.Printf("Звіт збережено у файл %s\n", *output)
+	return nil
+}
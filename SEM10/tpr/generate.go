@@ -0,0 +1,125 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/xuri/excelize/v2"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	kindPayoff  = decision.KindPayoff
+	kindRanking = decision.KindRanking
+
+	errGenerateKind  = "Невідомий тип задачі '%s': потрібен %s або %s"
+	errGenerateCount = "Некоректне число %s: %d"
+)
+
+// Problem – згенерована задача у вигляді таблиці вхідного аркуша Excel:
+// перший рядок – заголовки, далі назва альтернативи та її значення
+type Problem struct {
+	Header []string
+	Rows   [][]any
+}
+
+// GeneratePayoff створює матрицю корисності alts×states з цілими значеннями від 1 до maxScore
+func GeneratePayoff(rng *rand.Rand, alts, states, maxScore int) Problem {
+	p := Problem{Header: []string{"Альтернатива"}}
+	for j := range states {
+		p.Header = append(p.Header, fmt.Sprintf("Стан %d", j+1))
+	}
+	for i := range alts {
+		row := []any{fmt.Sprintf("A%d", i+1)}
+		for range states {
+			row = append(row, 1+rng.IntN(maxScore))
+		}
+		p.Rows = append(p.Rows, row)
+	}
+	return p
+}
+
+// GenerateRanking створює профіль ранжувань: кожен експерт задає випадкову перестановку рангів 1…alts
+func GenerateRanking(rng *rand.Rand, alts, experts int) Problem {
+	p := Problem{Header: []string{"Альтернатива"}}
+	p.Rows = make([][]any, alts)
+	for i := range p.Rows {
+		p.Rows[i] = []any{fmt.Sprintf("A%d", i+1)}
+	}
+	for k := range experts {
+		p.Header = append(p.Header, fmt.Sprintf("Експерт %d", k+1))
+		for i, r := range rng.Perm(alts) {
+			p.Rows[i] = append(p.Rows[i], r+1)
+		}
+	}
+	return p
+}
+
+// SaveXLSX записує задачу на перший аркуш нової книги у форматі, який зчитують програми через -xlsx
+func (p Problem) SaveXLSX(path string) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheet := f.GetSheetName(0)
+	header := make([]any, len(p.Header))
+	for j, h := range p.Header {
+		header[j] = h
+	}
+	if err := f.SetSheetRow(sheet, "A1", &header); err != nil {
+		return err
+	}
+	for i, row := range p.Rows {
+		if err := f.SetSheetRow(sheet, fmt.Sprintf("A%d", i+2), &row); err != nil {
+			return err
+		}
+	}
+	return f.SaveAs(path)
+}
+
+func runGenerate(args []string) error {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	kind := fs.String("kind", kindPayoff, "тип задачі: payoff (матриця корисності, tpr-2/tpr-3) або ranking (ранжування експертів, tpr-4)")
+	alts := fs.Int("alts", 5, "кількість альтернатив")
+	states := fs.Int("states", 4, "кількість станів (для payoff)")
+	experts := fs.Int("experts", 3, "кількість експертів (для ranking)")
+	maxScore := fs.Int("max", 10, "максимальне значення бальної системи (для payoff)")
+	seedOpt := addSeedFlag(fs)
+	output := fs.String("o", "", "файл Excel для збереження задачі (за замовчуванням variant-<seed>.xlsx)")
+	fs.Parse(args)
+	seed := seedOpt.Value()
+
+	if *alts < 2 {
+		return fmt.Errorf(errGenerateCount, "альтернатив", *alts)
+	}
+	rng := newRand(seed)
+
+	var p Problem
+	switch *kind {
+	case kindPayoff:
+		if *states < 1 {
+			return fmt.Errorf(errGenerateCount, "станів", *states)
+		}
+		if *maxScore < 1 {
+			return fmt.Errorf(errGenerateCount, "балів", *maxScore)
+		}
+		p = GeneratePayoff(rng, *alts, *states, *maxScore)
+	case kindRanking:
+		if *experts < 1 {
+			return fmt.Errorf(errGenerateCount, "експертів", *experts)
+		}
+		p = GenerateRanking(rng, *alts, *experts)
+	default:
+		return fmt.Errorf(errGenerateKind, *kind, kindPayoff, kindRanking)
+	}
+
+	if *output == "" {
+		*output = fmt.Sprintf("variant-%d.xlsx", seed)
+	}
+	if err := p.SaveXLSX(*output); err != nil {
+		return err
+	}
+	fmt.Printf("Задачу збережено у файл %s (зерно %d)\n", *output, seed)
+	return nil
+}
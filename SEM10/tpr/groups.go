@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errGroupSyntax = "Некоректна група '%s': потрібно назва=альтернатива,альтернатива, групи через крапку з комою"
+	errGroupName   = "Групу '%s' задано двічі"
+
+	groupsUsage = "групи альтернатив для аналізу кожної групи окремо та порівняння найкращих представників: " +
+		"назва=альтернативи через кому, групи через крапку з комою (Постачальники=A,B;Власні=C); " +
+		"решта альтернатив утворює групу «" + otherGroup + "»"
+
+	// otherGroup – група альтернатив, не віднесених до жодної з заданих груп
+	otherGroup = "Інші"
+)
+
+// parseGroups розбирає прапорець -groups; порожній рядок – без груп
+func parseGroups(spec string) ([]decision.Group, error) {
+	var groups []decision.Group
+	for _, item := range strings.Split(spec, ";") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		name, alts, ok := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		g := decision.Group{Name: name, Alternatives: splitList(alts)}
+		if !ok || name == "" || len(g.Alternatives) == 0 || slices.Contains(g.Alternatives, "") {
+			return nil, fmt.Errorf(errGroupSyntax, item)
+		}
+		if slices.ContainsFunc(groups, func(g decision.Group) bool { return g.Name == name }) {
+			return nil, fmt.Errorf(errGroupName, name)
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+// setGroups перевіряє прапорець -groups і записує його в параметри аналізу
+func (opts *batchOptions) setGroups(spec string) error {
+	groups, err := parseGroups(spec)
+	if err != nil {
+		return err
+	}
+	opts.groups, opts.groupsSpec = groups, spec
+	return nil
+}
+
+// analyzeGroups доповнює результат аналізом кожної групи альтернатив і порівнянням
+// груп за найкращими представниками; m – вихідна матриця, analyzed – проаналізована
+func analyzeGroups(r *decision.Result, m, analyzed *decision.Matrix, kind string, opts batchOptions) error {
+	if len(opts.groups) == 0 {
+		return nil
+	}
+	if err := decision.ValidateGroups(m, opts.groups); err != nil {
+		return err
+	}
+	groups := slices.Clone(opts.groups)
+	var others []string
+	for _, alt := range m.Alternatives {
+		if !slices.ContainsFunc(groups, func(g decision.Group) bool { return slices.Contains(g.Alternatives, alt) }) {
+			others = append(others, alt)
+		}
+	}
+	if len(others) > 0 {
+		groups = append(groups, decision.Group{Name: otherGroup, Alternatives: others})
+	}
+
+	r.Groups = decision.AnalyzeGroups(analyzed, opts.params(kind), groups)
+	for i, g := range r.Groups {
+		sub := &decision.Result{Alternatives: g.Alternatives, Criteria: g.Criteria}
+		if err := addMeta(sub, opts); err != nil {
+			return err
+		}
+		r.Groups[i].Criteria = sub.Criteria
+	}
+	r.GroupBest = decision.BestOfGroups(r, r.Groups)
+	return nil
+}
+
+// printGroups виводить ранжування всередині груп і порівняння груп
+func printGroups(r *decision.Result) {
+	for _, g := range r.Groups {
+		fmt.Printf("\nГрупа «%s»: %s\n", g.Name, strings.Join(g.Alternatives, ", "))
+		for _, c := range g.Criteria {
+			fmt.Printf("  %-8s %s\n", c.Name, strings.Join(c.Ranking, " ≻ "))
+		}
+		fmt.Printf("  Множина Парето: %s\n", strings.Join(g.Pareto, ", "))
+	}
+	if len(r.GroupBest) == 0 {
+		return
+	}
+	fmt.Println("\nПорівняння груп за найкращими альтернативами:")
+	for _, c := range r.GroupBest {
+		fmt.Printf("  %-8s %s\n", c.Name, strings.Join(c.Ranking, " ≻ "))
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"tpr/decisionpb"
+	"tpr/pkg/decision"
+)
+
+const (
+	// grpcDefaultProgress – типовий інтервал проміжних результатів Монте-Карло
+	grpcDefaultProgress = 100
+	// grpcMaxIterations обмежує тривалість одного виклику MonteCarlo
+	grpcMaxIterations = 1_000_000
+
+	errGRPCIterations = "iterations: потрібно від 1 до %d ітерацій"
+	errGRPCDeviation  = "deviation: значення має бути від 0 до 1"
+)
+
+// decisionServer реалізує сервіс DecisionService на основі тих самих обчислень, що й tpr analyze
+type decisionServer struct {
+	decisionpb.UnimplementedDecisionServiceServer
+	// seed – зерно Монте-Карло для запитів без поля seed (tpr grpc -seed)
+	seed uint64
+}
+
+// statusError перетворює помилки перевірки задачі на код InvalidArgument з переліком полів
+func statusError(err error) error {
+	violations := decision.Violations(err)
+	if len(violations) == 0 {
+		return status.Error(codes.Internal, err.Error())
+	}
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		msgs[i] = v.Error()
+	}
+	return status.Error(codes.InvalidArgument, strings.Join(msgs, "; "))
+}
+
+// toMatrix перетворює повідомлення на матрицю
+func toMatrix(alts, columns []string, rows []*decisionpb.Row) *decision.Matrix {
+	m := &decision.Matrix{Alternatives: alts, Columns: columns, Values: make([][]float64, len(rows))}
+	for i, row := range rows {
+		m.Values[i] = row.GetValues()
+	}
+	return m
+}
+
+func requestAlpha(alpha *float64) (float64, error) {
+	if alpha == nil {
+		return 0.5, nil
+	}
+	if err := decision.ValidateAlpha(*alpha); err != nil {
+		return 0, statusError(err)
+	}
+	return *alpha, nil
+}
+
+func (decisionServer) ComputeCriteria(_ context.Context, req *decisionpb.CriteriaRequest) (*decisionpb.CriteriaResponse, error) {
+	p := req.GetMatrix()
+	m := toMatrix(p.GetAlternatives(), p.GetStates(), p.GetRows())
+	if err := m.Validate(); err != nil {
+		return nil, statusError(err)
+	}
+	alpha, err := requestAlpha(req.Alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	r := decision.Analyze(m, kindPayoff, alpha)
+	resp := &decisionpb.CriteriaResponse{Problem: p.GetProblem(), Pareto: r.Pareto}
+	for _, c := range r.Criteria {
+		resp.Criteria = append(resp.Criteria, &decisionpb.CriterionResult{
+			Name: c.Name, Values: c.Values, Ranking: c.Ranking, Best: c.Best,
+		})
+	}
+	return resp, nil
+}
+
+func (decisionServer) ComputePareto(_ context.Context, req *decisionpb.Rankings) (*decisionpb.ParetoResponse, error) {
+	m := toMatrix(req.GetAlternatives(), req.GetExperts(), req.GetRows())
+	if err := m.ValidateRanking(); err != nil {
+		return nil, statusError(err)
+	}
+	r := decision.Analyze(m, kindRanking, 0)
+	return &decisionpb.ParetoResponse{Problem: req.GetProblem(), Pareto: r.Pareto}, nil
+}
+
+func (srv decisionServer) MonteCarlo(req *decisionpb.MonteCarloRequest, stream decisionpb.DecisionService_MonteCarloServer) error {
+	p := req.GetMatrix()
+	m := toMatrix(p.GetAlternatives(), p.GetStates(), p.GetRows())
+	if err := m.Validate(); err != nil {
+		return statusError(err)
+	}
+	alpha, err := requestAlpha(req.Alpha)
+	if err != nil {
+		return err
+	}
+	iterations := int(req.GetIterations())
+	if iterations < 1 || iterations > grpcMaxIterations {
+		return status.Errorf(codes.InvalidArgument, errGRPCIterations, grpcMaxIterations)
+	}
+	if req.GetDeviation() < 0 || req.GetDeviation() > 1 {
+		return status.Error(codes.InvalidArgument, errGRPCDeviation)
+	}
+	var correlation [][]float64
+	for _, row := range req.GetCorrelation() {
+		correlation = append(correlation, row.GetValues())
+	}
+	if correlation != nil {
+		if err := decision.ValidateCorrelation(correlation, len(m.Columns)); err != nil {
+			return statusError(err)
+		}
+	}
+	every := int(req.GetProgressEvery())
+	if every <= 0 {
+		every = grpcDefaultProgress
+	}
+
+	seed := req.GetSeed()
+	if seed == 0 {
+		seed = srv.seed
+	}
+	rng := newRand(seed)
+	// Якщо клієнт скасував виклик або від'єднався, контекст потоку скасовується й обчислення припиняються
+	err = decision.MonteCarlo(stream.Context(), m, alpha, req.GetDeviation(), correlation, iterations, every, rng, func(done int, shares []decision.CriterionShare) error {
+		msg := &decisionpb.MonteCarloProgress{Done: int32(done), Total: int32(iterations)}
+		for _, s := range shares {
+			msg.Shares = append(msg.Shares, &decisionpb.CriterionShares{Name: s.Name, BestShare: s.BestShare})
+		}
+		return stream.Send(msg)
+	})
+	if ctxErr := stream.Context().Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		return status.FromContextError(err).Err()
+	}
+	return err
+}
+
+func runGRPC(args []string) error {
+	fs := flag.NewFlagSet("grpc", flag.ExitOnError)
+	port := fs.Int("port", 9090, "порт gRPC-сервера")
+	fs.Var(criterionFlag{}, "criterion", criterionUsage)
+	fs.Var(scriptFlag{}, "script", scriptUsage)
+	host := fs.String("host", "", "адреса, на якій слухає сервер (за замовчуванням усі інтерфейси)")
+	seed := addSeedFlag(fs)
+	fs.Parse(args)
+
+	if *port < 1 || *port > 65535 {
+		return fmt.Errorf(errServePort, *port)
+	}
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", *host, *port))
+	if err != nil {
+		return err
+	}
+
+	s := grpc.NewServer()
+	decisionpb.RegisterDecisionServiceServer(s, decisionServer{seed: seed.Value()})
+	// Рефлексія дозволяє викликати сервіс з grpcurl без файлу .proto
+	reflection.Register(s)
+	fmt.Printf("gRPC-сервер слухає %s (сервіс tpr.decision.v1.DecisionService, зерно %d)\n", lis.Addr(), seed.Value())
+	return s.Serve(lis)
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+// Формати файлів задач (formatJSON оголошено разом з форматами результатів)
+const (
+	formatXLSX = "xlsx"
+	formatCSV  = "csv"
+	formatYAML = "yaml"
+	formatText = "tpr"
+
+	// inputFormats – підтримувані формати файлів задач для повідомлення про помилку
+	inputFormats = "xlsx (книга Excel), csv, json, yaml, tpr (текстовий формат)"
+
+	errInputFormat   = "%s: не вдалося визначити формат файлу задачі; підтримуються: %s"
+	errProblemFormat = "%s: задача некоректна:\n%s"
+	errCSVHeader     = "перший рядок CSV має містити заголовок: назву стовпця альтернатив і назви станів"
+	errCSVCell       = "альтернатива '%s', стовпець %d: некоректне число '%s'"
+)
+
+// parseCell розбирає число з клітинки таблиці; NaN та ±Inf, які приймає
+// strconv.ParseFloat, вважаються некоректними числами
+func parseCell(s string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err == nil && (math.IsNaN(v) || math.IsInf(v, 0)) {
+		err = decision.ErrNotFinite
+	}
+	return v, err
+}
+
+// utf8BOM – позначка порядку байтів, яку Excel додає на початок CSV у UTF-8
+var utf8BOM = []byte("\ufeff")
+
+// yamlKeyLine – рядок YAML, що починається з ключа відображення ("columns: …")
+var yamlKeyLine = regexp.MustCompile(`^["']?[\p{L}\p{N}_.-]+["']?\s*:(\s|$)`)
+
+// formatByExt визначає формат файлу задачі за розширенням ("" – розширення невідоме)
+func formatByExt(path string) string {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".xlsx", ".csv", ".json", problemTextExt:
+		return ext[1:]
+	case ".yaml", ".yml":
+		return formatYAML
+	}
+	return ""
+}
+
+// detectFormat визначає формат файлу задачі за розширенням, а якщо воно
+// невідоме (.txt, без розширення) – за вмістом
+func detectFormat(path string, data []byte) (string, error) {
+	if format := formatByExt(path); format != "" {
+		return format, nil
+	}
+	if format := sniffFormat(data); format != "" {
+		return format, nil
+	}
+	return "", fmt.Errorf(errInputFormat, path, inputFormats)
+}
+
+// sniffFormat визначає формат за вмістом: книга Excel – ZIP-архів; JSON
+// починається з '{'; текстовий формат – з розділу [states] або «параметр = значення»;
+// YAML – з ключа, елемента списку чи «---»; CSV – з рядка значень через кому,
+// крапку з комою або табуляцію. Коментарі (#) і порожні рядки пропускаються.
+func sniffFormat(data []byte) string {
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return formatXLSX
+	}
+	sc := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(data, utf8BOM)))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "{"):
+			return formatJSON
+		case strings.HasPrefix(line, "[") || strings.Contains(line, "="):
+			return formatText
+		case line == "---" || strings.HasPrefix(line, "- ") || yamlKeyLine.MatchString(line):
+			return formatYAML
+		case strings.ContainsAny(line, ",;\t"):
+			return formatCSV
+		}
+		return ""
+	}
+	return ""
+}
+
+// problemChecker повертає розбір і повну перевірку файлу задачі формату format
+// (крім книги Excel, яку зчитує readMatrix)
+func problemChecker(format string) func(data []byte) (*problemFile, []diagnostic) {
+	return map[string]func([]byte) (*problemFile, []diagnostic){
+		formatJSON: checkProblemJSON,
+		formatYAML: checkProblemYAML,
+		formatCSV:  checkProblemCSV,
+		formatText: checkProblemText,
+	}[format]
+}
+
+// readProblem зчитує файл задачі будь-якого підтримуваного формату. Книга
+// Excel зчитується функцією readXLSX, решта форматів повністю перевіряються,
+// і помилка перелічує всі проблеми у форматі «файл:рядок: повідомлення», як tpr validate.
+func readProblem(path string, readXLSX func() (*decision.Matrix, error)) (*problemFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	format, err := detectFormat(path, data)
+	if err != nil {
+		return nil, err
+	}
+	if format == formatXLSX {
+		m, err := readXLSX()
+		if err != nil {
+			return nil, err
+		}
+		return &problemFile{Matrix: *m}, nil
+	}
+
+	p, diags := problemChecker(format)(data)
+	if len(diags) == 0 {
+		return p, nil
+	}
+	lines := make([]string, len(diags))
+	for i, d := range diags {
+		lines[i] = d.format(path)
+	}
+	return nil, fmt.Errorf(errProblemFormat, path, strings.Join(lines, "\n"))
+}
+
+// newCSVReader створює читач CSV з роздільником за рядком заголовка:
+// крапка з комою або табуляція, якщо вони в ньому є, інакше кома
+func newCSVReader(r io.Reader, header []byte) *csv.Reader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	switch {
+	case bytes.ContainsRune(header, ';'):
+		cr.Comma = ';'
+	case bytes.ContainsRune(header, '\t'):
+		cr.Comma = '\t'
+	}
+	return cr
+}
+
+// checkProblemCSV розбирає матрицю у форматі CSV: заголовок (назва стовпця
+// альтернатив, далі стани), потім рядок на альтернативу. Роздільник – кома,
+// крапка з комою або табуляція (за першим рядком); з крапкою з комою, як у
+// CSV з української локалі Excel, допускається десяткова кома.
+func checkProblemCSV(data []byte) (*problemFile, []diagnostic) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	r := newCSVReader(bytes.NewReader(data), header)
+
+	p := &problemFile{}
+	lines := map[string]int{"": 1, "columns": 1}
+	var diags []diagnostic
+	for first := true; ; first = false {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			line := 1
+			var pe *csv.ParseError
+			if errors.As(err, &pe) {
+				line = pe.Line
+			}
+			return p, []diagnostic{{line, fieldError{Message: err.Error()}}}
+		}
+		line, _ := r.FieldPos(0)
+		if first {
+			if len(record) < 2 {
+				return p, []diagnostic{{line, fieldError{Message: errCSVHeader}}}
+			}
+			p.Columns = record[1:]
+			for j := range p.Columns {
+				lines[fmt.Sprintf("columns[%d]", j)] = line
+			}
+			continue
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		i := len(p.Alternatives)
+		row := make([]float64, len(record)-1)
+		for j, cell := range record[1:] {
+			if r.Comma == ';' {
+				cell = strings.Replace(cell, ",", ".", 1)
+			}
+			v, err := parseCell(cell)
+			if err != nil {
+				diags = append(diags, diagnostic{line, fieldError{Message: fmt.Sprintf(errCSVCell, record[0], j+2, cell)}})
+			}
+			row[j] = v
+			lines[fmt.Sprintf("values[%d][%d]", i, j)] = line
+		}
+		p.Alternatives = append(p.Alternatives, strings.TrimSpace(record[0]))
+		p.Values = append(p.Values, row)
+		lines[fmt.Sprintf("alternatives[%d]", i)] = line
+		lines[fmt.Sprintf("values[%d]", i)] = line
+	}
+	if len(diags) > 0 {
+		return p, diags
+	}
+	return p, locateErrors(validateProblemFields(p), lines, 1)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"tpr/pkg/decision"
+)
+
+// checkParsed перевіряє, що задача, яку парсер прийняв без помилок, має
+// узгоджену форму, зберігається в JSON без змін і аналізується без паніки
+func checkParsed(t *testing.T, p *problemFile, diags []diagnostic) {
+	t.Helper()
+	if len(diags) > 0 {
+		for _, d := range diags {
+			if d.line < 1 {
+				t.Fatalf("помилка без номера рядка: %+v", d)
+			}
+		}
+		return
+	}
+	m := &p.Matrix
+	if len(m.Alternatives) == 0 || len(m.Columns) == 0 || len(m.Values) != len(m.Alternatives) {
+		t.Fatalf("прийнято некоректну матрицю: %+v", m)
+	}
+	for i, row := range m.Values {
+		if len(row) != len(m.Columns) {
+			t.Fatalf("рядок %d: %d значень замість %d", i, len(row), len(m.Columns))
+		}
+		for j, v := range row {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				t.Fatalf("values[%d][%d]: прийнято нескінченне значення %v", i, j, v)
+			}
+		}
+	}
+
+	// Прийнята задача в будь-якому форматі має проходити перевірку як JSON і зчитуватися назад без змін
+	saved, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("задачу не збережено в JSON: %v", err)
+	}
+	back, diags := checkProblemJSON(saved)
+	if len(diags) > 0 {
+		t.Fatalf("збережену задачу не прийнято: %+v\n%s", diags, saved)
+	}
+	if again, _ := json.Marshal(back); !bytes.Equal(again, saved) {
+		t.Fatalf("зчитано іншу задачу:\n%s\n%s", saved, again)
+	}
+	decision.Analyze(m, decision.KindPayoff, 0.5)
+}
+
+func FuzzProblemCSV(f *testing.F) {
+	f.Add([]byte("Альтернатива,s1,s2\nA,1,2\nB,3,4\n"))
+	f.Add([]byte("\ufeffАльтернатива;s1;s2\nA;1,5;2\nB;3;4,25\n"))
+	f.Add([]byte("A\ts1\nx\t1\n\n"))
+	f.Add([]byte("A,s1\n\"x,1\n"))
+	f.Add([]byte("A,s1,s2\nx,NaN,-Inf\n"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, diags := checkProblemCSV(data)
+		checkParsed(t, p, diags)
+	})
+}
+
+func FuzzProblemJSON(f *testing.F) {
+	f.Add([]byte(`{"problem":"p","kind":"payoff","alternatives":["A","B"],"columns":["s1"],"values":[[1],[2]]}`))
+	f.Add([]byte(`{"alternatives":["A"],"columns":["s1","s2"],"values":[[1]],"probabilities":[0.5,0.5],"alpha":2}`))
+	f.Add([]byte(`{"alternatives":[`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, diags := checkProblemJSON(data)
+		checkParsed(t, p, diags)
+	})
+}
+
+func FuzzProblemYAML(f *testing.F) {
+	f.Add([]byte("alternatives: [A, B]\ncolumns: [s1]\nvalues:\n  - [1]\n  - [2]\n"))
+	f.Add([]byte("x-row: &r [1, 2]\nalternatives: [A]\ncolumns: [s1, s2]\nvalues: [*r]\n"))
+	f.Add([]byte("a: &a\n  <<: *a\n"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, diags := checkProblemYAML(data)
+		checkParsed(t, p, diags)
+	})
+}
+
+func FuzzProblemText(f *testing.F) {
+	f.Add([]byte("problem = Посів\nkind = payoff\nalpha = 0.6\n\n[states]\nПосуха = 0.2\nНорма = 0.8\n\n[matrix]\nПшениця: 10 20\nКукурудза: 5 40\n"))
+	f.Add([]byte("[experts]\nE1\nE2\n[matrix]\nA: 1 2 # коментар\nB: 2 1\n"))
+	f.Add([]byte("[matrix]\n:\n[states]\n= \n"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		p, diags := checkProblemText(data)
+		checkParsed(t, p, diags)
+	})
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errLexEmpty = "Вкажіть стани для лексикографічного критерію через кому від найважливішого, наприклад -lex Посуха,Норма"
+	errLexDup   = "Стан '%s' повторюється в переліку -lex"
+	errLexState = "Стану '%s' з переліку -lex немає в матриці; стани: %s"
+
+	lexUsage = "лексикографічний критерій lex: стани через кому від найважливішого; альтернативи порівнюються " +
+		"за першим станом, за рівності – за наступним"
+)
+
+// lexPriority – стани лексикографічного критерію з прапорця -lex; записуються
+// в параметри запуску, щоб tpr verify міг відтворити критерій
+var lexPriority []string
+
+// lexFlag реєструє лексикографічний критерій для прапорця -lex
+type lexFlag struct{}
+
+func (lexFlag) String() string { return "" }
+
+func (lexFlag) Set(value string) error {
+	return registerLexicographic(splitList(value))
+}
+
+// registerLexicographic перевіряє перелік станів і реєструє лексикографічний критерій
+func registerLexicographic(priority []string) error {
+	if len(priority) == 0 || slices.Contains(priority, "") {
+		return fmt.Errorf(errLexEmpty)
+	}
+	for i, state := range priority {
+		if slices.Contains(priority[:i], state) {
+			return fmt.Errorf(errLexDup, state)
+		}
+	}
+	if err := decision.RegisterLexicographic(priority); err != nil {
+		return err
+	}
+	lexPriority = priority
+	return nil
+}
+
+// checkLexicographic перевіряє, що всі стани лексикографічного критерію є в матриці
+func checkLexicographic(columns []string) error {
+	for _, state := range lexPriority {
+		if !slices.Contains(columns, state) {
+			return fmt.Errorf(errLexState, state, strings.Join(columns, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	verboseUsage     = "журнал роботи: зчитані задачі, параметри аналізу й тривалість кожного етапу обчислень"
+	veryVerboseUsage = "докладний журнал: як -v, а також значення матриці, критеріїв і ранжування для кожної задачі"
+	logFileUsage     = "файл, у кінець якого дописується журнал -v/-vv (за замовчуванням стандартний потік помилок)"
+
+	// logOff – рівень, вищий за будь-який запис журналу: без -v/-vv журнал вимкнено
+	logOff = slog.LevelError + 1
+)
+
+var (
+	// logLevel – рівень журналу: -v вмикає Info, -vv – Debug
+	logLevel = new(slog.LevelVar)
+	logger   = newLogger(os.Stderr)
+)
+
+func init() { logLevel.Set(logOff) }
+
+// newLogger створює журнал у текстовому форматі key=value, що записується у w
+func newLogger(w io.Writer) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: logLevel}))
+}
+
+// addLogFlags реєструє прапорці журналу -v, -vv та -log
+func addLogFlags(fs *flag.FlagSet) {
+	fs.Var(verbosityFlag(slog.LevelInfo), "v", verboseUsage)
+	fs.Var(verbosityFlag(slog.LevelDebug), "vv", veryVerboseUsage)
+	fs.Var(logFileFlag{}, "log", logFileUsage)
+}
+
+// verbosityFlag – булевий прапорець, що знижує рівень журналу до свого значення
+type verbosityFlag slog.Level
+
+func (verbosityFlag) String() string   { return "" }
+func (verbosityFlag) IsBoolFlag() bool { return true }
+
+func (f verbosityFlag) Set(value string) error {
+	on, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+	if on && slog.Level(f) < logLevel.Level() {
+		logLevel.Set(slog.Level(f))
+	}
+	return nil
+}
+
+// logFileFlag перенаправляє журнал у файл (-log)
+type logFileFlag struct{}
+
+func (logFileFlag) String() string { return "" }
+
+func (logFileFlag) Set(path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	logger = newLogger(f)
+	return nil
+}
+
+// logStage записує початок етапу обчислень і повертає функцію, яка записує
+// його тривалість; використовується як defer logStage("етап", ...)()
+func logStage(stage string, args ...any) func() {
+	start := time.Now()
+	logger.Debug("початок етапу", append([]any{"stage", stage}, args...)...)
+	return func() {
+		logger.Info("етап завершено", append([]any{"stage", stage, "duration", time.Since(start)}, args...)...)
+	}
+}
+
+// logProblem записує розмір зчитаної задачі, а на рівні -vv – і саму матрицю
+func logProblem(name string, m *decision.Matrix) {
+	logger.Info("задачу зчитано", "problem", name, "alternatives", len(m.Alternatives), "columns", len(m.Columns))
+	logger.Debug("матриця задачі", "problem", name, "alternatives", m.Alternatives, "columns", m.Columns, "values", m.Values)
+}
+
+// logResult записує значення й ранжування кожного критерію та множину Парето (-vv)
+func logResult(r *decision.Result) {
+	for _, c := range r.Criteria {
+		logger.Debug("критерій", "problem", r.Problem, "criterion", c.Name, "values", c.Values, "ranking", c.Ranking, "best", c.Best)
+	}
+	logger.Debug("множина Парето", "problem", r.Problem, "pareto", r.Pareto)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const (
+	usage = `Використання: tpr <команда> [прапорці]
+
+Команди:
+  generate     згенерувати випадкову задачу (матрицю корисності або ранжування експертів)
+  template     створити порожній шаблон файлу задачі (csv, json, yaml, tpr, xlsx) потрібної форми
+  analyze      проаналізувати задачу з файлу (-watch – перераховувати після кожної зміни)
+  batch        обробити всі задачі з каталогу та скласти зведений індекс результатів
+  full-report  скласти зведений звіт курсу з комбінованого файлу: матриця корисності та ранжування експертів
+  stream       обчислити критерії Вальда, maxmax, Лапласа та Севіджа для великого CSV, не завантажуючи його в пам'ять
+  scenarios    порівняти найкращі альтернативи за кількома сценаріями задачі (add, remove – змінити набір)
+  diff         порівняти два файли результатів: ранжування, значення критеріїв, множину Парето
+  transpose    транспонувати матрицю: стани (експерти) стають рядками, альтернативи – стовпцями
+  reshape      перейменувати й переставити альтернативи та стани
+  merge        об'єднати дві матриці за альтернативами (нові стани) або за станами (нові альтернативи)
+  normalize    нормалізувати матрицю корисності (minmax, max, sum, vector, rank) і зберегти результат
+  reversal     перевірити rank reversal: видаляти по одній альтернативі й порівнювати ранжування
+  serve        запустити HTTP-сервер з вебінтерфейсом і REST API для задач у форматі JSON
+  grpc         запустити gRPC-сервер з тими самими обчисленнями та аналізом Монте-Карло
+  schema       вивести JSON Schema формату задачі (problem), результатів (result), комбінованої задачі (full-report) або сценаріїв (scenarios)
+  validate     перевірити файл задачі (JSON, YAML, CSV або текстовий формат .tpr) і вивести всі помилки з номерами рядків
+  verify       перевірити файл результатів за маніфестом: хеш вхідних даних і повторний аналіз
+  history      показати останні запуски, збережені з прапорцем -db
+  show         показати збережений запуск: вхідні дані, параметри та результати
+
+Довідка щодо прапорців команди: tpr <команда> -h
+`
+
+	errUnknownCommand = "Невідома команда '%s'\n\n"
+)
+
+// command – підкоманда утиліти; run отримує аргументи після назви команди
+type command struct {
+	name string
+	run  func(args []string) error
+}
+
+var commands = []command{
+	{"generate", runGenerate},
+	{"template", runTemplate},
+	{"analyze", runAnalyze},
+	{"batch", runBatch},
+	{"full-report", runFullReport},
+	{"stream", runStream},
+	{"scenarios", runScenarios},
+	{"diff", runDiff},
+	{"transpose", runTranspose},
+	{"reshape", runReshape},
+	{"merge", runMerge},
+	{"normalize", runNormalize},
+	{"reversal", runReversal},
+	{"serve", runServe},
+	{"grpc", runGRPC},
+	{"schema", runSchema},
+	{"validate", runValidate},
+	{"verify", runVerify},
+	{"history", runHistory},
+	{"show", runShow},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	for _, c := range commands {
+		if c.name == name {
+			if err := c.run(os.Args[2:]); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	if name != "-h" && name != "--help" && name != "help" {
+		fmt.Printf(errUnknownCommand, name)
+	}
+	fmt.Print(usage)
+	os.Exit(2)
+}
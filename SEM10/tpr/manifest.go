@@ -0,0 +1,196 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errVerifyFiles    = "Вкажіть файл результатів: tpr verify <результат.json> [вхідний файл]"
+	errVerifyManifest = "Файл %s не містить маніфесту: його записує tpr batch -format json"
+	errVerifyHash     = "Вхідні дані змінено: SHA-256 файлу %s – %s, а в маніфесті – %s"
+	errVerifyResult   = "Вхідні дані ті самі, але результат відрізняється від повторного аналізу: змін – %d"
+	errVerifyScript   = "Скрипт критерію змінено: SHA-256 файлу %s – %s, а в маніфесті – %s"
+	errVerifyNoScript = "Маніфест не містить SHA-256 скрипту %s: запишіть результат заново командою tpr batch"
+)
+
+// version – версія утиліти; для релізу задається під час збірки:
+// go build -ldflags "-X main.version=v1.2.0"
+var version = ""
+
+type (
+	// Manifest описує, з яких даних і з якими параметрами отримано результат,
+	// щоб файл результатів можна було перевірити за вхідними даними
+	Manifest struct {
+		Tool        string    `json:"tool"`
+		Version     string    `json:"version"`
+		Created     time.Time `json:"created"`
+		Input       string    `json:"input"`
+		InputSHA256 string    `json:"input_sha256"`
+		Params      runParams `json:"params"`
+		// ScriptsSHA256 – SHA-256 скриптів критеріїв за шляхами з Params.Scripts
+		ScriptsSHA256 map[string]string `json:"scripts_sha256,omitempty"`
+	}
+
+	// resultFile – файл результатів batch: результат аналізу разом із маніфестом
+	resultFile struct {
+		SchemaVersion int `json:"schema_version"`
+		*decision.Result
+		Manifest *Manifest `json:"manifest,omitempty"`
+	}
+)
+
+// toolVersion повертає версію з -ldflags, а без неї – версію модуля
+// або ревізію git, які Go вбудовує у виконуваний файл
+func toolVersion() string {
+	if version != "" {
+		return version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+	for _, s := range info.Settings {
+		if s.Key == "vcs.revision" && len(s.Value) >= 12 {
+			return "dev+" + s.Value[:12]
+		}
+	}
+	return "dev"
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func newManifest(path string, params runParams) (*Manifest, error) {
+	sum, err := fileSHA256(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{
+		Tool:        "tpr",
+		Version:     toolVersion(),
+		Created:     time.Now().UTC().Truncate(time.Second),
+		Input:       filepath.Base(path),
+		InputSHA256: sum,
+		Params:      params,
+	}
+	for _, script := range params.Scripts {
+		if m.ScriptsSHA256 == nil {
+			m.ScriptsSHA256 = make(map[string]string, len(params.Scripts))
+		}
+		if m.ScriptsSHA256[script], err = fileSHA256(script); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// runVerify перевіряє файл результатів: SHA-256 вхідного файлу та скриптів критеріїв
+// має збігатися з маніфестом, а повторний аналіз з тими самими параметрами – дати той самий результат
+func runVerify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	positional := parseInterspersed(fs, args)
+	if len(positional) < 1 || len(positional) > 2 {
+		return fmt.Errorf(errVerifyFiles)
+	}
+
+	saved, err := readResultFile(positional[0])
+	if err != nil {
+		return err
+	}
+	m := saved.Manifest
+	if m == nil {
+		return fmt.Errorf(errVerifyManifest, positional[0])
+	}
+
+	// За замовчуванням вхідний файл шукається поруч із каталогом результатів, куди його кладе batch
+	input := filepath.Join(filepath.Dir(positional[0]), "..", m.Input)
+	if len(positional) == 2 {
+		input = positional[1]
+	}
+	sum, err := fileSHA256(input)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Маніфест: %s %s, створено %s, параметри: тип %s, α = %.2f\n",
+		m.Tool, m.Version, m.Created.Local().Format("02.01.2006 15:04:05"), m.Params.Kind, m.Params.Alpha)
+	if sum != m.InputSHA256 {
+		return fmt.Errorf(errVerifyHash, input, sum, m.InputSHA256)
+	}
+	fmt.Printf("SHA-256 вхідного файлу %s збігається\n", input)
+
+	for _, def := range m.Params.Criteria {
+		if err := registerCriterion(def); err != nil {
+			return err
+		}
+	}
+	// Скрипт міг змінитися за тим самим шляхом, тож перед реєстрацією звіряється його вміст
+	for _, path := range m.Params.Scripts {
+		want, ok := m.ScriptsSHA256[path]
+		if !ok {
+			return fmt.Errorf(errVerifyNoScript, path)
+		}
+		sum, err := fileSHA256(path)
+		if err != nil {
+			return err
+		}
+		if sum != want {
+			return fmt.Errorf(errVerifyScript, path, sum, want)
+		}
+		fmt.Printf("SHA-256 скрипту %s збігається\n", path)
+		if err := registerScript(path); err != nil {
+			return err
+		}
+	}
+	if len(m.Params.Lexicographic) > 0 {
+		if err := registerLexicographic(m.Params.Lexicographic); err != nil {
+			return err
+		}
+	}
+
+	opts := batchOptions{kind: m.Params.Kind, alpha: m.Params.Alpha, sheet: m.Params.Sheet, normalize: m.Params.Normalize,
+		rate: m.Params.Rate, aspiration: m.Params.Aspiration, aspirationMode: m.Params.AspirationMode, exact: m.Params.Exact}
+	if err := opts.setGroups(m.Params.Groups); err != nil {
+		return err
+	}
+	if m.Params.Meta != "" {
+		if err := opts.setMeta(m.Params.Meta, m.Params.MetaNormalize); err != nil {
+			return err
+		}
+	}
+	cur, err := analyzeFile(input, opts)
+	if err != nil {
+		return err
+	}
+	cur.Problem = saved.Problem
+	if changes := DiffResults(saved.Result, cur); len(changes) > 0 {
+		for _, c := range changes {
+			fmt.Println(c)
+		}
+		return fmt.Errorf(errVerifyResult, len(changes))
+	}
+	fmt.Println("Результат відтворюється з вхідних даних")
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errMatrixEmpty     = "%s: аркуш '%s' не містить матриці: потрібен рядок заголовків і хоча б одна альтернатива"
+	errMatrixDuplicate = "%s: альтернатива '%s' повторюється"
+	errMatrixCell      = "%s: аркуш '%s', клітинка %s: некоректне число '%s'"
+	errMatrixFlows     = "%s: аркуш '%s', клітинка %s: грошові потоки за періодами враховуються лише в tpr analyze і tpr batch"
+
+	rateUsage = "ставка дисконтування для клітинок з грошовими потоками за періодами 0, 1, …, n через крапку з комою " +
+		"(\"-100; 30; 40; 50\"): значенням клітинки стає NPV"
+
+	// cashFlowSep розділяє грошові потоки за періодами в одній клітинці: "-100; 30; 40; 50"
+	cashFlowSep = ";"
+)
+
+// loadMatrix зчитує матрицю з аркуша книги Excel (за замовчуванням першого)
+// або з файлу задачі іншого формату (CSV, JSON, YAML, .tpr – див. detectFormat)
+func loadMatrix(path, sheet string) (*decision.Matrix, error) {
+	p, err := readProblem(path, func() (*decision.Matrix, error) {
+		m, _, err := readMatrix(path, sheet, nil)
+		return m, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.Matrix, nil
+}
+
+// loadProblem зчитує задачу для аналізу: з файлу задачі – разом з типом задачі,
+// з книги Excel – лише матрицю, у клітинках якої можуть бути грошові потоки (див. loadCashFlowMatrix)
+func loadProblem(path, sheet string, rate float64) (*problemFile, error) {
+	defer logStage("read", "problem", path)()
+	p, err := readProblem(path, func() (*decision.Matrix, error) {
+		m, _, err := loadCashFlowMatrix(path, sheet, rate)
+		return m, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	logProblem(path, &p.Matrix)
+	return p, nil
+}
+
+// loadCashFlowMatrix зчитує матрицю, у клітинках якої можуть бути грошові потоки
+// за періодами 0, 1, …, n через крапку з комою; такі клітинки замінюються чистою
+// приведеною вартістю за ставкою rate. flows повідомляє, чи були такі клітинки.
+func loadCashFlowMatrix(path, sheet string, rate float64) (m *decision.Matrix, flows bool, err error) {
+	return readMatrix(path, sheet, func(cells []string) (float64, error) {
+		values := make([]float64, len(cells))
+		for t, cell := range cells {
+			v, err := parseCell(cell)
+			if err != nil {
+				return 0, err
+			}
+			values[t] = v
+		}
+		return decision.NPV(values, rate), nil
+	})
+}
+
+// readMatrix зчитує матрицю з аркуша; npv обчислює значення клітинки з кількома
+// грошовими потоками (nil – такі клітинки є помилкою)
+func readMatrix(path, sheet string, npv func(cells []string) (float64, error)) (*decision.Matrix, bool, error) {
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rows) < 2 || len(rows[0]) < 2 {
+		return nil, false, fmt.Errorf(errMatrixEmpty, path, sheet)
+	}
+
+	m := &decision.Matrix{Columns: rows[0][1:]}
+	flows := false
+	for i, row := range rows[1:] {
+		if len(row) == 0 || row[0] == "" {
+			continue
+		}
+		if slices.Contains(m.Alternatives, row[0]) {
+			return nil, false, fmt.Errorf(errMatrixDuplicate, path, row[0])
+		}
+
+		values := make([]float64, len(m.Columns))
+		for j := range values {
+			cell := ""
+			if j+1 < len(row) {
+				cell = row[j+1]
+			}
+			name, _ := excelize.CoordinatesToCellName(j+2, i+2)
+			if parts := strings.Split(cell, cashFlowSep); len(parts) > 1 {
+				if npv == nil {
+					return nil, false, fmt.Errorf(errMatrixFlows, path, sheet, name)
+				}
+				if values[j], err = npv(parts); err != nil {
+					return nil, false, fmt.Errorf(errMatrixCell, path, sheet, name, cell)
+				}
+				flows = true
+				continue
+			}
+			if values[j], err = parseCell(cell); err != nil {
+				return nil, false, fmt.Errorf(errMatrixCell, path, sheet, name, cell)
+			}
+		}
+		m.Alternatives = append(m.Alternatives, row[0])
+		m.Values = append(m.Values, values)
+	}
+	if len(m.Alternatives) == 0 {
+		return nil, false, fmt.Errorf(errMatrixEmpty, path, sheet)
+	}
+	return m, flows, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errMetaWeight = "Некоректна вага '%s': потрібно критерій=вага, наприклад wald=0.5,savage=0.3,laplace=0.2"
+
+	metaUsage          = "зважена метаоцінка за критеріями: критерій=вага через кому, наприклад wald=0.5,savage=0.3,laplace=0.2"
+	metaNormalizeUsage = "нормалізація шкал критеріїв для -meta: minmax, max, sum, vector або rank"
+)
+
+// parseWeights розбирає ваги "критерій=вага" через кому; порожній рядок – без метаоцінки
+func parseWeights(s string) (map[string]float64, error) {
+	pairs := splitList(s)
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]float64, len(pairs))
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		w, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if !ok || name == "" || err != nil {
+			return nil, fmt.Errorf(errMetaWeight, pair)
+		}
+		out[name] = w
+	}
+	return out, nil
+}
+
+// setMeta перевіряє прапорці -meta та -meta-normalize і записує їх у параметри аналізу
+func (opts *batchOptions) setMeta(spec, method string) error {
+	if err := checkNormalize(method); err != nil {
+		return err
+	}
+	weights, err := parseWeights(spec)
+	if err != nil {
+		return err
+	}
+	opts.meta, opts.metaSpec, opts.metaNormalize = weights, spec, method
+	return nil
+}
+
+// addMeta дописує до результату зважену метаоцінку, якщо задано ваги
+func addMeta(r *decision.Result, opts batchOptions) error {
+	if opts.meta == nil {
+		return nil
+	}
+	meta, err := decision.MetaRanking(r, opts.meta, opts.metaNormalize)
+	if err != nil {
+		return fmt.Errorf("метаоцінка: %w", err)
+	}
+	r.Criteria = append(r.Criteria, meta)
+	return nil
+}
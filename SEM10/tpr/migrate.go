@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"tpr/pkg/decision"
+)
+
+// resultSchemaVersion – поточна версія формату результатів (поле schema_version
+// у файлах tpr batch і tpr analyze -stdin-json та в базі -db). Версії:
+//
+//	1 – результати без schema_version; порожні переліки могли записуватися як null;
+//	2 – поле schema_version, порожні переліки – [].
+//
+// Після зміни формату версія збільшується, а в resultMigrations додається
+// перетворення з попередньої, щоб tpr diff, tpr verify і tpr show читали старі результати.
+const resultSchemaVersion = 2
+
+const (
+	errSchemaVersion = "некоректна версія формату schema_version: %v"
+	errSchemaNewer   = "формат результатів версії %d новіший за підтримуваний (%d): оновіть tpr"
+)
+
+// resultMigrations[v] перетворює розібраний документ результатів версії v на версію v+1
+var resultMigrations = map[int]func(doc map[string]any){
+	1: migrateResultV1,
+}
+
+// migrateResultV1 замінює null у переліках результату й критеріїв порожніми масивами
+func migrateResultV1(doc map[string]any) {
+	emptyNull := func(obj map[string]any, keys ...string) {
+		for _, k := range keys {
+			if v, ok := obj[k]; ok && v == nil {
+				obj[k] = []any{}
+			}
+		}
+	}
+	emptyNull(doc, "alternatives", "columns", "pareto")
+	criteria, _ := doc["criteria"].([]any)
+	for _, c := range criteria {
+		if c, ok := c.(map[string]any); ok {
+			emptyNull(c, "values", "ranking", "best")
+		}
+	}
+}
+
+// migrateResult переводить документ результатів до поточної версії формату;
+// документ без schema_version має версію 1
+func migrateResult(doc map[string]any) error {
+	version := 1
+	if v, ok := doc["schema_version"]; ok {
+		f, isNum := v.(float64)
+		if !isNum || f != math.Trunc(f) || f < 1 {
+			return fmt.Errorf(errSchemaVersion, v)
+		}
+		version = int(f)
+	}
+	if version > resultSchemaVersion {
+		return fmt.Errorf(errSchemaNewer, version, resultSchemaVersion)
+	}
+	for ; version < resultSchemaVersion; version++ {
+		resultMigrations[version](doc)
+	}
+	doc["schema_version"] = float64(resultSchemaVersion)
+	return nil
+}
+
+// decodeResult розбирає результати будь-якої підтримуваної версії: документ
+// переводиться до поточної версії й перевіряється за схемою result, а рядки
+// помилок визначаються за вихідним документом
+func decodeResult(data []byte) (*resultFile, []diagnostic, error) {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, []diagnostic{{syntaxLine(data), fieldError{Message: fmt.Sprintf(errValidateSyntax, err)}}}, nil
+	}
+	if doc, ok := raw.(map[string]any); ok {
+		if err := migrateResult(doc); err != nil {
+			return nil, nil, err
+		}
+	}
+	if errs := validateValue(raw, resultFileSchema, ""); len(errs) > 0 {
+		return nil, locateErrors(errs, fieldLines(data), 1), nil
+	}
+
+	migrated, err := json.Marshal(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	rf := resultFile{Result: &decision.Result{}}
+	if err := json.Unmarshal(migrated, &rf); err != nil {
+		return nil, nil, err
+	}
+	return &rf, nil, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errNormalizeFile    = "Вкажіть файл задачі: tpr normalize <файл.xlsx> [-method minmax|max|sum|vector|rank] [-o <результат.xlsx>]"
+	errNormalizeMethod  = "Невідомий спосіб нормалізації '%s': потрібен один з %s"
+	errNormalizeRanking = "Нормалізація застосовується лише до матриці корисності, а не до ранжування експертів"
+
+	normalizeUsage = "нормалізувати кожен стан матриці корисності перед обчисленням критеріїв: minmax, max, sum, vector або rank"
+)
+
+// checkNormalize перевіряє назву способу нормалізації; порожня назва – без нормалізації
+func checkNormalize(method string) error {
+	if method != "" && !slices.Contains(decision.NormalizeMethods, method) {
+		return fmt.Errorf(errNormalizeMethod, method, strings.Join(decision.NormalizeMethods, ", "))
+	}
+	return nil
+}
+
+// PrintMatrix виводить матрицю з назвами альтернатив і стовпців
+func PrintMatrix(title string, m *decision.Matrix) {
+	fmt.Printf("\n%s\n%-20s", title, "Альтернатива")
+	for _, c := range m.Columns {
+		fmt.Printf("%12s", c)
+	}
+	fmt.Println()
+	for i, alt := range m.Alternatives {
+		fmt.Printf("%-20s", alt)
+		for _, v := range m.Values[i] {
+			fmt.Printf("%12.4f", v)
+		}
+		fmt.Println()
+	}
+}
+
+func runNormalize(args []string) error {
+	fs := flag.NewFlagSet("normalize", flag.ExitOnError)
+	sheet := fs.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	method := fs.String("method", decision.NormMinMax, "спосіб нормалізації: minmax, max, sum, vector або rank")
+	output := fs.String("o", "", "файл Excel для збереження нормалізованої матриці")
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf(errNormalizeFile)
+	}
+	if err := checkNormalize(*method); err != nil {
+		return err
+	}
+
+	m, err := loadMatrix(positional[0], *sheet)
+	if err != nil {
+		return err
+	}
+	n, err := m.Normalize(*method)
+	if err != nil {
+		return err
+	}
+	PrintMatrix(fmt.Sprintf("Нормалізована матриця (%s):", *method), n)
+	if *output != "" {
+		if err := saveMatrix(*output, n); err != nil {
+			return err
+		}
+		fmt.Printf("\nМатрицю збережено у файл %s\n", *output)
+	}
+	return nil
+}
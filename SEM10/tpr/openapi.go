@@ -0,0 +1,291 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errFieldType     = "очікується %s"
+	errFieldRequired = "обов'язкове поле"
+	errFieldUnknown  = "невідоме поле"
+	errFieldMinItems = "потрібно щонайменше %d елементів"
+	errFieldMinimum  = "значення має бути не менше %g"
+	errFieldMaximum  = "значення має бути не більше %g"
+	errFieldEnum     = "допустимі значення: %s"
+)
+
+type (
+	// schema – підмножина JSON Schema, з якої будується специфікація OpenAPI
+	// і за якою перевіряються тіла запитів і файли, тому документація й перевірка не розходяться.
+	// Dialect, ID і Title задаються лише для схем, що публікує tpr schema.
+	schema struct {
+		Dialect              string             `json:"$schema,omitempty"`
+		ID                   string             `json:"$id,omitempty"`
+		Title                string             `json:"title,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Description          string             `json:"description,omitempty"`
+		Properties           map[string]*schema `json:"properties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+		Items                *schema            `json:"items,omitempty"`
+		MinItems             int                `json:"minItems,omitempty"`
+		Minimum              *float64           `json:"minimum,omitempty"`
+		Maximum              *float64           `json:"maximum,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Example              any                `json:"example,omitempty"`
+	}
+
+	// fieldError – помилка перевірки запиту з шляхом до поля, наприклад values[1][0]
+	fieldError struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func stringArray(description string) *schema {
+	return &schema{Type: "array", Description: description, MinItems: 1, Items: &schema{Type: "string"}}
+}
+
+// matrixProperties – поля задачі, спільні для обох запитів
+func matrixProperties(columns, values string) map[string]*schema {
+	return map[string]*schema{
+		"problem":      {Type: "string", Description: "назва задачі, повертається у відповіді"},
+		"alternatives": stringArray("назви альтернатив"),
+		"columns":      stringArray(columns),
+		"values": {
+			Type:        "array",
+			Description: values,
+			MinItems:    1,
+			Items:       &schema{Type: "array", Items: &schema{Type: "number"}},
+		},
+	}
+}
+
+var (
+	uncertaintySchema = &schema{
+		Type:                 "object",
+		Description:          "матриця корисності: values[i][j] – корисність альтернативи i за стану j",
+		Required:             []string{"alternatives", "columns", "values"},
+		AdditionalProperties: ptr(false),
+		Properties: func() map[string]*schema {
+			p := matrixProperties("назви станів", "рядок значень корисності для кожної альтернативи")
+			p["alpha"] = &schema{Type: "number", Description: "коефіцієнт оптимізму для критерію Гурвіца (за замовчуванням 0.5)",
+				Minimum: ptr(0.0), Maximum: ptr(1.0)}
+			return p
+		}(),
+		Example: map[string]any{
+			"alternatives": []string{"A1", "A2"},
+			"columns":      []string{"Стан 1", "Стан 2"},
+			"values":       [][]float64{{4, 7}, {6, 3}},
+			"alpha":        0.5,
+		},
+	}
+
+	paretoSchema = &schema{
+		Type:                 "object",
+		Description:          "ранжування експертів: values[i][k] – ранг альтернативи i від експерта k (1 – найкраща)",
+		Required:             []string{"alternatives", "columns", "values"},
+		AdditionalProperties: ptr(false),
+		Properties:           matrixProperties("імена експертів", "ранги альтернативи від кожного експерта"),
+		Example: map[string]any{
+			"alternatives": []string{"A1", "A2", "A3"},
+			"columns":      []string{"Експерт 1", "Експерт 2"},
+			"values":       [][]float64{{1, 2}, {2, 1}, {3, 3}},
+		},
+	}
+
+	criterionSchema = &schema{
+		Type:     "object",
+		Required: []string{"name", "values", "ranking"},
+		Properties: map[string]*schema{
+			"name":    {Type: "string", Description: "wald, maxmax, hurwicz, savage або laplace"},
+			"values":  {Type: "array", Items: &schema{Type: "number"}, Description: "значення в порядку alternatives"},
+			"ranking": {Type: "array", Items: &schema{Type: "string"}, Description: "від найкращої до найгіршої"},
+			"best":    {Type: "array", Items: &schema{Type: "string"}},
+		},
+	}
+
+	resultSchema = &schema{
+		Type:     "object",
+		Required: []string{"kind", "alternatives", "columns", "pareto"},
+		Properties: map[string]*schema{
+			"problem":      {Type: "string"},
+			"kind":         {Type: "string", Enum: []string{kindPayoff, kindRanking}},
+			"alternatives": {Type: "array", Items: &schema{Type: "string"}},
+			"columns":      {Type: "array", Items: &schema{Type: "string"}},
+			"criteria":     {Type: "array", Items: criterionSchema},
+			"pareto":       {Type: "array", Items: &schema{Type: "string"}},
+			"groups": {Type: "array", Description: "ранжування всередині груп альтернатив", Items: &schema{
+				Type:     "object",
+				Required: []string{"name", "alternatives", "pareto"},
+				Properties: map[string]*schema{
+					"name":         {Type: "string"},
+					"alternatives": {Type: "array", Items: &schema{Type: "string"}},
+					"criteria":     {Type: "array", Items: criterionSchema},
+					"pareto":       {Type: "array", Items: &schema{Type: "string"}},
+				},
+			}},
+			"group_best": {Type: "array", Description: "порівняння груп за найкращими альтернативами; значення – у порядку groups",
+				Items: criterionSchema},
+			"screened": {Type: "array", Description: "недосягнуті рівні домагань кон'юнктивного відбору", Items: &schema{
+				Type:     "object",
+				Required: []string{"alternative", "column", "value", "level"},
+				Properties: map[string]*schema{
+					"alternative": {Type: "string"},
+					"column":      {Type: "string"},
+					"value":       {Type: "number"},
+					"level":       {Type: "number"},
+				},
+			}},
+		},
+	}
+
+	errorSchema = &schema{
+		Type: "object",
+		Properties: map[string]*schema{
+			"error": {Type: "string"},
+			"errors": {Type: "array", Items: &schema{
+				Type: "object",
+				Properties: map[string]*schema{
+					"field":   {Type: "string", Description: "шлях до поля, наприклад values[1][0]"},
+					"message": {Type: "string"},
+				},
+			}},
+		},
+	}
+)
+
+// operation описує метод API для специфікації OpenAPI
+func operation(summary string, request *schema) map[string]any {
+	jsonContent := func(s *schema) map[string]any {
+		return map[string]any{"application/json": map[string]any{"schema": s}}
+	}
+	return map[string]any{
+		"post": map[string]any{
+			"summary":     summary,
+			"requestBody": map[string]any{"required": true, "content": jsonContent(request)},
+			"responses": map[string]any{
+				"200": map[string]any{"description": "результати аналізу", "content": jsonContent(resultSchema)},
+				"400": map[string]any{"description": "некоректне тіло запиту", "content": jsonContent(errorSchema)},
+			},
+		},
+	}
+}
+
+// OpenAPISpec повертає специфікацію OpenAPI 3 для режиму tpr serve
+func OpenAPISpec() map[string]any {
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "tpr – теорія прийняття рішень",
+			"version": "1.0.0",
+		},
+		"paths": map[string]any{
+			"/uncertainty/criteria": operation("критерії Вальда, maxmax, Гурвіца, Севіджа та Лапласа для матриці корисності", uncertaintySchema),
+			"/pareto":               operation("множина Парето за ранжуваннями експертів", paretoSchema),
+		},
+	}
+}
+
+// validateValue перевіряє значення, розібране encoding/json, за схемою
+// і повертає всі помилки з шляхами до полів
+func validateValue(v any, s *schema, path string) []fieldError {
+	fail := func(format string, args ...any) []fieldError {
+		return []fieldError{{Field: path, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	switch s.Type {
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return fail(errFieldType, "об'єкт")
+		}
+		var errs []fieldError
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				errs = append(errs, fieldError{Field: joinPath(path, name), Message: errFieldRequired})
+			}
+		}
+		names := make([]string, 0, len(obj))
+		for name := range obj {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			prop, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					errs = append(errs, fieldError{Field: joinPath(path, name), Message: errFieldUnknown})
+				}
+				continue
+			}
+			errs = append(errs, validateValue(obj[name], prop, joinPath(path, name))...)
+		}
+		return errs
+
+	case "array":
+		arr, ok := v.([]any)
+		if !ok {
+			return fail(errFieldType, "масив")
+		}
+		if len(arr) < s.MinItems {
+			return fail(errFieldMinItems, s.MinItems)
+		}
+		var errs []fieldError
+		for i, item := range arr {
+			errs = append(errs, validateValue(item, s.Items, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return errs
+
+	case "number":
+		n, ok := v.(float64)
+		if !ok {
+			return fail(errFieldType, "число")
+		}
+		if s.Minimum != nil && n < *s.Minimum {
+			return fail(errFieldMinimum, *s.Minimum)
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			return fail(errFieldMaximum, *s.Maximum)
+		}
+
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fail(errFieldType, "рядок")
+		}
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, str) {
+			return fail(errFieldEnum, strings.Join(s.Enum, ", "))
+		}
+	}
+	return nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// validateMatrix перевіряє узгодженість розмірів задачі, яку не виражає схема
+func validateMatrix(m *decision.Matrix) []fieldError {
+	return fieldErrors(m.Validate())
+}
+
+// fieldErrors перетворює помилки перевірки задачі на помилки полів відповіді
+func fieldErrors(err error) []fieldError {
+	var errs []fieldError
+	for _, v := range decision.Violations(err) {
+		errs = append(errs, fieldError{Field: v.Field, Message: v.Message()})
+	}
+	return errs
+}
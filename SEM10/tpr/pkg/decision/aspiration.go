@@ -0,0 +1,42 @@
+package decision
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrAspirationCount – кількість рівнів домагань не збігається з кількістю станів
+var ErrAspirationCount = errors.New("рівні домагань задаються для кожного стану матриці")
+
+// Shortfall – значення альтернативи в стані, нижче за рівень домагань
+type Shortfall struct {
+	Alternative string  `json:"alternative"`
+	Column      string  `json:"column"`
+	Value       float64 `json:"value"`
+	Level       float64 `json:"level"`
+}
+
+// Screen виконує кон'юнктивний відбір: альтернатива прийнятна, якщо в кожному стані
+// її виграш не менший за рівень домагань levels[j] (NaN – для стану рівня немає).
+// Повертає матрицю прийнятних альтернатив і всі недосягнуті рівні у порядку рядків.
+func Screen(m *Matrix, levels []float64) (*Matrix, []Shortfall, error) {
+	if len(levels) != len(m.Columns) {
+		return nil, nil, &ValidationError{Field: "aspiration", Value: len(levels), Want: len(m.Columns), Err: ErrAspirationCount}
+	}
+	passed := &Matrix{Columns: m.Columns}
+	var shortfalls []Shortfall
+	for i, row := range m.Values {
+		ok := true
+		for j, v := range row {
+			if !math.IsNaN(levels[j]) && v < levels[j] {
+				shortfalls = append(shortfalls, Shortfall{m.Alternatives[i], m.Columns[j], v, levels[j]})
+				ok = false
+			}
+		}
+		if ok {
+			passed.Alternatives = append(passed.Alternatives, m.Alternatives[i])
+			passed.Values = append(passed.Values, row)
+		}
+	}
+	return passed, shortfalls, nil
+}
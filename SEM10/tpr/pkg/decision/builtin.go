@@ -0,0 +1,57 @@
+package decision
+
+import "slices"
+
+func init() {
+	Register("wald", func(Params) Criterion { return byRow{"wald", Maximize, slices.Min[[]float64]} })
+	Register("maxmax", func(Params) Criterion { return byRow{"maxmax", Maximize, slices.Max[[]float64]} })
+	Register("hurwicz", func(p Params) Criterion {
+		return byRow{"hurwicz", Maximize, func(row []float64) float64 {
+			if p.Exact {
+				return exactHurwicz(p.Alpha, slices.Max(row), slices.Min(row))
+			}
+			return p.Alpha*slices.Max(row) + (1-p.Alpha)*slices.Min(row)
+		}}
+	})
+	Register("savage", func(p Params) Criterion { return savage{exact: p.Exact} })
+	Register("laplace", func(p Params) Criterion {
+		if p.Exact {
+			return byRow{"laplace", Maximize, exactMean}
+		}
+		return byRow{"laplace", Maximize, mean}
+	})
+}
+
+// byRow – критерій, значення якого для альтернативи залежить лише від її рядка
+type byRow struct {
+	name      string
+	direction Direction
+	value     func(row []float64) float64
+}
+
+func (c byRow) Name() string         { return c.name }
+func (c byRow) Direction() Direction { return c.direction }
+
+func (c byRow) Evaluate(m *Matrix) map[string]float64 {
+	return byRowValues(m, func(i int) float64 { return c.value(m.Values[i]) })
+}
+
+func mean(row []float64) float64 {
+	return sum(row) / float64(len(row))
+}
+
+// savage – критерій Севіджа: найбільший жаль max_j (max_a u(a, j) − u(a, j));
+// exact – жаль обчислюється в раціональних числах
+type savage struct{ exact bool }
+
+func (savage) Name() string         { return "savage" }
+func (savage) Direction() Direction { return Minimize }
+
+func (c savage) Evaluate(m *Matrix) map[string]float64 {
+	regrets := RegretMatrix
+	if c.exact {
+		regrets = exactRegretMatrix
+	}
+	r := regrets(m)
+	return byRowValues(m, func(i int) float64 { return slices.Max(r.Values[i]) })
+}
@@ -0,0 +1,87 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// Помилки кореляційної матриці
+var (
+	ErrCorrelationShape    = errors.New("кореляційна матриця має бути квадратною з розміром, рівним кількості станів")
+	ErrCorrelationValue    = errors.New("коефіцієнт кореляції має бути від −1 до 1, на діагоналі – 1, а матриця – симетричною")
+	ErrNotPositiveDefinite = errors.New("кореляційна матриця не є додатно визначеною")
+)
+
+// correlationEps – допустима похибка симетрії та діагоналі кореляційної матриці
+const correlationEps = 1e-9
+
+// ValidateCorrelation перевіряє кореляційну матрицю збурень n станів
+func ValidateCorrelation(c [][]float64, n int) error {
+	_, err := cholesky(c, n)
+	return err
+}
+
+// cholesky повертає нижньотрикутну матрицю L, для якої L·Lᵀ = c
+func cholesky(c [][]float64, n int) ([][]float64, error) {
+	if len(c) != n {
+		return nil, &ValidationError{Field: "correlation", Value: len(c), Want: n, Err: ErrCorrelationShape}
+	}
+	for i, row := range c {
+		if len(row) != n {
+			return nil, &ValidationError{Field: fmt.Sprintf("correlation[%d]", i), Value: len(row), Want: n,
+				Err: ErrCorrelationShape}
+		}
+	}
+	for i := range c {
+		for j, v := range c[i] {
+			if v < -1 || v > 1 || math.Abs(v-c[j][i]) > correlationEps || (i == j && math.Abs(v-1) > correlationEps) {
+				return nil, &ValidationError{Field: fmt.Sprintf("correlation[%d][%d]", i, j), Value: v, Err: ErrCorrelationValue}
+			}
+		}
+	}
+
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+		for j := 0; j <= i; j++ {
+			sum := c[i][j]
+			for k := range j {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, &ValidationError{Field: "correlation", Err: ErrNotPositiveDefinite}
+				}
+				l[i][i] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, nil
+}
+
+// perturbCorrelated збурює матрицю так само, як perturb (рівномірно на ±deviation),
+// але відхилення станів у рядку корельовані: нормальний вектор L·e переводиться
+// у рівномірні величини функцією розподілу Φ (гаусова копула)
+func (m *Matrix) perturbCorrelated(rng *rand.Rand, deviation float64, l [][]float64) *Matrix {
+	p := &Matrix{Alternatives: m.Alternatives, Columns: m.Columns, Values: make([][]float64, len(m.Values))}
+	e := make([]float64, len(l))
+	for i, row := range m.Values {
+		for k := range e {
+			e[k] = rng.NormFloat64()
+		}
+		p.Values[i] = make([]float64, len(row))
+		for j, v := range row {
+			z := 0.0
+			for k := 0; k <= j; k++ {
+				z += l[j][k] * e[k]
+			}
+			u := 0.5 * (1 + math.Erf(z/math.Sqrt2))
+			p.Values[i][j] = v * (1 + deviation*(2*u-1))
+		}
+	}
+	return p
+}
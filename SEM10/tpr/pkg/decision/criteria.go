@@ -0,0 +1,124 @@
+// Package decision – обчислювальне ядро tpr: критерії прийняття рішень в умовах
+// невизначеності, множина Парето та аналіз стійкості методом Монте-Карло.
+// Пакет не залежить від введення-виведення, тому збирається й у WebAssembly.
+package decision
+
+import "sort"
+
+type (
+	// Result – результат аналізу однієї задачі, який записується у файл результатів
+	Result struct {
+		Problem      string            `json:"problem"`
+		Kind         string            `json:"kind"`
+		Alternatives []string          `json:"alternatives"`
+		Columns      []string          `json:"columns"`
+		Criteria     []CriterionResult `json:"criteria,omitempty"`
+		Pareto       []string          `json:"pareto"`
+		// Screened – недосягнуті рівні домагань кон'юнктивного відбору (tpr analyze -aspiration)
+		Screened []Shortfall `json:"screened,omitempty"`
+		// Groups – ранжування всередині груп альтернатив, GroupBest – порівняння груп
+		// за найкращими альтернативами (значення – у порядку Groups)
+		Groups    []GroupResult     `json:"groups,omitempty"`
+		GroupBest []CriterionResult `json:"group_best,omitempty"`
+	}
+
+	// CriterionResult – значення критерію для кожної альтернативи (у порядку Alternatives)
+	// та ранжування від найкращої до найгіршої
+	CriterionResult struct {
+		Name    string    `json:"name"`
+		Values  []float64 `json:"values"`
+		Ranking []string  `json:"ranking"`
+		Best    []string  `json:"best"`
+	}
+)
+
+// Analyze обчислює зареєстровані критерії, застосовні до типу задачі (вбудовані –
+// Вальда, maxmax, Гурвіца, Севіджа, Лапласа – лише для матриці корисності), і множину Парето
+func Analyze(m *Matrix, kind string, alpha float64) *Result {
+	return AnalyzeWith(m, Params{Alpha: alpha, Kind: kind})
+}
+
+// AnalyzeWith – Analyze з усіма параметрами аналізу (наприклад, точною арифметикою Params.Exact)
+func AnalyzeWith(m *Matrix, p Params) *Result {
+	kind := p.Kind
+	r := &Result{Kind: kind, Alternatives: m.Alternatives, Columns: m.Columns}
+	for _, name := range Registered() {
+		c, _ := Lookup(name, p)
+		if appliesTo(c, kind) {
+			r.Criteria = append(r.Criteria, Evaluate(c, m))
+		}
+	}
+	if kind == KindRanking {
+		// Менший ранг – краще, тому для порівняння ранги беруться з протилежним знаком
+		negated := make([][]float64, len(m.Values))
+		for i, row := range m.Values {
+			negated[i] = make([]float64, len(row))
+			for j, v := range row {
+				negated[i][j] = -v
+			}
+		}
+		r.Pareto = paretoSet(m.Alternatives, negated)
+		return r
+	}
+	r.Pareto = paretoSet(m.Alternatives, m.Values)
+	return r
+}
+
+// criterionResult ранжує альтернативи за значеннями; ascending – менше значення краще
+func criterionResult(name string, alts []string, values []float64, ascending bool) CriterionResult {
+	order := make([]int, len(alts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if ascending {
+			return values[order[a]] < values[order[b]]
+		}
+		return values[order[a]] > values[order[b]]
+	})
+
+	c := CriterionResult{Name: name, Values: values}
+	for _, i := range order {
+		c.Ranking = append(c.Ranking, alts[i])
+		if values[i] == values[order[0]] {
+			c.Best = append(c.Best, alts[i])
+		}
+	}
+	return c
+}
+
+// paretoSet повертає альтернативи, над якими не домінує жодна інша
+// (більше значення в кожному стовпці – краще)
+func paretoSet(alts []string, values [][]float64) []string {
+	dominates := func(a, b []float64) bool {
+		better := false
+		for j := range a {
+			if a[j] < b[j] {
+				return false
+			}
+			if a[j] > b[j] {
+				better = true
+			}
+		}
+		return better
+	}
+
+	dominated := make([]bool, len(alts))
+	parallelFor(len(alts), func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			for k := range alts {
+				if k != i && dominates(values[k], values[i]) {
+					dominated[i] = true
+					break
+				}
+			}
+		}
+	})
+	var out []string
+	for i, alt := range alts {
+		if !dominated[i] {
+			out = append(out, alt)
+		}
+	}
+	return out
+}
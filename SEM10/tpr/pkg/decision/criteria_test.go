@@ -0,0 +1,207 @@
+package decision
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+// quickConfig – кількість випадкових задач для кожної властивості
+var quickConfig = &quick.Config{MaxCount: 500}
+
+type (
+	// payoffMatrix – випадкова матриця корисності для testing/quick. Значення цілі,
+	// тож зсув на сталу не вносить похибок округлення і порівняння можуть бути точними.
+	payoffMatrix struct{ *Matrix }
+
+	// rankingMatrix – випадковий профіль ранжувань: кожен стовпець – перестановка рангів 1…n
+	rankingMatrix struct{ *Matrix }
+)
+
+// randomMatrix створює матрицю rows×cols з назвами альтернатив a1…, стовпців s1… і значеннями value
+func randomMatrix(rows, cols int, value func(i, j int) float64) *Matrix {
+	m := &Matrix{Alternatives: make([]string, rows), Columns: make([]string, cols), Values: make([][]float64, rows)}
+	for j := range m.Columns {
+		m.Columns[j] = fmt.Sprintf("s%d", j+1)
+	}
+	for i := range m.Values {
+		m.Alternatives[i] = fmt.Sprintf("a%d", i+1)
+		m.Values[i] = make([]float64, cols)
+		for j := range m.Values[i] {
+			m.Values[i][j] = value(i, j)
+		}
+	}
+	return m
+}
+
+func (payoffMatrix) Generate(rng *rand.Rand, size int) reflect.Value {
+	// Невеликий діапазон значень часто дає однакові значення, тобто нічиї в ранжуваннях
+	spread := 1 + rng.Intn(2*size+1)
+	m := randomMatrix(1+rng.Intn(8), 1+rng.Intn(6), func(int, int) float64 {
+		return float64(rng.Intn(2*spread+1) - spread)
+	})
+	return reflect.ValueOf(payoffMatrix{m})
+}
+
+func (rankingMatrix) Generate(rng *rand.Rand, _ int) reflect.Value {
+	rows, cols := 1+rng.Intn(8), 1+rng.Intn(6)
+	ranks := make([][]int, cols)
+	for j := range ranks {
+		ranks[j] = rng.Perm(rows)
+	}
+	m := randomMatrix(rows, cols, func(i, j int) float64 { return float64(ranks[j][i] + 1) })
+	return reflect.ValueOf(rankingMatrix{m})
+}
+
+// GoString показує значення матриці в повідомленні quick.Check про невдалу перевірку
+func (m payoffMatrix) GoString() string  { return fmt.Sprint(m.Values) }
+func (m rankingMatrix) GoString() string { return fmt.Sprint(m.Values) }
+
+// shifted повертає копію матриці, в якій до кожного значення додано c
+func shifted(m *Matrix, c float64) *Matrix {
+	return randomMatrix(len(m.Alternatives), len(m.Columns), func(i, j int) float64 { return m.Values[i][j] + c })
+}
+
+// evaluate обчислює зареєстрований критерій name
+func evaluate(t *testing.T, name string, m *Matrix, alpha float64) CriterionResult {
+	t.Helper()
+	c, ok := Lookup(name, Params{Alpha: alpha, Kind: KindPayoff})
+	if !ok {
+		t.Fatalf("критерій %q не зареєстровано", name)
+	}
+	return Evaluate(c, m)
+}
+
+func check(t *testing.T, property any) {
+	t.Helper()
+	if err := quick.Check(property, quickConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSavageShiftInvariance(t *testing.T) {
+	check(t, func(m payoffMatrix, c int16) bool {
+		before := evaluate(t, "savage", m.Matrix, 0)
+		after := evaluate(t, "savage", shifted(m.Matrix, float64(c)), 0)
+		return slices.Equal(before.Values, after.Values) && slices.Equal(before.Ranking, after.Ranking)
+	})
+}
+
+func TestHurwiczBounds(t *testing.T) {
+	check(t, func(m payoffMatrix) bool {
+		return slices.Equal(evaluate(t, "hurwicz", m.Matrix, 0).Values, evaluate(t, "wald", m.Matrix, 0).Values) &&
+			slices.Equal(evaluate(t, "hurwicz", m.Matrix, 1).Values, evaluate(t, "maxmax", m.Matrix, 0).Values)
+	})
+}
+
+func TestHurwiczBetweenWaldAndMaxmax(t *testing.T) {
+	check(t, func(m payoffMatrix, a uint8) bool {
+		alpha := float64(a) / 255
+		wald, maxmax := evaluate(t, "wald", m.Matrix, 0), evaluate(t, "maxmax", m.Matrix, 0)
+		for i, v := range evaluate(t, "hurwicz", m.Matrix, alpha).Values {
+			if v < wald.Values[i]-1e-9 || v > maxmax.Values[i]+1e-9 {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestRankingIsPermutation(t *testing.T) {
+	check(t, func(m payoffMatrix, a uint8) bool {
+		for _, c := range Analyze(m.Matrix, KindPayoff, float64(a)/255).Criteria {
+			ranking, alts := slices.Clone(c.Ranking), slices.Clone(m.Alternatives)
+			slices.Sort(ranking)
+			slices.Sort(alts)
+			if !slices.Equal(ranking, alts) {
+				return false
+			}
+			// Найкращі альтернативи – початок ранжування
+			if len(c.Best) == 0 || !slices.Equal(c.Best, c.Ranking[:len(c.Best)]) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestParetoNeverEmpty(t *testing.T) {
+	check(t, func(m payoffMatrix) bool {
+		return len(Analyze(m.Matrix, KindPayoff, 0.5).Pareto) > 0
+	})
+	check(t, func(m rankingMatrix) bool {
+		return len(Analyze(m.Matrix, KindRanking, 0.5).Pareto) > 0
+	})
+}
+
+func TestParetoContainsMaxmaxBest(t *testing.T) {
+	// Серед найкращих за maxmax альтернатив принаймні одна не домінована:
+	// домінуюча альтернатива мала б не менший максимум
+	check(t, func(m payoffMatrix) bool {
+		r := Analyze(m.Matrix, KindPayoff, 0.5)
+		for _, c := range r.Criteria {
+			if c.Name == "maxmax" {
+				return slices.ContainsFunc(c.Best, func(alt string) bool { return slices.Contains(r.Pareto, alt) })
+			}
+		}
+		return false
+	})
+}
+
+// matrixRows – RowReader над матрицею в пам'яті
+type matrixRows struct {
+	m *Matrix
+	i int
+}
+
+func (r *matrixRows) Next() (string, []float64, error) {
+	if r.i == len(r.m.Values) {
+		return "", nil, io.EOF
+	}
+	r.i++
+	return r.m.Alternatives[r.i-1], r.m.Values[r.i-1], nil
+}
+
+func TestStreamMatchesEvaluate(t *testing.T) {
+	// Потокові критерії збігаються зі звичайними, а нічия обрізається до limit альтернатив
+	const limit = 2
+	check(t, func(m payoffMatrix) bool {
+		rows, results, err := Stream(func() (RowReader, error) { return &matrixRows{m: m.Matrix}, nil }, limit)
+		if err != nil || rows != len(m.Alternatives) {
+			return false
+		}
+		for _, r := range results {
+			c := evaluate(t, r.Name, m.Matrix, 0)
+			best := slices.IndexFunc(m.Alternatives, func(alt string) bool { return alt == c.Best[0] })
+			if r.Value != c.Values[best] || r.Ties != len(c.Best) ||
+				!slices.Equal(r.Best, c.Best[:min(limit, len(c.Best))]) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestExactArithmetic(t *testing.T) {
+	// Значення, які у float64 дають похибку округлення, у точному режимі збігаються з обчисленими вручну
+	m := &Matrix{Alternatives: []string{"a1", "a2"}, Columns: []string{"s1", "s2", "s3"},
+		Values: [][]float64{{0.1, 0.2, 0.3}, {0.7, 0.1, 0.2}}}
+	tests := []struct {
+		criterion string
+		want      []float64
+	}{
+		{"laplace", []float64{0.2, 1.0 / 3}},
+		{"hurwicz", []float64{0.16, 0.28}},
+		{"savage", []float64{0.6, 0.1}},
+	}
+	for _, tt := range tests {
+		c, _ := Lookup(tt.criterion, Params{Alpha: 0.3, Kind: KindPayoff, Exact: true})
+		if got := Evaluate(c, m).Values; !slices.Equal(got, tt.want) {
+			t.Errorf("%s: %v, потрібно %v", tt.criterion, got, tt.want)
+		}
+	}
+}
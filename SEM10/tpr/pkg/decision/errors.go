@@ -0,0 +1,120 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Помилки перевірки задачі; конкретне поле й значення містить ValidationError,
+// тому їх слід порівнювати через errors.Is
+var (
+	ErrEmptyProblem            = errors.New("задача має містити хоча б одну альтернативу та один стовпець")
+	ErrInvalidAlternativeCount = errors.New("кількість рядків не збігається з кількістю альтернатив")
+	ErrInvalidRowLength        = errors.New("кількість значень у рядку не збігається з кількістю стовпців")
+	ErrDuplicateAlternative    = errors.New("альтернатива повторюється")
+	ErrNotRanking              = errors.New("кожен стовпець має бути ранжуванням без повторів")
+	ErrInvalidAlpha            = errors.New("коефіцієнт оптимізму має бути від 0 до 1")
+)
+
+// ValidationError – помилка окремого поля задачі. Field – шлях до поля
+// у форматі JSON-задачі (values[1], alternatives[2]), Value – некоректне значення,
+// Want – очікуване (якщо відоме), Err – одна з помилок Err…
+type ValidationError struct {
+	Field string
+	Value any
+	Want  any
+	Err   error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Message()
+}
+
+// Message повертає опис помилки без шляху до поля
+func (e *ValidationError) Message() string {
+	switch {
+	case e.Want != nil && e.Value != nil:
+		return fmt.Sprintf("%v (%v, потрібно %v)", e.Err, e.Value, e.Want)
+	case e.Want != nil:
+		return fmt.Sprintf("%v (потрібно %v)", e.Err, e.Want)
+	case e.Value != nil:
+		return fmt.Sprintf("%v (%v)", e.Err, e.Value)
+	}
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Violations повертає всі помилки полів, об'єднані в err (через errors.Join)
+func Violations(err error) []*ValidationError {
+	var out []*ValidationError
+	var walk func(err error)
+	walk = func(err error) {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				walk(e)
+			}
+			return
+		}
+		var ve *ValidationError
+		if errors.As(err, &ve) {
+			out = append(out, ve)
+		}
+	}
+	if err != nil {
+		walk(err)
+	}
+	return out
+}
+
+// Validate перевіряє, що задача непорожня, альтернативи не повторюються,
+// а розміри матриці узгоджені з назвами; повертає всі знайдені помилки
+func (m *Matrix) Validate() error {
+	var errs []error
+	if len(m.Alternatives) == 0 {
+		errs = append(errs, &ValidationError{Field: "alternatives", Err: ErrEmptyProblem})
+	}
+	if len(m.Columns) == 0 {
+		errs = append(errs, &ValidationError{Field: "columns", Err: ErrEmptyProblem})
+	}
+	if len(m.Values) != len(m.Alternatives) {
+		errs = append(errs, &ValidationError{Field: "values", Value: len(m.Values), Want: len(m.Alternatives),
+			Err: ErrInvalidAlternativeCount})
+	}
+	seen := make(map[string]bool, len(m.Alternatives))
+	for i, alt := range m.Alternatives {
+		if seen[alt] {
+			errs = append(errs, &ValidationError{Field: fmt.Sprintf("alternatives[%d]", i), Value: alt,
+				Err: ErrDuplicateAlternative})
+		}
+		seen[alt] = true
+	}
+	for i, row := range m.Values {
+		if len(row) != len(m.Columns) {
+			errs = append(errs, &ValidationError{Field: fmt.Sprintf("values[%d]", i), Value: len(row),
+				Want: len(m.Columns), Err: ErrInvalidRowLength})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateRanking додатково до Validate перевіряє, що кожен стовпець – ранжування 1…n
+func (m *Matrix) ValidateRanking() error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	if !m.IsRanking() {
+		return &ValidationError{Field: "values", Want: fmt.Sprintf("1…%d", len(m.Alternatives)), Err: ErrNotRanking}
+	}
+	return nil
+}
+
+// ValidateAlpha перевіряє коефіцієнт оптимізму критерію Гурвіца (NaN теж некоректний)
+func ValidateAlpha(alpha float64) error {
+	if !(alpha >= 0 && alpha <= 1) {
+		return &ValidationError{Field: "alpha", Value: alpha, Err: ErrInvalidAlpha}
+	}
+	return nil
+}
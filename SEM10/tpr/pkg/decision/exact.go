@@ -0,0 +1,42 @@
+package decision
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// rat повертає значення як раціональне число за його найкоротшим десятковим
+// записом: введене 7.1 стає рівно 71/10, а не двійковим наближенням
+func rat(v float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	return r
+}
+
+// ratFloat повертає найближче до r значення float64; рівні раціональні числа
+// дають рівні значення, тому однакові результати не розходяться в ранжуванні
+func ratFloat(r *big.Rat) float64 {
+	f, _ := r.Float64()
+	return f
+}
+
+// exactMean – середнє арифметичне рядка, обчислене в раціональних числах
+func exactMean(row []float64) float64 {
+	sum := new(big.Rat)
+	for _, v := range row {
+		sum.Add(sum, rat(v))
+	}
+	return ratFloat(sum.Quo(sum, big.NewRat(int64(len(row)), 1)))
+}
+
+// exactHurwicz – α·max + (1 − α)·min, обчислене в раціональних числах
+func exactHurwicz(alpha, hi, lo float64) float64 {
+	a := rat(alpha)
+	v := new(big.Rat).Mul(a, rat(hi))
+	rest := new(big.Rat).Sub(big.NewRat(1, 1), a)
+	return ratFloat(v.Add(v, rest.Mul(rest, rat(lo))))
+}
+
+// exactSub – різниця a − b, обчислена в раціональних числах
+func exactSub(a, b float64) float64 {
+	return ratFloat(new(big.Rat).Sub(rat(a), rat(b)))
+}
@@ -0,0 +1,319 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"unicode"
+)
+
+// ErrInvalidExpression – вираз критерію не вдалося розібрати
+var ErrInvalidExpression = errors.New("некоректний вираз критерію")
+
+// aggregates – змінні виразу: агрегати рядка альтернативи та параметри аналізу
+var aggregates = map[string]func(row []float64, p Params) float64{
+	"min":    func(row []float64, _ Params) float64 { return slices.Min(row) },
+	"max":    func(row []float64, _ Params) float64 { return slices.Max(row) },
+	"sum":    func(row []float64, _ Params) float64 { return sum(row) },
+	"mean":   func(row []float64, _ Params) float64 { return mean(row) },
+	"median": func(row []float64, _ Params) float64 { return median(row) },
+	"stddev": func(row []float64, _ Params) float64 { return stddev(row) },
+	"range":  func(row []float64, _ Params) float64 { return slices.Max(row) - slices.Min(row) },
+	"n":      func(row []float64, _ Params) float64 { return float64(len(row)) },
+	"alpha":  func(_ []float64, p Params) float64 { return p.Alpha },
+}
+
+// functions – функції виразу з кількістю аргументів (-1 – довільна, але не менше одного)
+var functions = map[string]struct {
+	arity int
+	call  func(args []float64) float64
+}{
+	"abs":  {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"sqrt": {1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"log":  {1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"exp":  {1, func(a []float64) float64 { return math.Exp(a[0]) }},
+	"pow":  {2, func(a []float64) float64 { return math.Pow(a[0], a[1]) }},
+	"min":  {-1, func(a []float64) float64 { return slices.Min(a) }},
+	"max":  {-1, func(a []float64) float64 { return slices.Max(a) }},
+}
+
+type (
+	// Expression – критерій, заданий формулою над агрегатами рядка альтернативи,
+	// наприклад 0.6*max + 0.3*mean - 0.1*stddev
+	Expression struct {
+		name      string
+		direction Direction
+		source    string
+		eval      exprNode
+		params    Params
+	}
+
+	// exprNode обчислює вузол виразу для рядка альтернативи
+	exprNode func(row []float64, p Params) float64
+
+	exprParser struct {
+		src []rune
+		pos int
+	}
+)
+
+// ParseExpression розбирає вираз критерію. Підтримуються числа, змінні
+// (min, max, sum, mean, median, stddev, range, n, alpha), операції + - * / ^,
+// дужки та функції abs, sqrt, log, exp, pow, min(…), max(…)
+func ParseExpression(name, source string, direction Direction) (*Expression, error) {
+	p := &exprParser{src: []rune(source)}
+	node, err := p.expr()
+	if err == nil && p.skipSpace() < len(p.src) {
+		err = p.fail("зайвий символ '%c'", p.src[p.pos])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Expression{name: name, direction: direction, source: source, eval: node}, nil
+}
+
+// RegisterExpression розбирає вираз і реєструє його як критерій; на відміну
+// від Register повертає помилку, якщо назву вже зайнято
+func RegisterExpression(name, source string, direction Direction) error {
+	if _, ok := Lookup(name, Params{}); ok {
+		return fmt.Errorf("критерій %q уже визначено", name)
+	}
+	e, err := ParseExpression(name, source, direction)
+	if err != nil {
+		return err
+	}
+	Register(name, func(p Params) Criterion {
+		c := *e
+		c.params = p
+		return &c
+	})
+	return nil
+}
+
+func (e *Expression) Name() string         { return e.name }
+func (e *Expression) Direction() Direction { return e.direction }
+
+// String повертає вираз у тому вигляді, в якому його задано
+func (e *Expression) String() string { return e.source }
+
+func (e *Expression) Evaluate(m *Matrix) map[string]float64 {
+	return byRowValues(m, func(i int) float64 { return e.eval(m.Values[i], e.params) })
+}
+
+func (p *exprParser) fail(format string, args ...any) error {
+	return fmt.Errorf("%w: позиція %d: %s", ErrInvalidExpression, p.pos+1, fmt.Sprintf(format, args...))
+}
+
+// skipSpace пропускає пробіли й повертає поточну позицію
+func (p *exprParser) skipSpace() int {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+	return p.pos
+}
+
+// accept пропускає символ c, якщо він наступний
+func (p *exprParser) accept(c rune) bool {
+	if p.skipSpace() < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expr := term (('+' | '-') term)*
+func (p *exprParser) expr() (exprNode, error) {
+	left, err := p.term()
+	for err == nil {
+		var op rune
+		switch {
+		case p.accept('+'):
+			op = '+'
+		case p.accept('-'):
+			op = '-'
+		default:
+			return left, nil
+		}
+		var right exprNode
+		if right, err = p.term(); err == nil {
+			left = binary(op, left, right)
+		}
+	}
+	return nil, err
+}
+
+// term := unary (('*' | '/') unary)*
+func (p *exprParser) term() (exprNode, error) {
+	left, err := p.unary()
+	for err == nil {
+		var op rune
+		switch {
+		case p.accept('*'):
+			op = '*'
+		case p.accept('/'):
+			op = '/'
+		default:
+			return left, nil
+		}
+		var right exprNode
+		if right, err = p.unary(); err == nil {
+			left = binary(op, left, right)
+		}
+	}
+	return nil, err
+}
+
+// unary := '-' unary | power
+func (p *exprParser) unary() (exprNode, error) {
+	if p.accept('-') {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(row []float64, pr Params) float64 { return -operand(row, pr) }, nil
+	}
+	return p.power()
+}
+
+// power := primary ('^' unary)? – степінь правоасоціативний: 2^3^2 = 2^9
+func (p *exprParser) power() (exprNode, error) {
+	base, err := p.primary()
+	if err != nil || !p.accept('^') {
+		return base, err
+	}
+	exponent, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return binary('^', base, exponent), nil
+}
+
+// primary := число | змінна | функція '(' expr (',' expr)* ')' | '(' expr ')'
+func (p *exprParser) primary() (exprNode, error) {
+	if p.skipSpace() >= len(p.src) {
+		return nil, p.fail("неочікуваний кінець виразу")
+	}
+	if p.accept('(') {
+		node, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(')') {
+			return nil, p.fail("очікується ')'")
+		}
+		return node, nil
+	}
+
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case unicode.IsDigit(c) || c == '.':
+		for p.pos < len(p.src) && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		text := string(p.src[start:p.pos])
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			p.pos = start
+			return nil, p.fail("некоректне число '%s'", text)
+		}
+		return func([]float64, Params) float64 { return v }, nil
+
+	case unicode.IsLetter(c) || c == '_':
+		for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '_') {
+			p.pos++
+		}
+		ident := string(p.src[start:p.pos])
+		if p.accept('(') {
+			return p.call(ident, start)
+		}
+		agg, ok := aggregates[ident]
+		if !ok {
+			p.pos = start
+			return nil, p.fail("невідома змінна '%s'", ident)
+		}
+		return agg, nil
+	}
+	return nil, p.fail("неочікуваний символ '%c'", c)
+}
+
+// call розбирає аргументи функції ident після відкривної дужки
+func (p *exprParser) call(ident string, start int) (exprNode, error) {
+	fn, ok := functions[ident]
+	if !ok {
+		p.pos = start
+		return nil, p.fail("невідома функція '%s'", ident)
+	}
+	var args []exprNode
+	for {
+		arg, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.accept(')') {
+			break
+		}
+		if !p.accept(',') {
+			return nil, p.fail("очікується ',' або ')'")
+		}
+	}
+	if fn.arity >= 0 && len(args) != fn.arity {
+		p.pos = start
+		return nil, p.fail("функція %s приймає аргументів: %d, задано %d", ident, fn.arity, len(args))
+	}
+	return func(row []float64, pr Params) float64 {
+		values := make([]float64, len(args))
+		for i, arg := range args {
+			values[i] = arg(row, pr)
+		}
+		return fn.call(values)
+	}, nil
+}
+
+func binary(op rune, left, right exprNode) exprNode {
+	return func(row []float64, p Params) float64 {
+		a, b := left(row, p), right(row, p)
+		switch op {
+		case '+':
+			return a + b
+		case '-':
+			return a - b
+		case '*':
+			return a * b
+		case '/':
+			return a / b
+		}
+		return math.Pow(a, b)
+	}
+}
+
+func sum(row []float64) float64 {
+	s := 0.0
+	for _, v := range row {
+		s += v
+	}
+	return s
+}
+
+func median(row []float64) float64 {
+	sorted := slices.Clone(row)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// stddev – стандартне відхилення генеральної сукупності (ділення на n)
+func stddev(row []float64) float64 {
+	m := mean(row)
+	s := 0.0
+	for _, v := range row {
+		s += (v - m) * (v - m)
+	}
+	return math.Sqrt(s / float64(len(row)))
+}
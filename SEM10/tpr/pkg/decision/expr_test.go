@@ -0,0 +1,27 @@
+package decision
+
+import "testing"
+
+func FuzzParseExpression(f *testing.F) {
+	for _, src := range []string{
+		"0.6*min + 0.4*max",
+		"mean - stddev/2",
+		"(sum - range) / n",
+		"alpha*max + (1-alpha)*median",
+		"-(-min)",
+		"max/0",
+		"((((",
+	} {
+		f.Add(src)
+	}
+	m := &Matrix{Alternatives: []string{"A", "B"}, Columns: []string{"s1", "s2", "s3"},
+		Values: [][]float64{{1, 2, 3}, {-4, 0, 1e308}}}
+	f.Fuzz(func(t *testing.T, src string) {
+		e, err := ParseExpression("fuzz", src, Maximize)
+		if err != nil {
+			return
+		}
+		e.params = Params{Alpha: 0.5}
+		Evaluate(e, m)
+	})
+}
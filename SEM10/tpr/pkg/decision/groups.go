@@ -0,0 +1,99 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Помилки розбиття альтернатив на групи
+var (
+	ErrGroupUnknown   = errors.New("альтернативи немає в матриці")
+	ErrGroupDuplicate = errors.New("альтернативу вже віднесено до іншої групи")
+)
+
+type (
+	// Group – група (категорія) альтернатив, наприклад «постачальники» чи «власна розробка»
+	Group struct {
+		Name         string   `json:"name"`
+		Alternatives []string `json:"alternatives"`
+	}
+
+	// GroupResult – ранжування альтернатив групи, обчислене лише серед них
+	GroupResult struct {
+		Name         string            `json:"name"`
+		Alternatives []string          `json:"alternatives"`
+		Criteria     []CriterionResult `json:"criteria,omitempty"`
+		Pareto       []string          `json:"pareto"`
+	}
+)
+
+// ValidateGroups перевіряє, що кожна альтернатива груп є в матриці й належить лише одній групі
+func ValidateGroups(m *Matrix, groups []Group) error {
+	var errs []error
+	owner := make(map[string]string)
+	for i, g := range groups {
+		for k, alt := range g.Alternatives {
+			field := fmt.Sprintf("groups[%d].alternatives[%d]", i, k)
+			switch {
+			case !slices.Contains(m.Alternatives, alt):
+				errs = append(errs, &ValidationError{Field: field, Value: alt, Err: ErrGroupUnknown})
+			case owner[alt] != "":
+				errs = append(errs, &ValidationError{Field: field, Value: alt + " – група " + owner[alt], Err: ErrGroupDuplicate})
+			default:
+				owner[alt] = g.Name
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// AnalyzeGroups аналізує кожну групу окремо: критерії, що залежать від усієї
+// множини альтернатив (як критерій Севіджа), перераховуються лише для членів групи.
+// Альтернативи, яких немає в m (наприклад, виключені відбором), пропускаються,
+// а групи без альтернатив не аналізуються.
+func AnalyzeGroups(m *Matrix, p Params, groups []Group) []GroupResult {
+	var out []GroupResult
+	for _, g := range groups {
+		sub := &Matrix{Columns: m.Columns}
+		for _, alt := range g.Alternatives {
+			if i := slices.Index(m.Alternatives, alt); i >= 0 {
+				sub.Alternatives = append(sub.Alternatives, alt)
+				sub.Values = append(sub.Values, m.Values[i])
+			}
+		}
+		if len(sub.Alternatives) == 0 {
+			continue
+		}
+		r := AnalyzeWith(sub, p)
+		out = append(out, GroupResult{Name: g.Name, Alternatives: r.Alternatives, Criteria: r.Criteria, Pareto: r.Pareto})
+	}
+	return out
+}
+
+// BestOfGroups порівнює проаналізовані групи за найкращими представниками: для
+// кожного критерію результату r значенням групи (у порядку groups) є значення її
+// найкращої альтернативи, а групи ранжуються так само, як ці альтернативи в загальному ранжуванні
+func BestOfGroups(r *Result, groups []GroupResult) []CriterionResult {
+	var out []CriterionResult
+	for _, c := range r.Criteria {
+		best := CriterionResult{Name: c.Name, Values: make([]float64, len(groups))}
+		var order []int
+		for _, alt := range c.Ranking {
+			g := slices.IndexFunc(groups, func(g GroupResult) bool { return slices.Contains(g.Alternatives, alt) })
+			if g < 0 || slices.Contains(order, g) {
+				continue
+			}
+			best.Values[g] = c.Values[slices.Index(r.Alternatives, alt)]
+			order = append(order, g)
+		}
+		for _, g := range order {
+			best.Ranking = append(best.Ranking, groups[g].Name)
+			if best.Values[g] == best.Values[order[0]] {
+				best.Best = append(best.Best, groups[g].Name)
+			}
+		}
+		out = append(out, best)
+	}
+	return out
+}
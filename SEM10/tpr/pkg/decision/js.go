@@ -0,0 +1,78 @@
+//go:build js && wasm
+
+package decision
+
+import (
+	"encoding/json"
+	"errors"
+	"syscall/js"
+)
+
+const errJSArgs = "очікується один аргумент – задача"
+
+// jsRequest – аргумент функцій computeCriteria і computePareto: об'єкт JavaScript
+// або рядок JSON у форматі запитів tpr serve
+type jsRequest struct {
+	Problem string   `json:"problem"`
+	Alpha   *float64 `json:"alpha"`
+	Matrix
+}
+
+// RegisterJS реєструє у глобальному об'єкті JavaScript функції
+// computeCriteria(задача) і computePareto(задача). Обидві повертають
+// об'єкт результату (як у tpr serve) або {error: "..."}
+func RegisterJS() {
+	js.Global().Set("computeCriteria", jsFunc(func(req *jsRequest) (*Result, error) {
+		alpha := 0.5
+		if req.Alpha != nil {
+			alpha = *req.Alpha
+		}
+		if err := ValidateAlpha(alpha); err != nil {
+			return nil, err
+		}
+		return Analyze(&req.Matrix, KindPayoff, alpha), nil
+	}))
+	js.Global().Set("computePareto", jsFunc(func(req *jsRequest) (*Result, error) {
+		if err := req.ValidateRanking(); err != nil {
+			return nil, err
+		}
+		return Analyze(&req.Matrix, KindRanking, 0), nil
+	}))
+}
+
+// jsFunc розбирає аргумент, перевіряє розміри матриці й перетворює результат
+// на об'єкт JavaScript; обмін іде через JSON, щоб не обходити вкладені масиви вручну
+func jsFunc(compute func(req *jsRequest) (*Result, error)) js.Func {
+	jsonObj := js.Global().Get("JSON")
+	fail := func(err error) any {
+		return map[string]any{"error": err.Error()}
+	}
+
+	return js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if len(args) != 1 {
+			return fail(errors.New(errJSArgs))
+		}
+		raw := args[0].String()
+		if args[0].Type() == js.TypeObject {
+			raw = jsonObj.Call("stringify", args[0]).String()
+		}
+
+		var req jsRequest
+		if err := json.Unmarshal([]byte(raw), &req); err != nil {
+			return fail(err)
+		}
+		if err := req.Validate(); err != nil {
+			return fail(err)
+		}
+		r, err := compute(&req)
+		if err != nil {
+			return fail(err)
+		}
+		r.Problem = req.Problem
+		out, err := json.Marshal(r)
+		if err != nil {
+			return fail(err)
+		}
+		return jsonObj.Call("parse", string(out))
+	})
+}
@@ -0,0 +1,62 @@
+package decision
+
+import "slices"
+
+// KendallDistance повертає нормовану відстань Кендалла τ між ранжуваннями двох
+// критеріїв однієї задачі: частку пар альтернатив, які критерії впорядковують
+// протилежно (0 – ранжування збігаються, 1 – обернені). Пара, рівноцінна за одним
+// критерієм і впорядкована за іншим, враховується з вагою ½.
+func KendallDistance(alts []string, a, b CriterionResult) float64 {
+	n := len(alts)
+	if n < 2 {
+		return 0
+	}
+	sa, sb := pairOrder(alts, a), pairOrder(alts, b)
+	d := 0.0
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			switch x, y := sa(i, j), sb(i, j); {
+			case x*y < 0:
+				d++
+			case x != y:
+				d += 0.5
+			}
+		}
+	}
+	return d / float64(n*(n-1)/2)
+}
+
+// pairOrder повертає порівняння альтернатив i та j за критерієм: −1, якщо i вище
+// в ранжуванні, 1 – якщо нижче, 0 – якщо значення критерію рівні
+func pairOrder(alts []string, c CriterionResult) func(i, j int) int {
+	pos := make([]int, len(alts))
+	for i, alt := range alts {
+		pos[i] = slices.Index(c.Ranking, alt)
+	}
+	return func(i, j int) int {
+		if c.Values[i] == c.Values[j] {
+			return 0
+		}
+		if pos[i] < pos[j] {
+			return -1
+		}
+		return 1
+	}
+}
+
+// KendallDistances повертає матрицю попарних відстаней Кендалла між ранжуваннями
+// критеріїв результату (у порядку Criteria)
+func (r *Result) KendallDistances() [][]float64 {
+	d := make([][]float64, len(r.Criteria))
+	for i, a := range r.Criteria {
+		d[i] = make([]float64, len(r.Criteria))
+		for j, b := range r.Criteria {
+			if j < i {
+				d[i][j] = d[j][i]
+			} else if j > i {
+				d[i][j] = KendallDistance(r.Alternatives, a, b)
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,68 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// LexicographicName – назва лексикографічного критерію в результатах
+const LexicographicName = "lex"
+
+// lexicographic – лексикографічний критерій: альтернативи порівнюються за виграшем
+// у найважливішому стані, за рівності – у наступному за важливістю і т. д.
+// Значення критерію – місце альтернативи (1 – найкраща, рівноцінні мають однакове місце).
+type lexicographic struct {
+	priority []string
+}
+
+// RegisterLexicographic реєструє лексикографічний критерій зі станами priority,
+// упорядкованими від найважливішого; стани, яких немає в переліку, не враховуються
+func RegisterLexicographic(priority []string) error {
+	if _, ok := Lookup(LexicographicName, Params{}); ok {
+		return fmt.Errorf("критерій %q уже визначено", LexicographicName)
+	}
+	c := lexicographic{slices.Clone(priority)}
+	Register(LexicographicName, func(Params) Criterion { return c })
+	return nil
+}
+
+func (lexicographic) Name() string         { return LexicographicName }
+func (lexicographic) Direction() Direction { return Minimize }
+
+func (c lexicographic) Evaluate(m *Matrix) map[string]float64 {
+	var cols []int
+	for _, state := range c.priority {
+		if j := slices.Index(m.Columns, state); j >= 0 {
+			cols = append(cols, j)
+		}
+	}
+	// compare повертає від'ємне число, якщо рядок a кращий за b
+	compare := func(a, b int) int {
+		for _, j := range cols {
+			if d := m.Values[b][j] - m.Values[a][j]; d != 0 {
+				if d < 0 {
+					return -1
+				}
+				return 1
+			}
+		}
+		return 0
+	}
+
+	order := make([]int, len(m.Alternatives))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return compare(order[a], order[b]) < 0 })
+
+	out := make(map[string]float64, len(m.Alternatives))
+	place := 0
+	for k, i := range order {
+		if k == 0 || compare(order[k-1], i) != 0 {
+			place++
+		}
+		out[m.Alternatives[i]] = float64(place)
+	}
+	return out
+}
@@ -0,0 +1,43 @@
+package decision
+
+const (
+	// KindPayoff – матриця корисності альтернатив за станами середовища
+	KindPayoff = "payoff"
+	// KindRanking – профіль ранжувань експертів (1 – найкраща альтернатива)
+	KindRanking = "ranking"
+)
+
+// Matrix – вхідна задача у форматі аркуша Excel програм tpr-2, tpr-3 і tpr-4
+// або JSON-запиту до сервера: Values[i][j] – значення альтернативи i
+// у стовпці j (стан або експерт)
+type Matrix struct {
+	Alternatives []string    `json:"alternatives"`
+	Columns      []string    `json:"columns"`
+	Values       [][]float64 `json:"values"`
+}
+
+// IsRanking перевіряє, чи кожен стовпець є перестановкою рангів 1…n,
+// тобто чи матриця є профілем ранжувань експертів, а не матрицею корисності
+func (m *Matrix) IsRanking() bool {
+	n := len(m.Alternatives)
+	for j := range m.Columns {
+		seen := make([]bool, n+1)
+		for i := range m.Alternatives {
+			r := m.Values[i][j]
+			if r != float64(int(r)) || r < 1 || r > float64(n) || seen[int(r)] {
+				return false
+			}
+			seen[int(r)] = true
+		}
+	}
+	return true
+}
+
+// column повертає значення всіх альтернатив у стовпці j
+func (m *Matrix) column(j int) []float64 {
+	column := make([]float64, len(m.Alternatives))
+	for i := range m.Alternatives {
+		column[i] = m.Values[i][j]
+	}
+	return column
+}
@@ -0,0 +1,70 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// MetaCriterion – назва зваженої метаоцінки серед критеріїв результату
+const MetaCriterion = "meta"
+
+// Помилки ваг метаоцінки
+var (
+	ErrNegativeWeight = errors.New("вага критерію не може бути від'ємною")
+	ErrZeroWeights    = errors.New("сума ваг критеріїв має бути додатною")
+)
+
+// MetaRanking обчислює зважену метаоцінку альтернатив за вже обчисленими
+// критеріями результату: значення кожного критерію нормалізуються способом
+// method (див. NormalizeValues) з урахуванням його напряму, а тоді усереднюються
+// з вагами weights (сума ваг не обов'язково дорівнює 1). Критерії без ваги не
+// враховуються. Більша метаоцінка – краще.
+func MetaRanking(r *Result, weights map[string]float64, method string) (CriterionResult, error) {
+	total := 0.0
+	for name, w := range weights {
+		if !slices.ContainsFunc(r.Criteria, func(c CriterionResult) bool { return c.Name == name }) {
+			return CriterionResult{}, &ValidationError{Field: "weights", Value: name, Err: ErrUnknownName}
+		}
+		if w < 0 {
+			return CriterionResult{}, &ValidationError{Field: "weights." + name, Value: w, Err: ErrNegativeWeight}
+		}
+		total += w
+	}
+	if total <= 0 {
+		return CriterionResult{}, &ValidationError{Field: "weights", Err: ErrZeroWeights}
+	}
+	if slices.ContainsFunc(r.Criteria, func(c CriterionResult) bool { return c.Name == MetaCriterion }) {
+		return CriterionResult{}, &ValidationError{Field: "criteria", Value: MetaCriterion, Err: ErrDuplicateName}
+	}
+
+	score := make([]float64, len(r.Alternatives))
+	for _, c := range r.Criteria {
+		w, ok := weights[c.Name]
+		if !ok || w == 0 {
+			continue
+		}
+		norm, err := NormalizeValues(c.Values, method, direction(r.Alternatives, c))
+		if err != nil {
+			return CriterionResult{}, fmt.Errorf("%s: %w", c.Name, err)
+		}
+		for i, v := range norm {
+			score[i] += w / total * v
+		}
+	}
+	return criterionResult(MetaCriterion, r.Alternatives, score, false), nil
+}
+
+// direction відновлює напрям критерію з його ранжування: якщо найкраща
+// альтернатива має менше значення, ніж найгірша, критерій мінімізується
+func direction(alts []string, c CriterionResult) Direction {
+	if len(c.Ranking) < 2 {
+		return Maximize
+	}
+	first := c.Values[slices.Index(alts, c.Ranking[0])]
+	last := c.Values[slices.Index(alts, c.Ranking[len(c.Ranking)-1])]
+	if first < last {
+		return Minimize
+	}
+	return Maximize
+}
@@ -0,0 +1,93 @@
+package decision
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+)
+
+// CriterionShare – частка ітерацій Монте-Карло, у яких альтернатива була
+// найкращою за критерієм (у порядку альтернатив задачі)
+type CriterionShare struct {
+	Name      string
+	BestShare []float64
+}
+
+// perturb повертає копію матриці, значення якої рівномірно відхиляються
+// від заданих на ±deviation (відносно)
+func (m *Matrix) perturb(rng *rand.Rand, deviation float64) *Matrix {
+	p := &Matrix{Alternatives: m.Alternatives, Columns: m.Columns, Values: make([][]float64, len(m.Values))}
+	for i, row := range m.Values {
+		p.Values[i] = make([]float64, len(row))
+		for j, v := range row {
+			p.Values[i][j] = v * (1 + deviation*(2*rng.Float64()-1))
+		}
+	}
+	return p
+}
+
+// MonteCarlo перевіряє стійкість рішень до неточності оцінок: iterations разів
+// обчислює критерії для збуреної матриці й рахує, як часто кожна альтернатива
+// найкраща (за рівних значень найкращими вважаються всі). progress викликається
+// кожні every ітерацій і після останньої; помилка progress перериває обчислення.
+// Після скасування ctx progress отримує частки за вже виконані ітерації,
+// а MonteCarlo повертає ctx.Err().
+//
+// correlation – кореляційна матриця збурень станів (див. ValidateCorrelation):
+// стани з додатною кореляцією відхиляються переважно в один бік. nil – збурення незалежні.
+func MonteCarlo(ctx context.Context, m *Matrix, alpha, deviation float64, correlation [][]float64, iterations, every int,
+	rng *rand.Rand, progress func(done int, shares []CriterionShare) error) error {
+	perturb := func() *Matrix { return m.perturb(rng, deviation) }
+	if correlation != nil {
+		l, err := cholesky(correlation, len(m.Columns))
+		if err != nil {
+			return err
+		}
+		perturb = func() *Matrix { return m.perturbCorrelated(rng, deviation, l) }
+	}
+
+	var names []string
+	var wins [][]int
+	for done := 1; done <= iterations; done++ {
+		if err := ctx.Err(); err != nil {
+			if done > 1 {
+				progress(done-1, shareOf(names, wins, done-1))
+			}
+			return err
+		}
+		r := Analyze(perturb(), KindPayoff, alpha)
+		if wins == nil {
+			for _, c := range r.Criteria {
+				names = append(names, c.Name)
+				wins = append(wins, make([]int, len(m.Alternatives)))
+			}
+		}
+		for k, c := range r.Criteria {
+			for i, alt := range m.Alternatives {
+				if slices.Contains(c.Best, alt) {
+					wins[k][i]++
+				}
+			}
+		}
+
+		if done%every != 0 && done != iterations {
+			continue
+		}
+		if err := progress(done, shareOf(names, wins, done)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shareOf перетворює кількість перемог за done ітерацій на частки
+func shareOf(names []string, wins [][]int, done int) []CriterionShare {
+	shares := make([]CriterionShare, len(names))
+	for k, name := range names {
+		shares[k] = CriterionShare{Name: name, BestShare: make([]float64, len(wins[k]))}
+		for i, w := range wins[k] {
+			shares[k].BestShare[i] = float64(w) / float64(done)
+		}
+	}
+	return shares
+}
@@ -0,0 +1,132 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Способи нормалізації шкали значень до [0, 1], де 1 – найкраще значення
+const (
+	// NormMinMax – (x − min) / (max − min); для критеріїв, що мінімізуються, (max − x) / (max − min)
+	NormMinMax = "minmax"
+	// NormMax – x / max; для критеріїв, що мінімізуються, min / x. Потрібні додатні значення
+	NormMax = "max"
+	// NormSum – x / Σx; для критеріїв, що мінімізуються, (1/x) / Σ(1/x). Потрібні додатні значення
+	NormSum = "sum"
+	// NormVector – x / ‖x‖, де ‖x‖ = √Σx²; для критеріїв, що мінімізуються, 1 − x / ‖x‖
+	NormVector = "vector"
+	// NormRank – за місцем у ранжуванні: найкраща 1, найгірша 0, однакові значення – однакова оцінка
+	NormRank = "rank"
+)
+
+// NormalizeMethods – підтримувані способи нормалізації
+var NormalizeMethods = []string{NormMinMax, NormMax, NormSum, NormVector, NormRank}
+
+// Помилки нормалізації
+var (
+	ErrNormalizeMethod = errors.New("невідомий спосіб нормалізації")
+	ErrNonPositive     = errors.New("спосіб нормалізації потребує додатних значень")
+	ErrNotFinite       = errors.New("значення не є скінченним числом")
+)
+
+// Normalize нормалізує кожен стовпець матриці корисності (більше – краще)
+// способом method і повертає нову матрицю з тими самими назвами
+func (m *Matrix) Normalize(method string) (*Matrix, error) {
+	out := &Matrix{
+		Alternatives: slices.Clone(m.Alternatives),
+		Columns:      slices.Clone(m.Columns),
+		Values:       make([][]float64, len(m.Values)),
+	}
+	for i := range m.Values {
+		out.Values[i] = make([]float64, len(m.Columns))
+	}
+	for j, col := range m.Columns {
+		norm, err := NormalizeValues(m.column(j), method, Maximize)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", col, err)
+		}
+		for i, v := range norm {
+			out.Values[i][j] = v
+		}
+	}
+	return out, nil
+}
+
+// NormalizeValues зводить значення до [0, 1] так, що більше – краще незалежно
+// від напряму dir. Якщо всі значення однакові, кожне отримує 1.
+func NormalizeValues(values []float64, method string, dir Direction) ([]float64, error) {
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, &ValidationError{Field: fmt.Sprintf("values[%d]", i), Value: v, Err: ErrNotFinite}
+		}
+	}
+	out := make([]float64, len(values))
+	if len(values) == 0 {
+		return out, nil
+	}
+	lo, hi := slices.Min(values), slices.Max(values)
+	if lo == hi {
+		for i := range out {
+			out[i] = 1
+		}
+		return out, nil
+	}
+
+	switch method {
+	case NormMinMax:
+		for i, v := range values {
+			out[i] = (v - lo) / (hi - lo)
+			if dir == Minimize {
+				out[i] = 1 - out[i]
+			}
+		}
+	case NormMax, NormSum:
+		if lo <= 0 {
+			return nil, &ValidationError{Field: "values", Value: lo, Err: ErrNonPositive}
+		}
+		total := 0.0
+		for i, v := range values {
+			out[i] = v
+			if dir == Minimize {
+				out[i] = 1 / v
+			}
+			total += out[i]
+		}
+		scale := total
+		if method == NormMax {
+			scale = slices.Max(out)
+		}
+		for i := range out {
+			out[i] /= scale
+		}
+	case NormVector:
+		norm := 0.0
+		for _, v := range values {
+			norm += v * v
+		}
+		norm = math.Sqrt(norm)
+		for i, v := range values {
+			out[i] = v / norm
+			if dir == Minimize {
+				out[i] = 1 - out[i]
+			}
+		}
+	case NormRank:
+		// Кількість різних значень, гірших за дане, відносно найбільшої такої кількості
+		distinct := slices.Clone(values)
+		slices.Sort(distinct)
+		distinct = slices.Compact(distinct)
+		for i, v := range values {
+			k, _ := slices.BinarySearch(distinct, v)
+			out[i] = float64(k) / float64(len(distinct)-1)
+			if dir == Minimize {
+				out[i] = 1 - out[i]
+			}
+		}
+	default:
+		return nil, &ValidationError{Field: "normalize", Value: method, Want: NormalizeMethods, Err: ErrNormalizeMethod}
+	}
+	return out, nil
+}
@@ -0,0 +1,27 @@
+package decision
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrInvalidRate – ставка дисконтування, за якої дисконтний множник не визначений
+var ErrInvalidRate = errors.New("ставка дисконтування має бути більшою за −1")
+
+// NPV повертає чисту приведену вартість грошових потоків flows за періодами
+// 0, 1, …, n (потік періоду 0 не дисконтується): Σ_t CF_t / (1 + rate)^t
+func NPV(flows []float64, rate float64) float64 {
+	npv := 0.0
+	for t, cf := range flows {
+		npv += cf / math.Pow(1+rate, float64(t))
+	}
+	return npv
+}
+
+// ValidateRate перевіряє ставку дисконтування
+func ValidateRate(rate float64) error {
+	if rate <= -1 {
+		return &ValidationError{Field: "rate", Value: rate, Err: ErrInvalidRate}
+	}
+	return nil
+}
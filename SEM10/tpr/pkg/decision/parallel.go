@@ -0,0 +1,94 @@
+package decision
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelThreshold – найменша кількість рядків, для якої обчислення ділиться між
+// горутинами; на менших матрицях накладні витрати перевищують виграш
+const parallelThreshold = 512
+
+// workers – кількість горутин для обчислень над рядками матриці (0 – runtime.NumCPU())
+var workers atomic.Int64
+
+// SetWorkers задає кількість горутин, між якими діляться рядки великих матриць під
+// час обчислення критеріїв, матриці жалю та множини Парето; n <= 0 – за кількістю процесорів,
+// 1 – обчислення в одній горутині
+func SetWorkers(n int) {
+	workers.Store(int64(max(n, 0)))
+}
+
+// Workers повертає кількість горутин для обчислень над рядками матриці
+func Workers() int {
+	if n := int(workers.Load()); n > 0 {
+		return n
+	}
+	return runtime.NumCPU()
+}
+
+// parallelFor викликає body для відрізків [lo; hi), які разом покривають [0; n);
+// для великих n відрізки обробляються одночасно в Workers() горутинах.
+// body не повинен записувати в спільні дані поза своїм відрізком.
+func parallelFor(n int, body func(lo, hi int)) {
+	w := Workers()
+	if w <= 1 || n < parallelThreshold {
+		body(0, n)
+		return
+	}
+	chunk := (n + w - 1) / w
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += chunk {
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			body(lo, hi)
+		}(lo, min(lo+chunk, n))
+	}
+	wg.Wait()
+}
+
+// byRowValues обчислює value для кожного рядка матриці й повертає значення за назвами альтернатив
+func byRowValues(m *Matrix, value func(i int) float64) map[string]float64 {
+	values := make([]float64, len(m.Alternatives))
+	parallelFor(len(values), func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			values[i] = value(i)
+		}
+	})
+	out := make(map[string]float64, len(values))
+	for i, alt := range m.Alternatives {
+		out[alt] = values[i]
+	}
+	return out
+}
+
+// RegretMatrix повертає матрицю жалю Севіджа: max_a u(a, j) − u(a, j)
+func RegretMatrix(m *Matrix) *Matrix {
+	return regretMatrix(m, func(a, b float64) float64 { return a - b })
+}
+
+// exactRegretMatrix – матриця жалю, обчислена в раціональних числах
+func exactRegretMatrix(m *Matrix) *Matrix {
+	return regretMatrix(m, exactSub)
+}
+
+func regretMatrix(m *Matrix, sub func(a, b float64) float64) *Matrix {
+	maxima := make([]float64, len(m.Columns))
+	for j := range maxima {
+		maxima[j] = slices.Max(m.column(j))
+	}
+	r := &Matrix{Alternatives: m.Alternatives, Columns: m.Columns, Values: make([][]float64, len(m.Values))}
+	parallelFor(len(m.Values), func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			row := make([]float64, len(maxima))
+			for j, v := range m.Values[i] {
+				row[j] = sub(maxima[j], v)
+			}
+			r.Values[i] = row
+		}
+	})
+	return r
+}
@@ -0,0 +1,59 @@
+package decision
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+// benchMatrix – випадкова матриця корисності rows×cols з фіксованим зерном
+func benchMatrix(rows, cols int) *Matrix {
+	rng := rand.New(rand.NewPCG(1, 2))
+	m := &Matrix{Alternatives: make([]string, rows), Columns: make([]string, cols), Values: make([][]float64, rows)}
+	for j := range m.Columns {
+		m.Columns[j] = fmt.Sprintf("s%d", j+1)
+	}
+	for i := range m.Values {
+		m.Alternatives[i] = fmt.Sprintf("a%d", i+1)
+		m.Values[i] = make([]float64, cols)
+		for j := range m.Values[i] {
+			m.Values[i][j] = rng.Float64() * 100
+		}
+	}
+	return m
+}
+
+// benchWorkers запускає bench для однієї горутини та для кількості процесорів
+func benchWorkers(b *testing.B, bench func(b *testing.B)) {
+	defer SetWorkers(0)
+	for _, w := range slices.Compact([]int{1, runtime.NumCPU()}) {
+		b.Run(fmt.Sprintf("workers=%d", w), func(b *testing.B) {
+			SetWorkers(w)
+			bench(b)
+		})
+	}
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	for _, size := range []struct{ rows, cols int }{{100, 10}, {2000, 10}, {5000, 20}} {
+		m := benchMatrix(size.rows, size.cols)
+		b.Run(fmt.Sprintf("%dx%d", size.rows, size.cols), func(b *testing.B) {
+			benchWorkers(b, func(b *testing.B) {
+				for range b.N {
+					Analyze(m, KindPayoff, 0.5)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkRegretMatrix(b *testing.B) {
+	m := benchMatrix(20000, 20)
+	benchWorkers(b, func(b *testing.B) {
+		for range b.N {
+			RegretMatrix(m)
+		}
+	})
+}
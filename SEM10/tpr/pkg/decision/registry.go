@@ -0,0 +1,115 @@
+package decision
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Direction – напрям оптимізації критерію
+type Direction int
+
+const (
+	// Maximize – найкраща альтернатива має найбільше значення критерію
+	Maximize Direction = iota
+	// Minimize – найкраща альтернатива має найменше значення (наприклад, жаль Севіджа)
+	Minimize
+)
+
+type (
+	// Criterion – критерій прийняття рішень (див. також KindFilter). Evaluate
+	// повертає значення критерію для кожної альтернативи за її назвою.
+	Criterion interface {
+		Name() string
+		Direction() Direction
+		Evaluate(m *Matrix) map[string]float64
+	}
+
+	// KindFilter – необов'язковий інтерфейс критерію з переліком типів задач
+	// (KindPayoff, KindRanking), для яких він обчислюється. Критерії без нього
+	// обчислюються лише для матриць корисності.
+	KindFilter interface {
+		Kinds() []string
+	}
+
+	// Params – параметри аналізу, від яких може залежати критерій
+	Params struct {
+		// Alpha – коефіцієнт оптимізму (критерій Гурвіца)
+		Alpha float64
+		// Kind – тип задачі: KindPayoff або KindRanking
+		Kind string
+		// Exact – обчислювати середні значення (Лаплас), зважену суму Гурвіца й жаль
+		// (Севідж) у точних раціональних числах, щоб результати збігалися з обчисленнями
+		// вручну без похибок на кшталт 6.999999
+		Exact bool
+	}
+
+	// CriterionFactory створює критерій для параметрів конкретного аналізу
+	CriterionFactory func(p Params) Criterion
+)
+
+var registry struct {
+	sync.RWMutex
+	names     []string
+	factories map[string]CriterionFactory
+}
+
+// Register додає критерій до реєстру. Analyze обчислює критерії в порядку
+// реєстрації, тому вбудовані йдуть першими, а власні – після них. Зазвичай
+// викликається з init() пакета з критеріями, який достатньо імпортувати:
+//
+//	import _ "example.com/mycriteria"
+//
+// Повторна реєстрація назви – помилка програміста, тому Register панікує.
+func Register(name string, factory CriterionFactory) {
+	registry.Lock()
+	defer registry.Unlock()
+	if factory == nil {
+		panic("decision: Register factory is nil for " + name)
+	}
+	if _, dup := registry.factories[name]; dup {
+		panic(fmt.Sprintf("decision: Register called twice for criterion %q", name))
+	}
+	if registry.factories == nil {
+		registry.factories = make(map[string]CriterionFactory)
+	}
+	registry.names = append(registry.names, name)
+	registry.factories[name] = factory
+}
+
+// Registered повертає назви зареєстрованих критеріїв у порядку реєстрації
+func Registered() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	return append([]string(nil), registry.names...)
+}
+
+// Lookup створює зареєстрований критерій за назвою
+func Lookup(name string, p Params) (Criterion, bool) {
+	registry.RLock()
+	factory, ok := registry.factories[name]
+	registry.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return factory(p), true
+}
+
+// appliesTo перевіряє, чи обчислюється критерій для задачі типу kind
+func appliesTo(c Criterion, kind string) bool {
+	f, ok := c.(KindFilter)
+	if !ok {
+		return kind != KindRanking
+	}
+	return slices.Contains(f.Kinds(), kind)
+}
+
+// Evaluate обчислює критерій і ранжує альтернативи відповідно до його напряму
+func Evaluate(c Criterion, m *Matrix) CriterionResult {
+	byName := c.Evaluate(m)
+	values := make([]float64, len(m.Alternatives))
+	for i, alt := range m.Alternatives {
+		values[i] = byName[alt]
+	}
+	return criterionResult(c.Name(), m.Alternatives, values, c.Direction() == Minimize)
+}
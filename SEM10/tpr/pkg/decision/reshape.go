@@ -0,0 +1,169 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Помилки перетворення матриць
+var (
+	ErrUnknownName   = errors.New("невідома назва")
+	ErrDuplicateName = errors.New("назва повторюється")
+	ErrIncomplete    = errors.New("перелік має містити всі назви")
+	ErrMergeMismatch = errors.New("матриці не збігаються за назвами")
+)
+
+// Transpose міняє місцями рядки й стовпці: стани (експерти) стають рядками.
+// Використовується, коли дані підготовлено з альтернативами в стовпцях.
+func (m *Matrix) Transpose() *Matrix {
+	t := &Matrix{
+		Alternatives: slices.Clone(m.Columns),
+		Columns:      slices.Clone(m.Alternatives),
+		Values:       make([][]float64, len(m.Columns)),
+	}
+	for j := range m.Columns {
+		t.Values[j] = m.column(j)
+	}
+	return t
+}
+
+// Rename перейменовує альтернативи та стовпці за відповідностями стара → нова
+// назва. Назви, яких немає в матриці, і повтори після перейменування – помилка.
+func (m *Matrix) Rename(alternatives, columns map[string]string) (*Matrix, error) {
+	rename := func(field string, names []string, mapping map[string]string) ([]string, error) {
+		for old := range mapping {
+			if !slices.Contains(names, old) {
+				return nil, &ValidationError{Field: field, Value: old, Err: ErrUnknownName}
+			}
+		}
+		out := make([]string, len(names))
+		for i, name := range names {
+			out[i] = name
+			if n, ok := mapping[name]; ok {
+				out[i] = n
+			}
+			if slices.Contains(out[:i], out[i]) {
+				return nil, &ValidationError{Field: field, Value: out[i], Err: ErrDuplicateName}
+			}
+		}
+		return out, nil
+	}
+
+	alts, err := rename("alternatives", m.Alternatives, alternatives)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := rename("columns", m.Columns, columns)
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]float64, len(m.Values))
+	for i, row := range m.Values {
+		values[i] = slices.Clone(row)
+	}
+	return &Matrix{Alternatives: alts, Columns: cols, Values: values}, nil
+}
+
+// Reorder переставляє альтернативи та стовпці в заданому порядку. Порожній
+// перелік залишає порядок без змін; непорожній має містити кожну назву рівно раз.
+func (m *Matrix) Reorder(alternatives, columns []string) (*Matrix, error) {
+	order := func(field string, names, want []string) ([]int, error) {
+		if len(want) == 0 {
+			idx := make([]int, len(names))
+			for i := range idx {
+				idx[i] = i
+			}
+			return idx, nil
+		}
+		idx := make([]int, len(want))
+		for k, name := range want {
+			i := slices.Index(names, name)
+			if i < 0 {
+				return nil, &ValidationError{Field: field, Value: name, Err: ErrUnknownName}
+			}
+			if slices.Contains(want[:k], name) {
+				return nil, &ValidationError{Field: field, Value: name, Err: ErrDuplicateName}
+			}
+			idx[k] = i
+		}
+		if len(want) != len(names) {
+			return nil, &ValidationError{Field: field, Value: len(want), Want: len(names), Err: ErrIncomplete}
+		}
+		return idx, nil
+	}
+
+	rows, err := order("alternatives", m.Alternatives, alternatives)
+	if err != nil {
+		return nil, err
+	}
+	cols, err := order("columns", m.Columns, columns)
+	if err != nil {
+		return nil, err
+	}
+	out := &Matrix{Values: make([][]float64, len(rows))}
+	for _, j := range cols {
+		out.Columns = append(out.Columns, m.Columns[j])
+	}
+	for k, i := range rows {
+		out.Alternatives = append(out.Alternatives, m.Alternatives[i])
+		out.Values[k] = make([]float64, len(cols))
+		for c, j := range cols {
+			out.Values[k][c] = m.Values[i][j]
+		}
+	}
+	return out, nil
+}
+
+// MergeColumns об'єднує матриці з однаковими альтернативами (у будь-якому
+// порядку), дописуючи стовпці b після стовпців a, – наприклад, ранжування двох
+// груп експертів. Стовпці з однаковими назвами – помилка.
+func MergeColumns(a, b *Matrix) (*Matrix, error) {
+	if len(a.Alternatives) != len(b.Alternatives) {
+		return nil, fmt.Errorf("%w: альтернатив %d і %d", ErrMergeMismatch, len(a.Alternatives), len(b.Alternatives))
+	}
+	aligned, err := b.Reorder(a.Alternatives, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMergeMismatch, err)
+	}
+	for _, c := range b.Columns {
+		if slices.Contains(a.Columns, c) {
+			return nil, &ValidationError{Field: "columns", Value: c, Err: ErrDuplicateName}
+		}
+	}
+	out := &Matrix{
+		Alternatives: slices.Clone(a.Alternatives),
+		Columns:      slices.Concat(a.Columns, b.Columns),
+		Values:       make([][]float64, len(a.Values)),
+	}
+	for i := range a.Values {
+		out.Values[i] = slices.Concat(a.Values[i], aligned.Values[i])
+	}
+	return out, nil
+}
+
+// MergeRows об'єднує матриці з однаковими стовпцями (у будь-якому порядку),
+// дописуючи альтернативи b після альтернатив a. Повтор альтернативи – помилка.
+func MergeRows(a, b *Matrix) (*Matrix, error) {
+	if len(a.Columns) != len(b.Columns) {
+		return nil, fmt.Errorf("%w: стовпців %d і %d", ErrMergeMismatch, len(a.Columns), len(b.Columns))
+	}
+	aligned, err := b.Reorder(nil, a.Columns)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMergeMismatch, err)
+	}
+	for _, alt := range b.Alternatives {
+		if slices.Contains(a.Alternatives, alt) {
+			return nil, &ValidationError{Field: "alternatives", Value: alt, Err: ErrDuplicateName}
+		}
+	}
+	out := &Matrix{
+		Alternatives: slices.Concat(a.Alternatives, b.Alternatives),
+		Columns:      slices.Clone(a.Columns),
+	}
+	for _, row := range a.Values {
+		out.Values = append(out.Values, slices.Clone(row))
+	}
+	out.Values = append(out.Values, aligned.Values...)
+	return out, nil
+}
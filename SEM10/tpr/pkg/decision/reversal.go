@@ -0,0 +1,68 @@
+package decision
+
+import "slices"
+
+// Reversal – результат повторного аналізу без однієї альтернативи за одним критерієм
+type Reversal struct {
+	Criterion string `json:"criterion"`
+	Removed   string `json:"removed"`
+	// Ranking – ранжування решти альтернатив після видалення
+	Ranking []string `json:"ranking"`
+	// Pairs – пари [a, b]: до видалення a була вище за b, після – нижче
+	Pairs [][2]string `json:"pairs,omitempty"`
+}
+
+// RankReversal для кожного критерію матриці корисності по черзі видаляє кожну
+// альтернативу, крім найкращих, перераховує критерій і порівнює порядок решти
+// альтернатив із початковим. Непорожнє Pairs означає rank reversal.
+func RankReversal(m *Matrix, alpha float64) []Reversal {
+	p := Params{Alpha: alpha, Kind: KindPayoff}
+	var out []Reversal
+	for _, name := range Registered() {
+		c, _ := Lookup(name, p)
+		if !appliesTo(c, KindPayoff) {
+			continue
+		}
+		base := Evaluate(c, m)
+		for _, removed := range base.Ranking {
+			if slices.Contains(base.Best, removed) {
+				continue
+			}
+			i := slices.Index(m.Alternatives, removed)
+			reduced := &Matrix{
+				Alternatives: slices.Delete(slices.Clone(m.Alternatives), i, i+1),
+				Columns:      m.Columns,
+				Values:       slices.Delete(slices.Clone(m.Values), i, i+1),
+			}
+			after := Evaluate(c, reduced)
+			out = append(out, Reversal{
+				Criterion: name,
+				Removed:   removed,
+				Ranking:   after.Ranking,
+				Pairs:     reversedPairs(base.Ranking, after.Ranking),
+			})
+		}
+	}
+	return out
+}
+
+// reversedPairs повертає пари альтернатив з обох ранжувань, відносний порядок яких змінився
+func reversedPairs(before, after []string) [][2]string {
+	pos := make(map[string]int, len(after))
+	for i, alt := range after {
+		pos[alt] = i
+	}
+	var pairs [][2]string
+	for i, a := range before {
+		pa, ok := pos[a]
+		if !ok {
+			continue
+		}
+		for _, b := range before[i+1:] {
+			if pb, ok := pos[b]; ok && pb < pa {
+				pairs = append(pairs, [2]string{a, b})
+			}
+		}
+	}
+	return pairs
+}
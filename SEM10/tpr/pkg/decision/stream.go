@@ -0,0 +1,133 @@
+package decision
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// ErrStreamChanged – другий прохід потокового обчислення прочитав інші рядки, ніж перший
+var ErrStreamChanged = errors.New("дані змінилися між проходами")
+
+type (
+	// RowReader – джерело рядків матриці корисності для потокового обчислення критеріїв
+	RowReader interface {
+		// Next повертає наступну альтернативу та її рядок; io.EOF – рядків більше немає.
+		// Рядок дійсний лише до наступного виклику Next.
+		Next() (alt string, row []float64, err error)
+	}
+
+	// StreamResult – критерій, обчислений потоково: без значень і ранжування всіх
+	// альтернатив, лише найкраще значення та альтернативи, що його досягають.
+	// Ties – кількість таких альтернатив; Best містить не більше limit перших з них.
+	StreamResult struct {
+		Name      string
+		Direction Direction
+		Value     float64
+		Best      []string
+		Ties      int
+	}
+
+	// streamBest – найкраще значення критерію серед уже прочитаних рядків
+	streamBest struct {
+		StreamResult
+		value func(row []float64) float64
+		limit int
+	}
+)
+
+// add враховує значення v альтернативи alt
+func (b *streamBest) add(alt string, v float64) {
+	switch {
+	case b.Ties == 0 || b.Direction == Maximize && v > b.Value || b.Direction == Minimize && v < b.Value:
+		b.Value, b.Best, b.Ties = v, append(b.Best[:0], alt), 1
+	case v == b.Value:
+		if b.limit < 1 || len(b.Best) < b.limit {
+			b.Best = append(b.Best, alt)
+		}
+		b.Ties++
+	}
+}
+
+// Stream обчислює критерії Вальда, maxmax, Лапласа та Севіджа для матриці корисності,
+// рядки якої читаються з open() по одному, тож у пам'яті зберігаються лише найбільші
+// значення станів і найкращі альтернативи. Критерій Севіджа потребує максимумів станів,
+// тому обчислюється другим проходом: open викликається двічі й має повертати ті самі рядки.
+// limit обмежує кількість найкращих альтернатив, що зберігаються для кожного критерію,
+// щоб нічия мільйонів рядків не займала пам'ять (limit < 1 – без обмеження).
+// Повертає кількість альтернатив і результати критеріїв.
+func Stream(open func() (RowReader, error), limit int) (int, []StreamResult, error) {
+	byRow := []*streamBest{
+		{StreamResult{Name: "wald", Direction: Maximize}, slices.Min[[]float64], limit},
+		{StreamResult{Name: "maxmax", Direction: Maximize}, slices.Max[[]float64], limit},
+		{StreamResult{Name: "laplace", Direction: Maximize}, mean, limit},
+	}
+	var maxima []float64
+	rows, err := streamPass(open, func(i int, alt string, row []float64) error {
+		if i == 0 {
+			maxima = slices.Clone(row)
+		}
+		if len(row) != len(maxima) {
+			return &ValidationError{Field: fmt.Sprintf("values[%d]", i), Value: len(row), Want: len(maxima), Err: ErrInvalidRowLength}
+		}
+		for j, v := range row {
+			maxima[j] = max(maxima[j], v)
+		}
+		for _, c := range byRow {
+			c.add(alt, c.value(row))
+		}
+		return nil
+	})
+	switch {
+	case err != nil:
+		return 0, nil, err
+	case rows == 0 || len(maxima) == 0:
+		return 0, nil, ErrEmptyProblem
+	}
+
+	savage := &streamBest{StreamResult: StreamResult{Name: "savage", Direction: Minimize}, limit: limit}
+	again, err := streamPass(open, func(i int, alt string, row []float64) error {
+		if i >= rows || len(row) != len(maxima) {
+			return ErrStreamChanged
+		}
+		regret := 0.0
+		for j, v := range row {
+			regret = max(regret, maxima[j]-v)
+		}
+		savage.add(alt, regret)
+		return nil
+	})
+	switch {
+	case err != nil:
+		return 0, nil, err
+	case again != rows:
+		return 0, nil, ErrStreamChanged
+	}
+
+	results := []StreamResult{byRow[0].StreamResult, byRow[1].StreamResult, savage.StreamResult, byRow[2].StreamResult}
+	return rows, results, nil
+}
+
+// streamPass читає всі рядки з open() і передає їх visit; повертає кількість рядків
+func streamPass(open func() (RowReader, error), visit func(i int, alt string, row []float64) error) (int, error) {
+	r, err := open()
+	if err != nil {
+		return 0, err
+	}
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+	for i := 0; ; i++ {
+		alt, row, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return i, nil
+		}
+		if err != nil {
+			return i, err
+		}
+		if err := visit(i, alt, row); err != nil {
+			return i, err
+		}
+	}
+}
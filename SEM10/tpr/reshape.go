@@ -0,0 +1,170 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errTransposeFile = "Вкажіть файл задачі: tpr transpose <файл.xlsx> -o <результат.xlsx>"
+	errReshapeFile   = "Вкажіть файл задачі: tpr reshape <файл.xlsx> -o <результат.xlsx> [-rename-alternatives …] [-alternatives …]"
+	errMergeFiles    = "Вкажіть два файли задач: tpr merge <a.xlsx> <b.xlsx> -o <результат.xlsx> [-by columns|rows]"
+	errReshapeOutput = "Вкажіть файл для збереження результату: -o <файл.xlsx>"
+	errReshapeRename = "Некоректна відповідність '%s': потрібно стара=нова"
+	errMergeBy       = "Невідомий спосіб об'єднання '%s': потрібен %s або %s"
+
+	mergeByColumns = "columns"
+	mergeByRows    = "rows"
+)
+
+// saveMatrix записує матрицю на перший аркуш нової книги у форматі, який зчитує loadMatrix
+func saveMatrix(path string, m *decision.Matrix) error {
+	p := Problem{Header: append([]string{"Альтернатива"}, m.Columns...)}
+	for i, alt := range m.Alternatives {
+		row := []any{alt}
+		for _, v := range m.Values[i] {
+			row = append(row, v)
+		}
+		p.Rows = append(p.Rows, row)
+	}
+	return p.SaveXLSX(path)
+}
+
+// splitList розбирає перелік назв через кому; порожній рядок дає порожній перелік
+func splitList(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return parts
+}
+
+// parseRenames розбирає відповідності "стара=нова" через кому
+func parseRenames(s string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, pair := range splitList(s) {
+		old, name, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(old) == "" || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf(errReshapeRename, pair)
+		}
+		out[strings.TrimSpace(old)] = strings.TrimSpace(name)
+	}
+	return out, nil
+}
+
+func printSaved(path string, m *decision.Matrix) {
+	fmt.Printf("Матрицю %d×%d збережено у файл %s\n", len(m.Alternatives), len(m.Columns), path)
+	fmt.Printf("  альтернативи: %s\n  стовпці: %s\n", strings.Join(m.Alternatives, ", "), strings.Join(m.Columns, ", "))
+}
+
+func runTranspose(args []string) error {
+	fs := flag.NewFlagSet("transpose", flag.ExitOnError)
+	sheet := fs.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	output := fs.String("o", "", "файл Excel для збереження транспонованої матриці")
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf(errTransposeFile)
+	}
+	if *output == "" {
+		return fmt.Errorf(errReshapeOutput)
+	}
+
+	m, err := loadMatrix(positional[0], *sheet)
+	if err != nil {
+		return err
+	}
+	t := m.Transpose()
+	if err := saveMatrix(*output, t); err != nil {
+		return err
+	}
+	printSaved(*output, t)
+	return nil
+}
+
+func runReshape(args []string) error {
+	fs := flag.NewFlagSet("reshape", flag.ExitOnError)
+	sheet := fs.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	output := fs.String("o", "", "файл Excel для збереження результату")
+	renameAlts := fs.String("rename-alternatives", "", "перейменувати альтернативи: стара=нова через кому")
+	renameCols := fs.String("rename-columns", "", "перейменувати стани (експертів): стара=нова через кому")
+	alts := fs.String("alternatives", "", "новий порядок альтернатив через кому (після перейменування)")
+	cols := fs.String("columns", "", "новий порядок станів (експертів) через кому (після перейменування)")
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf(errReshapeFile)
+	}
+	if *output == "" {
+		return fmt.Errorf(errReshapeOutput)
+	}
+	altNames, err := parseRenames(*renameAlts)
+	if err != nil {
+		return err
+	}
+	colNames, err := parseRenames(*renameCols)
+	if err != nil {
+		return err
+	}
+
+	m, err := loadMatrix(positional[0], *sheet)
+	if err != nil {
+		return err
+	}
+	if m, err = m.Rename(altNames, colNames); err != nil {
+		return err
+	}
+	if m, err = m.Reorder(splitList(*alts), splitList(*cols)); err != nil {
+		return err
+	}
+	if err := saveMatrix(*output, m); err != nil {
+		return err
+	}
+	printSaved(*output, m)
+	return nil
+}
+
+func runMerge(args []string) error {
+	fs := flag.NewFlagSet("merge", flag.ExitOnError)
+	sheet := fs.String("sheet", "", "аркуш обох книг Excel з матрицями (за замовчуванням перший)")
+	output := fs.String("o", "", "файл Excel для збереження об'єднаної матриці")
+	by := fs.String("by", mergeByColumns, "columns – дописати стани (експертів) другої матриці до тих самих альтернатив, "+
+		"rows – дописати альтернативи до тих самих станів")
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 2 {
+		return fmt.Errorf(errMergeFiles)
+	}
+	if *output == "" {
+		return fmt.Errorf(errReshapeOutput)
+	}
+	merge := decision.MergeColumns
+	switch *by {
+	case mergeByColumns:
+	case mergeByRows:
+		merge = decision.MergeRows
+	default:
+		return fmt.Errorf(errMergeBy, *by, mergeByColumns, mergeByRows)
+	}
+
+	a, err := loadMatrix(positional[0], *sheet)
+	if err != nil {
+		return err
+	}
+	b, err := loadMatrix(positional[1], *sheet)
+	if err != nil {
+		return err
+	}
+	m, err := merge(a, b)
+	if err != nil {
+		return err
+	}
+	if err := saveMatrix(*output, m); err != nil {
+		return err
+	}
+	printSaved(*output, m)
+	return nil
+}
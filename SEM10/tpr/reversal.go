@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errReversalFile = "Вкажіть файл задачі: tpr reversal <файл.xlsx> [прапорці]"
+	errReversalKind = "Файл %s містить ранжування експертів: перевірка rank reversal виконується для матриці корисності"
+)
+
+// PrintReversals виводить для кожного критерію, чи змінює видалення альтернативи порядок решти
+func PrintReversals(r *decision.Result, reversals []decision.Reversal) {
+	for _, c := range r.Criteria {
+		fmt.Printf("\n%s (ранжування: %s)\n", c.Name, strings.Join(c.Ranking, " ≻ "))
+		count, total := 0, 0
+		for _, rev := range reversals {
+			if rev.Criterion != c.Name {
+				continue
+			}
+			total++
+			status := "без змін"
+			if len(rev.Pairs) > 0 {
+				status = "rank reversal"
+				count++
+			}
+			fmt.Printf("  без %-12s %-14s %s\n", rev.Removed, status, strings.Join(rev.Ranking, " ≻ "))
+			for _, p := range rev.Pairs {
+				fmt.Printf("  %18s %s ≻ %s → %s ≻ %s\n", "", p[0], p[1], p[1], p[0])
+			}
+		}
+		if total > 0 {
+			fmt.Printf("  Змін порядку: %d з %d\n", count, total)
+		}
+	}
+}
+
+func runReversal(args []string) error {
+	fs := flag.NewFlagSet("reversal", flag.ExitOnError)
+	alpha := fs.Float64("alpha", 0.5, "коефіцієнт оптимізму α для критерію Гурвіца")
+	sheet := fs.String("sheet", "", "аркуш книги Excel з матрицею (за замовчуванням перший)")
+	format := fs.String("format", "text", "формат виводу: text або json")
+	fs.Var(criterionFlag{}, "criterion", criterionUsage)
+	fs.Var(scriptFlag{}, "script", scriptUsage)
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf(errReversalFile)
+	}
+
+	if *format != "text" && *format != formatJSON {
+		return fmt.Errorf(errBatchFormat, *format, "text", formatJSON)
+	}
+	if err := decision.ValidateAlpha(*alpha); err != nil {
+		return err
+	}
+
+	m, err := loadMatrix(positional[0], *sheet)
+	if err != nil {
+		return err
+	}
+	if m.IsRanking() {
+		return fmt.Errorf(errReversalKind, positional[0])
+	}
+	reversals := decision.RankReversal(m, *alpha)
+	if *format == formatJSON {
+		return writeJSON(os.Stdout, reversals)
+	}
+	fmt.Println("Перевірка rank reversal: кожна альтернатива, крім найкращої, по черзі видаляється, а критерій перераховується")
+	PrintReversals(decision.Analyze(m, kindPayoff, *alpha), reversals)
+	return nil
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errScenariosFile   = "Вкажіть файл сценаріїв: tpr scenarios <сценарії.json|yaml> [-alpha α] або tpr scenarios add|remove …"
+	errScenariosAdd    = "Вкажіть файл сценаріїв, назву сценарію та файл задачі: tpr scenarios add <сценарії.json> <назва> <задача.xlsx|csv|json|yaml|tpr>"
+	errScenariosRemove = "Вкажіть файл сценаріїв і назву сценарію: tpr scenarios remove <сценарії.json> <назва>"
+	errScenarioJSON    = "%s: змінювати можна лише файл сценаріїв у форматі JSON"
+	errScenarioExists  = "Сценарій '%s' уже є у файлі %s"
+	errScenarioMissing = "Сценарію '%s' немає у файлі %s"
+	errScenarioShape   = "%s: альтернативи (%s) і стани (%s) мають збігатися з іншими сценаріями (%s; %s)"
+	errScenarioName    = "назва сценарію '%s' повторюється"
+)
+
+type (
+	// scenarioSet – кілька варіантів однієї задачі (наприклад, оптимістичний,
+	// базовий і песимістичний) зі спільними альтернативами та станами
+	scenarioSet struct {
+		Problem      string     `json:"problem,omitempty"`
+		Alternatives []string   `json:"alternatives"`
+		Columns      []string   `json:"columns"`
+		Scenarios    []scenario `json:"scenarios"`
+	}
+
+	// scenario – іменований варіант матриці корисності
+	scenario struct {
+		Name   string      `json:"name"`
+		Values [][]float64 `json:"values"`
+	}
+)
+
+var scenarioSetSchema = &schema{
+	Type:                 "object",
+	Description:          "варіанти однієї задачі: спільні альтернативи та стани, окрема матриця корисності для кожного сценарію",
+	Required:             []string{"alternatives", "columns", "scenarios"},
+	AdditionalProperties: ptr(false),
+	Properties: map[string]*schema{
+		"problem":      {Type: "string", Description: "назва задачі"},
+		"alternatives": stringArray("назви альтернатив"),
+		"columns":      stringArray("назви станів"),
+		"scenarios": {
+			Type:     "array",
+			MinItems: 1,
+			Items: &schema{
+				Type:                 "object",
+				Required:             []string{"name", "values"},
+				AdditionalProperties: ptr(false),
+				Properties: map[string]*schema{
+					"name": {Type: "string", Description: "назва сценарію, наприклад «Песимістичний»"},
+					"values": {
+						Type:        "array",
+						Description: "матриця корисності сценарію: values[i][j] – корисність альтернативи i за стану j",
+						MinItems:    1,
+						Items:       &schema{Type: "array", Items: &schema{Type: "number"}},
+					},
+				},
+			},
+		},
+	},
+}
+
+// matrix повертає матрицю корисності сценарію k
+func (s *scenarioSet) matrix(k int) *decision.Matrix {
+	return &decision.Matrix{Alternatives: s.Alternatives, Columns: s.Columns, Values: s.Scenarios[k].Values}
+}
+
+// checkScenarios перевіряє файл сценаріїв (JSON або YAML) за схемою та розмірами
+// матриці кожного сценарію; помилки вказують на рядки файлу
+func checkScenarios(path string, data []byte) (*scenarioSet, []diagnostic) {
+	lines := fieldLines
+	if formatByExt(path) == formatYAML {
+		js, err := yamlToJSON(data)
+		if err != nil {
+			return nil, []diagnostic{{1, fieldError{Message: fmt.Sprintf(errValidateYAML, err)}}}
+		}
+		source := data
+		data, lines = js, func([]byte) map[string]int { return yamlFieldLines(source) }
+	}
+
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, []diagnostic{{syntaxLine(data), fieldError{Message: fmt.Sprintf(errValidateSyntax, err)}}}
+	}
+	errs := validateValue(raw, scenarioSetSchema, "")
+	var s scenarioSet
+	if len(errs) == 0 {
+		json.Unmarshal(data, &s)
+		for k, sc := range s.Scenarios {
+			field := fmt.Sprintf("scenarios[%d]", k)
+			if slices.ContainsFunc(s.Scenarios[:k], func(o scenario) bool { return o.Name == sc.Name }) {
+				errs = append(errs, fieldError{Field: field + ".name", Message: fmt.Sprintf(errScenarioName, sc.Name)})
+			}
+			for _, e := range validateMatrix(s.matrix(k)) {
+				// Помилки альтернатив і станів спільні для всіх сценаріїв – досить повідомити їх раз
+				if strings.HasPrefix(e.Field, "values") {
+					e.Field = joinPath(field, e.Field)
+				} else if k > 0 {
+					continue
+				}
+				errs = append(errs, e)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, locateErrors(errs, lines(data), 1)
+	}
+	return &s, nil
+}
+
+// loadScenarios зчитує й перевіряє файл сценаріїв
+func loadScenarios(path string) (*scenarioSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, diags := checkScenarios(path, data)
+	if len(diags) > 0 {
+		lines := make([]string, len(diags))
+		for i, d := range diags {
+			lines[i] = d.format(path)
+		}
+		return nil, fmt.Errorf(errProblemFormat, path, strings.Join(lines, "\n"))
+	}
+	return s, nil
+}
+
+// CompareScenarios обчислює всі критерії для кожного сценарію
+func CompareScenarios(s *scenarioSet, alpha float64) []*decision.Result {
+	results := make([]*decision.Result, len(s.Scenarios))
+	for k, sc := range s.Scenarios {
+		results[k] = decision.Analyze(s.matrix(k), kindPayoff, alpha)
+		results[k].Problem = sc.Name
+	}
+	return results
+}
+
+// PrintScenarioComparison виводить найкращі альтернативи кожного критерію за
+// кожним сценарієм, рекомендації, стійкі до вибору сценарію, і кількість перемог альтернатив
+func PrintScenarioComparison(s *scenarioSet, results []*decision.Result) {
+	fmt.Printf("\nПорівняння сценаріїв – найкращі альтернативи:\n%-10s", "Критерій")
+	for _, sc := range s.Scenarios {
+		fmt.Printf("%-20s", sc.Name)
+	}
+	fmt.Println()
+
+	wins := make(map[string]int)
+	var robust []string
+	for c, crit := range results[0].Criteria {
+		fmt.Printf("%-10s", crit.Name)
+		common := crit.Best
+		for _, r := range results {
+			best := r.Criteria[c].Best
+			fmt.Printf("%-20s", strings.Join(best, ", "))
+			for _, alt := range best {
+				wins[alt]++
+			}
+			common = slices.DeleteFunc(slices.Clone(common), func(alt string) bool { return !slices.Contains(best, alt) })
+		}
+		fmt.Println()
+		if len(common) > 0 {
+			robust = append(robust, fmt.Sprintf("%s – %s", crit.Name, strings.Join(common, ", ")))
+		}
+	}
+	fmt.Printf("%-10s", "Парето")
+	for _, r := range results {
+		fmt.Printf("%-20s", strings.Join(r.Pareto, ", "))
+	}
+	fmt.Println()
+
+	if len(robust) > 0 {
+		fmt.Printf("\nНайкращі за всіх сценаріїв: %s\n", strings.Join(robust, "; "))
+	} else {
+		fmt.Println("\nЖоден критерій не має альтернативи, найкращої за всіх сценаріїв")
+	}
+	fmt.Println("\nКількість перемог (критерій × сценарій):")
+	order := slices.Clone(s.Alternatives)
+	slices.SortStableFunc(order, func(a, b string) int { return wins[b] - wins[a] })
+	total := len(results) * len(results[0].Criteria)
+	for _, alt := range order {
+		fmt.Printf("  %-20s %d з %d\n", alt, wins[alt], total)
+	}
+}
+
+// addScenario додає до файлу сценаріїв матрицю з файлу задачі; якщо файлу
+// сценаріїв немає, він створюється з альтернативами й станами цієї матриці
+func addScenario(setPath, name, problemPath, sheet string) error {
+	if formatByExt(setPath) != formatJSON {
+		return fmt.Errorf(errScenarioJSON, setPath)
+	}
+	m, err := loadMatrix(problemPath, sheet)
+	if err != nil {
+		return err
+	}
+	s := &scenarioSet{Alternatives: m.Alternatives, Columns: m.Columns}
+	if _, err := os.Stat(setPath); err == nil {
+		if s, err = loadScenarios(setPath); err != nil {
+			return err
+		}
+	}
+	if slices.ContainsFunc(s.Scenarios, func(sc scenario) bool { return sc.Name == name }) {
+		return fmt.Errorf(errScenarioExists, name, setPath)
+	}
+	if !slices.Equal(s.Alternatives, m.Alternatives) || !slices.Equal(s.Columns, m.Columns) {
+		return fmt.Errorf(errScenarioShape, problemPath, strings.Join(m.Alternatives, ", "), strings.Join(m.Columns, ", "),
+			strings.Join(s.Alternatives, ", "), strings.Join(s.Columns, ", "))
+	}
+	s.Scenarios = append(s.Scenarios, scenario{Name: name, Values: m.Values})
+	return saveFile(setPath, func(w io.Writer) error { return writeJSON(w, s) })
+}
+
+// removeScenario видаляє сценарій з файлу сценаріїв
+func removeScenario(setPath, name string) error {
+	if formatByExt(setPath) != formatJSON {
+		return fmt.Errorf(errScenarioJSON, setPath)
+	}
+	s, err := loadScenarios(setPath)
+	if err != nil {
+		return err
+	}
+	k := slices.IndexFunc(s.Scenarios, func(sc scenario) bool { return sc.Name == name })
+	if k < 0 {
+		return fmt.Errorf(errScenarioMissing, name, setPath)
+	}
+	s.Scenarios = slices.Delete(s.Scenarios, k, k+1)
+	return saveFile(setPath, func(w io.Writer) error { return writeJSON(w, s) })
+}
+
+func runScenarios(args []string) error {
+	fs := flag.NewFlagSet("scenarios", flag.ExitOnError)
+	alpha := fs.Float64("alpha", 0.5, "коефіцієнт оптимізму α для критерію Гурвіца")
+	sheet := fs.String("sheet", "", "аркуш книги Excel з матрицею для tpr scenarios add (за замовчуванням перший)")
+	brief := fs.Bool("brief", false, "вивести лише порівняння сценаріїв без значень критеріїв кожного сценарію")
+	fs.Var(criterionFlag{}, "criterion", criterionUsage)
+	fs.Var(scriptFlag{}, "script", scriptUsage)
+	positional := parseInterspersed(fs, args)
+
+	switch {
+	case len(positional) > 0 && positional[0] == "add":
+		if len(positional) != 4 {
+			return fmt.Errorf(errScenariosAdd)
+		}
+		if err := addScenario(positional[1], positional[2], positional[3], *sheet); err != nil {
+			return err
+		}
+		fmt.Printf("Сценарій '%s' додано у файл %s\n", positional[2], positional[1])
+		return nil
+	case len(positional) > 0 && positional[0] == "remove":
+		if len(positional) != 3 {
+			return fmt.Errorf(errScenariosRemove)
+		}
+		if err := removeScenario(positional[1], positional[2]); err != nil {
+			return err
+		}
+		fmt.Printf("Сценарій '%s' видалено з файлу %s\n", positional[2], positional[1])
+		return nil
+	case len(positional) != 1:
+		return fmt.Errorf(errScenariosFile)
+	}
+
+	if err := decision.ValidateAlpha(*alpha); err != nil {
+		return err
+	}
+	s, err := loadScenarios(positional[0])
+	if err != nil {
+		return err
+	}
+	results := CompareScenarios(s, *alpha)
+	if !*brief {
+		for _, r := range results {
+			fmt.Printf("\n=== Сценарій «%s» ===\n", r.Problem)
+			PrintResult(r)
+		}
+	}
+	PrintScenarioComparison(s, results)
+	return nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	// jsonSchemaDialect – версія JSON Schema опублікованих схем
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	// schemaBaseID – простір імен ідентифікаторів схем ($id)
+	schemaBaseID = "urn:tpr:schema:"
+
+	errSchemaName   = "Вкажіть схему: tpr schema <%s>"
+	errResultFormat = "%s: файл результатів некоректний:\n%s"
+)
+
+var (
+	manifestSchema = &schema{
+		Type:        "object",
+		Description: "маніфест: з яких даних і з якими параметрами отримано результат (tpr verify)",
+		Required:    []string{"tool", "version", "created", "input", "input_sha256", "params"},
+		Properties: map[string]*schema{
+			"tool":         {Type: "string"},
+			"version":      {Type: "string"},
+			"created":      {Type: "string", Format: "date-time"},
+			"input":        {Type: "string", Description: "шлях до вхідного файлу відносно каталогу задач"},
+			"input_sha256": {Type: "string", Description: "SHA-256 вхідного файлу в шістнадцятковому записі"},
+			"scripts_sha256": {Type: "object",
+				Description: "SHA-256 скриптів критеріїв (-script) за шляхами з params.scripts"},
+			"params": {Type: "object", Required: []string{"kind", "alpha"}, Properties: map[string]*schema{
+				"kind":  {Type: "string", Enum: []string{kindPayoff, kindRanking}},
+				"alpha": {Type: "number", Minimum: ptr(0.0), Maximum: ptr(1.0)},
+			}},
+		},
+	}
+
+	// resultFileSchema – файл результатів tpr batch -format json: результат разом із маніфестом
+	resultFileSchema = func() *schema {
+		s := *resultSchema
+		s.Properties = make(map[string]*schema, len(resultSchema.Properties)+1)
+		for name, p := range resultSchema.Properties {
+			s.Properties[name] = p
+		}
+		s.Properties["manifest"] = manifestSchema
+		s.Properties["schema_version"] = &schema{Type: "number", Minimum: ptr(1.0),
+			Description: "версія формату результатів; старіші версії tpr перетворює до поточної"}
+		s.Required = append(slices.Clone(resultSchema.Required), "schema_version")
+		return &s
+	}()
+
+	// publishedSchemas – схеми форматів файлів, які виводить tpr schema
+	publishedSchemas = []struct {
+		name, title string
+		schema      *schema
+	}{
+		{"problem", "Файл задачі tpr", problemSchema},
+		{"result", "Файл результатів tpr", resultFileSchema},
+		{"full-report", "Комбінована задача tpr full-report", combinedSchema},
+		{"scenarios", "Сценарії задачі tpr scenarios", scenarioSetSchema},
+	}
+)
+
+// publishedSchema повертає схему name як самостійний документ JSON Schema
+func publishedSchema(name string) (*schema, bool) {
+	for _, p := range publishedSchemas {
+		if p.name == name {
+			s := *p.schema
+			s.Dialect, s.ID, s.Title = jsonSchemaDialect, schemaBaseID+name, p.title
+			return &s, true
+		}
+	}
+	return nil, false
+}
+
+// readResultFile зчитує файл результатів будь-якої підтримуваної версії (див. decodeResult);
+// помилка перелічує всі розбіжності зі схемою у форматі «файл:рядок: поле: повідомлення»
+func readResultFile(path string) (*resultFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rf, diags, err := decodeResult(data)
+	if err != nil {
+		return nil, fmt.Errorf(errDiffFormat, path, err)
+	}
+	if len(diags) > 0 {
+		lines := make([]string, len(diags))
+		for i, d := range diags {
+			lines[i] = d.format(path)
+		}
+		return nil, fmt.Errorf(errResultFormat, path, strings.Join(lines, "\n"))
+	}
+	return rf, nil
+}
+
+// runSchema виводить опубліковану схему JSON Schema формату задачі або результатів
+func runSchema(args []string) error {
+	fs := flag.NewFlagSet("schema", flag.ExitOnError)
+	positional := parseInterspersed(fs, args)
+	names := make([]string, len(publishedSchemas))
+	for i, p := range publishedSchemas {
+		names[i] = p.name
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf(errSchemaName, strings.Join(names, "|"))
+	}
+	s, ok := publishedSchema(positional[0])
+	if !ok {
+		return fmt.Errorf(errSchemaName, strings.Join(names, "|"))
+	}
+	return writeJSON(os.Stdout, s)
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	starlarkmath "go.starlark.net/lib/math"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errScriptEvaluate  = "Скрипт %s: не визначено функцію evaluate(problem)"
+	errScriptDirection = "Скрипт %s: direction має бути \"max\" або \"min\", а не %s"
+	errScriptKinds     = "Скрипт %s: kinds має бути списком із \"payoff\" та/або \"ranking\""
+	errScriptResult    = "evaluate має повернути словник {альтернатива: значення}, список чисел у порядку альтернатив або список альтернатив від найкращої до найгіршої, а не %s"
+	errScriptRun       = "Скрипт %s, задача з альтернативами %s: %v\n"
+	errScriptDuplicate = "Скрипт %s: критерій '%s' уже визначено"
+
+	scriptUsage = "власний критерій на Starlark: файл визначає evaluate(problem), а також, за потреби, name, direction і kinds " +
+		"(див. scripts/borda.star); прапорець можна повторювати"
+
+	// scriptMaxSteps обмежує кількість кроків інтерпретатора на один виклик evaluate,
+	// щоб нескінченний цикл у скрипті не зупиняв аналіз
+	scriptMaxSteps = 100_000_000
+)
+
+// customScripts – шляхи до скриптів з прапорців -script у порядку реєстрації
+var customScripts []string
+
+// scriptFlag реєструє критерій-скрипт для кожного прапорця -script
+type scriptFlag struct{}
+
+func (scriptFlag) String() string { return "" }
+
+func (scriptFlag) Set(value string) error {
+	return registerScript(value)
+}
+
+// scriptCriterion – критерій, значення якого обчислює функція evaluate скрипту
+// Starlark. Скрипт отримує задачу як словник:
+//
+//	{"kind": "payoff" | "ranking", "alpha": α,
+//	 "alternatives": [...], "columns": [...], "values": [[...], ...]}
+//
+// і повертає значення для кожної альтернативи або ранжування від найкращої
+// до найгіршої (тоді значенням стає місце, і менше – краще).
+type scriptCriterion struct {
+	path      string
+	name      string
+	direction decision.Direction
+	kinds     []string
+	evaluate  starlark.Callable
+	params    decision.Params
+}
+
+// scriptPredeclared – імена, доступні скриптам понад вбудовані функції Starlark:
+// модуль math і sum, якої в Starlark немає
+var scriptPredeclared = starlark.StringDict{
+	"math": starlarkmath.Module,
+	"sum":  starlark.NewBuiltin("sum", scriptSum),
+}
+
+// scriptSum – sum(iterable): сума чисел
+func scriptSum(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var items starlark.Iterable
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &items); err != nil {
+		return nil, err
+	}
+	iter := items.Iterate()
+	defer iter.Done()
+	total := 0.0
+	var x starlark.Value
+	for i := 0; iter.Next(&x); i++ {
+		f, ok := starlark.AsFloat(x)
+		if !ok {
+			return nil, fmt.Errorf("%s: елемент %d не є числом (%s)", b.Name(), i, x.Type())
+		}
+		total += f
+	}
+	return starlark.Float(total), nil
+}
+
+// loadScript виконує файл скрипту й зчитує його глобальні визначення
+func loadScript(path string) (*scriptCriterion, error) {
+	thread := &starlark.Thread{Name: path, Print: func(_ *starlark.Thread, msg string) { fmt.Fprintln(os.Stderr, msg) }}
+	globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, path, nil, scriptPredeclared)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &scriptCriterion{
+		path:      path,
+		name:      strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+		direction: decision.Maximize,
+		kinds:     []string{kindPayoff},
+	}
+	fn, ok := globals["evaluate"].(starlark.Callable)
+	if !ok {
+		return nil, fmt.Errorf(errScriptEvaluate, path)
+	}
+	s.evaluate = fn
+	if v, ok := globals["name"].(starlark.String); ok {
+		s.name = string(v)
+	}
+	if v, ok := globals["direction"]; ok {
+		switch v {
+		case starlark.String("max"):
+		case starlark.String("min"):
+			s.direction = decision.Minimize
+		default:
+			return nil, fmt.Errorf(errScriptDirection, path, v)
+		}
+	}
+	if v, ok := globals["kinds"]; ok {
+		list, ok := v.(*starlark.List)
+		if !ok || list.Len() == 0 {
+			return nil, fmt.Errorf(errScriptKinds, path)
+		}
+		s.kinds = nil
+		for i := range list.Len() {
+			kind, ok := starlark.AsString(list.Index(i))
+			if !ok || (kind != kindPayoff && kind != kindRanking) {
+				return nil, fmt.Errorf(errScriptKinds, path)
+			}
+			s.kinds = append(s.kinds, kind)
+		}
+	}
+	return s, nil
+}
+
+// registerScript завантажує скрипт і реєструє його як критерій
+func registerScript(path string) error {
+	s, err := loadScript(path)
+	if err != nil {
+		return err
+	}
+	if !validCriterionName(s.name) {
+		return fmt.Errorf(errCriterionName, s.name)
+	}
+	if _, ok := decision.Lookup(s.name, decision.Params{}); ok {
+		return fmt.Errorf(errScriptDuplicate, path, s.name)
+	}
+	decision.Register(s.name, func(p decision.Params) decision.Criterion {
+		c := *s
+		c.params = p
+		return &c
+	})
+	customScripts = append(customScripts, path)
+	return nil
+}
+
+func (s *scriptCriterion) Name() string                  { return s.name }
+func (s *scriptCriterion) Direction() decision.Direction { return s.direction }
+func (s *scriptCriterion) Kinds() []string               { return s.kinds }
+
+// Evaluate викликає evaluate скрипту. Помилка скрипту виводиться у stderr,
+// а значення критерію для всіх альтернатив стають NaN
+func (s *scriptCriterion) Evaluate(m *decision.Matrix) map[string]float64 {
+	values, err := s.call(m)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, errScriptRun, s.path, strings.Join(m.Alternatives, ", "), err)
+		values = make(map[string]float64, len(m.Alternatives))
+		for _, alt := range m.Alternatives {
+			values[alt] = math.NaN()
+		}
+	}
+	return values
+}
+
+func (s *scriptCriterion) call(m *decision.Matrix) (map[string]float64, error) {
+	rows := make([]starlark.Value, len(m.Values))
+	for i, row := range m.Values {
+		cells := make([]starlark.Value, len(row))
+		for j, v := range row {
+			cells[j] = starlark.Float(v)
+		}
+		rows[i] = starlark.NewList(cells)
+	}
+	problem := starlark.NewDict(5)
+	problem.SetKey(starlark.String("kind"), starlark.String(s.params.Kind))
+	problem.SetKey(starlark.String("alpha"), starlark.Float(s.params.Alpha))
+	problem.SetKey(starlark.String("alternatives"), stringList(m.Alternatives))
+	problem.SetKey(starlark.String("columns"), stringList(m.Columns))
+	problem.SetKey(starlark.String("values"), starlark.NewList(rows))
+
+	thread := &starlark.Thread{Name: s.path, Print: func(_ *starlark.Thread, msg string) { fmt.Fprintln(os.Stderr, msg) }}
+	thread.SetMaxExecutionSteps(scriptMaxSteps)
+	result, err := starlark.Call(thread, s.evaluate, starlark.Tuple{problem}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return scriptValues(result, m.Alternatives, s)
+}
+
+// scriptValues перетворює результат evaluate на значення критерію
+func scriptValues(result starlark.Value, alts []string, s *scriptCriterion) (map[string]float64, error) {
+	values := make(map[string]float64, len(alts))
+	switch r := result.(type) {
+	case *starlark.Dict:
+		for _, alt := range alts {
+			v, found, _ := r.Get(starlark.String(alt))
+			f, ok := starlark.AsFloat(v)
+			if !found || !ok {
+				return nil, fmt.Errorf("немає числового значення для альтернативи %q", alt)
+			}
+			values[alt] = f
+		}
+		return values, nil
+
+	case *starlark.List:
+		if r.Len() != len(alts) {
+			return nil, fmt.Errorf("список має містити %d елементів, а містить %d", len(alts), r.Len())
+		}
+		// Список назв – ранжування: значенням стає місце, тому краще менше
+		if _, isName := starlark.AsString(r.Index(0)); isName {
+			for place := range r.Len() {
+				alt, _ := starlark.AsString(r.Index(place))
+				if !slices.Contains(alts, alt) {
+					return nil, fmt.Errorf("невідома альтернатива %q у ранжуванні", alt)
+				}
+				values[alt] = float64(place + 1)
+			}
+			if len(values) != len(alts) {
+				return nil, errors.New("альтернативи в ранжуванні повторюються")
+			}
+			// Evaluate викликається для копії з реєстру, тому напрям змінюється лише для цього аналізу
+			s.direction = decision.Minimize
+			return values, nil
+		}
+		for i, alt := range alts {
+			f, ok := starlark.AsFloat(r.Index(i))
+			if !ok {
+				return nil, fmt.Errorf("елемент %d не є числом", i)
+			}
+			values[alt] = f
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf(errScriptResult, result.Type())
+}
+
+func stringList(items []string) *starlark.List {
+	values := make([]starlark.Value, len(items))
+	for i, s := range items {
+		values[i] = starlark.String(s)
+	}
+	return starlark.NewList(values)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+	"math/rand/v2"
+	"strconv"
+	"time"
+)
+
+const seedUsage = "зерно генератора випадкових чисел; однакове зерно й однакові вхідні дані дають однакові результати (за замовчуванням випадкове, виводиться під час запуску)"
+
+// seedFlag – зерно генератора (-seed), спільне для всіх команд з випадковими обчисленнями:
+// генерування задач (generate) та аналізу Монте-Карло (grpc)
+type seedFlag struct {
+	value uint64
+	set   bool
+}
+
+// addSeedFlag реєструє прапорець -seed
+func addSeedFlag(fs *flag.FlagSet) *seedFlag {
+	f := &seedFlag{}
+	fs.Var(f, "seed", seedUsage)
+	return f
+}
+
+func (f *seedFlag) String() string {
+	if f == nil || !f.set {
+		return ""
+	}
+	return strconv.FormatUint(f.value, 10)
+}
+
+func (f *seedFlag) Set(value string) error {
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	f.value, f.set = v, true
+	return nil
+}
+
+// Value повертає задане зерно, а якщо -seed не задано – випадкове зерно за часом
+// запуску, яке далі не змінюється, щоб його можна було вивести й повторити запуск
+func (f *seedFlag) Value() uint64 {
+	if !f.set {
+		f.value, f.set = uint64(time.Now().UnixNano()), true
+	}
+	return f.value
+}
+
+// newRand створює генератор із зерном seed; усі випадкові обчислення утиліти
+// використовують лише його, тож однакове зерно відтворює результат повністю
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
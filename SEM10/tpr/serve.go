@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	// serveMaxBody – найбільший розмір тіла запиту; задачі курсу значно менші
+	serveMaxBody = 1 << 20
+
+	errServeJSON    = "Некоректний JSON задачі: %v"
+	errServeInvalid = "Тіло запиту не відповідає схемі"
+	errServePort    = "Некоректний порт: %d"
+)
+
+type (
+	// uncertaintyRequest – тіло запиту POST /uncertainty/criteria: матриця
+	// корисності та коефіцієнт оптимізму для критерію Гурвіца (за замовчуванням 0.5)
+	uncertaintyRequest struct {
+		Problem string   `json:"problem,omitempty"`
+		Alpha   *float64 `json:"alpha,omitempty"`
+		decision.Matrix
+	}
+
+	// paretoRequest – тіло запиту POST /pareto: ранжування альтернатив експертами
+	paretoRequest struct {
+		Problem string `json:"problem,omitempty"`
+		decision.Matrix
+	}
+
+	// errorResponse – тіло відповіді з помилкою; errors містить помилки окремих полів
+	errorResponse struct {
+		Error  string       `json:"error"`
+		Errors []fieldError `json:"errors,omitempty"`
+	}
+)
+
+// decodeRequest зчитує JSON-тіло запиту, перевіряє його за схемою s і лише
+// потім розбирає в v; при помилці відповідь 400 уже записана і повертається false
+func decodeRequest(w http.ResponseWriter, r *http.Request, s *schema, v any) bool {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, serveMaxBody))
+	var raw any
+	if err == nil {
+		err = json.Unmarshal(body, &raw)
+	}
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf(errServeJSON, err)})
+		return false
+	}
+	if errs := validateValue(raw, s, ""); len(errs) > 0 {
+		writeInvalid(w, errs)
+		return false
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		writeResponse(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf(errServeJSON, err)})
+		return false
+	}
+	return true
+}
+
+func writeResponse(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	writeJSON(w, v)
+}
+
+func writeInvalid(w http.ResponseWriter, errs []fieldError) {
+	writeResponse(w, http.StatusBadRequest, errorResponse{Error: errServeInvalid, Errors: errs})
+}
+
+func handleUncertainty(w http.ResponseWriter, r *http.Request) {
+	var req uncertaintyRequest
+	if !decodeRequest(w, r, uncertaintySchema, &req) {
+		return
+	}
+	if errs := validateMatrix(&req.Matrix); len(errs) > 0 {
+		writeInvalid(w, errs)
+		return
+	}
+	alpha := 0.5
+	if req.Alpha != nil {
+		alpha = *req.Alpha
+	}
+
+	res := decision.Analyze(&req.Matrix, kindPayoff, alpha)
+	res.Problem = req.Problem
+	writeResponse(w, http.StatusOK, res)
+}
+
+func handlePareto(w http.ResponseWriter, r *http.Request) {
+	var req paretoRequest
+	if !decodeRequest(w, r, paretoSchema, &req) {
+		return
+	}
+	if errs := fieldErrors(req.ValidateRanking()); len(errs) > 0 {
+		writeInvalid(w, errs)
+		return
+	}
+
+	res := decision.Analyze(&req.Matrix, kindRanking, 0)
+	res.Problem = req.Problem
+	writeResponse(w, http.StatusOK, res)
+}
+
+// newServeMux повертає маршрути REST API та вбудованого вебінтерфейсу
+func newServeMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /uncertainty/criteria", handleUncertainty)
+	mux.HandleFunc("POST /pareto", handlePareto)
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(w, http.StatusOK, OpenAPISpec())
+	})
+	mux.Handle("GET /", webHandler())
+	return mux
+}
+
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	port := fs.Int("port", 8080, "порт HTTP-сервера")
+	fs.Var(criterionFlag{}, "criterion", criterionUsage)
+	fs.Var(scriptFlag{}, "script", scriptUsage)
+	host := fs.String("host", "", "адреса, на якій слухає сервер (за замовчуванням усі інтерфейси)")
+	spec := fs.Bool("openapi", false, "вивести специфікацію OpenAPI 3 і завершити роботу")
+	fs.Parse(args)
+
+	if *spec {
+		return writeJSON(os.Stdout, OpenAPISpec())
+	}
+
+	if *port < 1 || *port > 65535 {
+		return fmt.Errorf(errServePort, *port)
+	}
+	addr := fmt.Sprintf("%s:%d", *host, *port)
+	fmt.Printf("Сервер слухає %s (вебінтерфейс: GET /; API: POST /uncertainty/criteria, POST /pareto, GET /openapi.json)\n", addr)
+	return http.ListenAndServe(addr, newServeMux())
+}
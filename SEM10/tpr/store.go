@@ -0,0 +1,302 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	defaultDB = "decisions.db"
+
+	errStoreOpen    = "Не вдалося відкрити базу %s: %v"
+	errStoreMissing = "База %s не існує: запуски зберігаються з прапорцем -db команд analyze і batch"
+	errShowID       = "Вкажіть номер запуску: tpr show <номер> [-db файл]"
+	errShowMissing  = "Запуск #%d не знайдено в базі %s"
+	errStoreResult  = "Результати запуску #%d пошкоджено: %s: %s"
+	errStoreRun     = "Запуск #%d пошкоджено: %v"
+	errStoreSkipped = "%v – запуск пропущено\n"
+)
+
+const storeSchema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	created_at TEXT NOT NULL,
+	problem    TEXT NOT NULL,
+	kind       TEXT NOT NULL,
+	params     TEXT NOT NULL,
+	input      TEXT NOT NULL,
+	result     TEXT NOT NULL
+)`
+
+type (
+	// store – база SQLite з історією аналізів: вхідна матриця, параметри та результат кожного запуску
+	store struct {
+		db *sql.DB
+	}
+
+	// runParams – параметри запуску, що впливають на результат
+	runParams struct {
+		Kind  string  `json:"kind"`
+		Alpha float64 `json:"alpha"`
+		Sheet string  `json:"sheet,omitempty"`
+		// Criteria – визначення власних критеріїв (-criterion)
+		Criteria []string `json:"criteria,omitempty"`
+		// Scripts – шляхи до скриптів Starlark з власними критеріями (-script)
+		Scripts []string `json:"scripts,omitempty"`
+		// Rate – ставка дисконтування грошових потоків у клітинках (-rate)
+		Rate float64 `json:"rate,omitempty"`
+		// Normalize – спосіб нормалізації матриці перед обчисленням критеріїв (-normalize)
+		Normalize string `json:"normalize,omitempty"`
+		// Meta – ваги зваженої метаоцінки (-meta), MetaNormalize – спосіб нормалізації шкал
+		Meta          string `json:"meta,omitempty"`
+		MetaNormalize string `json:"meta_normalize,omitempty"`
+		// Lexicographic – стани лексикографічного критерію від найважливішого (-lex)
+		Lexicographic []string `json:"lexicographic,omitempty"`
+		// Aspiration – рівні домагань (-aspiration), AspirationMode – режим відбору (-aspiration-mode)
+		Aspiration     string `json:"aspiration,omitempty"`
+		AspirationMode string `json:"aspiration_mode,omitempty"`
+		// Groups – групи альтернатив (-groups)
+		Groups string `json:"groups,omitempty"`
+		// Exact – точна раціональна арифметика критеріїв (-exact)
+		Exact bool `json:"exact,omitempty"`
+	}
+
+	// run – збережений запуск аналізу
+	run struct {
+		ID      int64
+		Created time.Time
+		Problem string
+		Params  runParams
+		Input   decision.Matrix
+		Result  decision.Result
+	}
+)
+
+// openStore відкриває базу, створюючи файл і таблицю за потреби; must – файл уже має існувати
+func openStore(path string, must bool) (*store, error) {
+	if _, err := os.Stat(path); must && err != nil {
+		return nil, fmt.Errorf(errStoreMissing, path)
+	}
+	// Драйвер modernc.org/sqlite написаний на Go і не потребує cgo
+	db, err := sql.Open("sqlite", path)
+	if err == nil {
+		// Один з'єднання серіалізує записи паралельних обробників batch
+		db.SetMaxOpenConns(1)
+		_, err = db.Exec(storeSchema)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errStoreOpen, path, err)
+	}
+	return &store{db: db}, nil
+}
+
+func (s *store) Close() error {
+	return s.db.Close()
+}
+
+// Save записує запуск і повертає його номер
+func (s *store) Save(problem string, params runParams, m *decision.Matrix, r *decision.Result) (int64, error) {
+	// Значення, які не кодуються в JSON (NaN, ±Inf), – помилка, а не порожній рядок у базі
+	var columns [3]string
+	for k, v := range []any{params, m, resultFile{SchemaVersion: resultSchemaVersion, Result: r}} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return 0, err
+		}
+		columns[k] = string(data)
+	}
+	res, err := s.db.Exec(`INSERT INTO runs (created_at, problem, kind, params, input, result) VALUES (?, ?, ?, ?, ?, ?)`,
+		time.Now().Format(time.RFC3339), problem, r.Kind, columns[0], columns[1], columns[2])
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+// errCorruptRun – рядок таблиці прочитано, але його JSON не вдалося розібрати
+type errCorruptRun struct{ err error }
+
+func (e errCorruptRun) Error() string { return e.err.Error() }
+func (e errCorruptRun) Unwrap() error { return e.err }
+
+// scanRun розбирає рядок таблиці runs у запуск; помилки розбору JSON мають тип errCorruptRun
+func scanRun(row interface{ Scan(...any) error }) (*run, error) {
+	var r run
+	var created, kind, params, input, result string
+	if err := row.Scan(&r.ID, &created, &r.Problem, &kind, &params, &input, &result); err != nil {
+		return nil, err
+	}
+	r.Created, _ = time.Parse(time.RFC3339, created)
+	for _, f := range []struct {
+		data string
+		v    any
+	}{{params, &r.Params}, {input, &r.Input}} {
+		if err := json.Unmarshal([]byte(f.data), f.v); err != nil {
+			return nil, errCorruptRun{fmt.Errorf(errStoreRun, r.ID, err)}
+		}
+	}
+	// Результати старіших версій tpr переводяться до поточного формату
+	rf, diags, err := decodeResult([]byte(result))
+	switch {
+	case err != nil:
+		return nil, errCorruptRun{fmt.Errorf(errStoreRun, r.ID, err)}
+	case len(diags) > 0 && diags[0].Field == "":
+		return nil, errCorruptRun{fmt.Errorf(errStoreRun, r.ID, diags[0].Message)}
+	case len(diags) > 0:
+		return nil, errCorruptRun{fmt.Errorf(errStoreResult, r.ID, diags[0].Field, diags[0].Message)}
+	}
+	r.Result = *rf.Result
+	return &r, nil
+}
+
+const runColumns = "id, created_at, problem, kind, params, input, result"
+
+// Recent повертає останні limit запусків, новіші першими. Пошкоджені запуски
+// не переривають читання: вони пропускаються, а їхні помилки повертаються в skipped.
+func (s *store) Recent(limit int) (runs []*run, skipped []error, err error) {
+	rows, err := s.db.Query("SELECT "+runColumns+" FROM runs ORDER BY id DESC LIMIT ?", limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r, err := scanRun(rows)
+		var corrupt errCorruptRun
+		switch {
+		case errors.As(err, &corrupt):
+			skipped = append(skipped, err)
+			continue
+		case err != nil:
+			return nil, nil, err
+		}
+		runs = append(runs, r)
+	}
+	return runs, skipped, rows.Err()
+}
+
+// Get повертає запуск за номером; nil, якщо його немає
+func (s *store) Get(id int64) (*run, error) {
+	r, err := scanRun(s.db.QueryRow("SELECT "+runColumns+" FROM runs WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return r, err
+}
+
+func runHistory(args []string) error {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	path := fs.String("db", defaultDB, "база SQLite з історією запусків")
+	limit := fs.Int("limit", 20, "кількість останніх запусків")
+	fs.Parse(args)
+
+	s, err := openStore(*path, true)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	runs, skipped, err := s.Recent(*limit)
+	if err != nil {
+		return err
+	}
+	for _, err := range skipped {
+		fmt.Fprintf(os.Stderr, errStoreSkipped, err)
+	}
+	if len(runs) == 0 {
+		fmt.Println("Історія порожня")
+		return nil
+	}
+
+	fmt.Printf("%-6s %-17s %-8s %-6s %-30s %s\n", "№", "Час", "Тип", "α", "Задача", "Множина Парето")
+	for _, r := range runs {
+		fmt.Printf("%-6d %-17s %-8s %-6.2f %-30s %s\n", r.ID, r.Created.Local().Format("02.01.2006 15:04"),
+			r.Result.Kind, r.Params.Alpha, r.Problem, strings.Join(r.Result.Pareto, ", "))
+	}
+	return nil
+}
+
+func runShow(args []string) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	path := fs.String("db", defaultDB, "база SQLite з історією запусків")
+	positional := parseInterspersed(fs, args)
+
+	if len(positional) != 1 {
+		return fmt.Errorf(errShowID)
+	}
+	id, err := strconv.ParseInt(positional[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf(errShowID)
+	}
+	s, err := openStore(*path, true)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	r, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return fmt.Errorf(errShowMissing, id, *path)
+	}
+
+	fmt.Printf("Запуск #%d від %s\n", r.ID, r.Created.Local().Format("02.01.2006 15:04:05"))
+	fmt.Printf("Задача: %s\n", r.Problem)
+	fmt.Printf("Параметри: тип %s, α = %.2f", r.Params.Kind, r.Params.Alpha)
+	if r.Params.Sheet != "" {
+		fmt.Printf(", аркуш %s", r.Params.Sheet)
+	}
+	if r.Params.Rate != 0 {
+		fmt.Printf(", ставка дисконтування %g", r.Params.Rate)
+	}
+	if r.Params.Normalize != "" {
+		fmt.Printf(", нормалізація %s", r.Params.Normalize)
+	}
+	if r.Params.Groups != "" {
+		fmt.Printf(", групи %s", r.Params.Groups)
+	}
+	if r.Params.Exact {
+		fmt.Print(", точна арифметика")
+	}
+	if r.Params.Aspiration != "" {
+		fmt.Printf(", рівні домагань %s (%s)", r.Params.Aspiration, r.Params.AspirationMode)
+	}
+	fmt.Println()
+	for _, def := range r.Params.Criteria {
+		fmt.Printf("Власний критерій: %s\n", def)
+	}
+	for _, path := range r.Params.Scripts {
+		fmt.Printf("Скрипт критерію: %s\n", path)
+	}
+	if len(r.Params.Lexicographic) > 0 {
+		fmt.Printf("Лексикографічний критерій: %s\n", strings.Join(r.Params.Lexicographic, " ≻ "))
+	}
+	if r.Params.Meta != "" {
+		fmt.Printf("Метаоцінка: %s, нормалізація %s\n", r.Params.Meta, r.Params.MetaNormalize)
+	}
+
+	fmt.Printf("\n%-20s", "Альтернатива")
+	for _, c := range r.Input.Columns {
+		fmt.Printf("%12s", c)
+	}
+	fmt.Println()
+	for i, alt := range r.Input.Alternatives {
+		fmt.Printf("%-20s", alt)
+		for _, v := range r.Input.Values[i] {
+			fmt.Printf("%12.2f", v)
+		}
+		fmt.Println()
+	}
+	PrintResult(&r.Result)
+	return nil
+}
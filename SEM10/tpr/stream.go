@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errStreamFile   = "Вкажіть файл CSV: tpr stream <матриця.csv> [-best n]"
+	errStreamFormat = "%s: потоково обробляються лише матриці корисності у форматі CSV"
+	errStreamLine   = "%s, рядок %d: %s"
+	errStreamCells  = "кількість значень %d не збігається з кількістю станів %d"
+	errStreamBest   = "-best має бути не меншим за 1, отримано %d"
+
+	// streamBuffer – розмір буфера читання CSV; у ньому ж шукається рядок заголовка
+	streamBuffer = 1 << 16
+)
+
+// csvRowReader читає матрицю корисності з CSV по одному рядку (decision.RowReader);
+// формат той самий, що й у checkProblemCSV
+type csvRowReader struct {
+	path    string
+	f       *os.File
+	r       *csv.Reader
+	columns []string
+	row     []float64
+}
+
+// openCSVRows відкриває CSV і зчитує рядок заголовка
+func openCSVRows(path string) (*csvRowReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReaderSize(f, streamBuffer)
+	if head, _ := br.Peek(len(utf8BOM)); bytes.Equal(head, utf8BOM) {
+		br.Discard(len(utf8BOM))
+	}
+	head, _ := br.Peek(streamBuffer)
+	header, _, _ := bytes.Cut(head, []byte("\n"))
+
+	cr := &csvRowReader{path: path, f: f, r: newCSVReader(br, header)}
+	cr.r.ReuseRecord = true
+	record, err := cr.r.Read()
+	if err == nil && len(record) < 2 {
+		err = fmt.Errorf(errStreamLine, path, 1, errCSVHeader)
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	cr.columns = slices.Clone(record[1:])
+	cr.row = make([]float64, len(cr.columns))
+	return cr, nil
+}
+
+func (cr *csvRowReader) Next() (string, []float64, error) {
+	for {
+		record, err := cr.r.Read()
+		if err != nil {
+			return "", nil, err
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		line, _ := cr.r.FieldPos(0)
+		if len(record)-1 != len(cr.columns) {
+			return "", nil, fmt.Errorf(errStreamLine, cr.path, line, fmt.Sprintf(errStreamCells, len(record)-1, len(cr.columns)))
+		}
+		for j, cell := range record[1:] {
+			if cr.r.Comma == ';' {
+				cell = strings.Replace(cell, ",", ".", 1)
+			}
+			v, err := parseCell(cell)
+			if err != nil {
+				return "", nil, fmt.Errorf(errStreamLine, cr.path, line, fmt.Sprintf(errCSVCell, record[0], j+2, cell))
+			}
+			cr.row[j] = v
+		}
+		return strings.TrimSpace(record[0]), cr.row, nil
+	}
+}
+
+func (cr *csvRowReader) Close() error {
+	return cr.f.Close()
+}
+
+// runStream обчислює критерії Вальда, maxmax, Лапласа та Севіджа для матриці
+// з мільйонами альтернатив, не завантажуючи її в пам'ять (див. decision.Stream)
+func runStream(args []string) error {
+	fs := flag.NewFlagSet("stream", flag.ExitOnError)
+	best := fs.Int("best", 10, "скільки найкращих альтернатив виводити для кожного критерію (решта лише підраховується)")
+	addLogFlags(fs)
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf(errStreamFile)
+	}
+	path := positional[0]
+	if formatByExt(path) != formatCSV {
+		return fmt.Errorf(errStreamFormat, path)
+	}
+	if *best < 1 {
+		return fmt.Errorf(errStreamBest, *best)
+	}
+
+	var columns int
+	done := logStage("stream", "problem", path)
+	rows, results, err := decision.Stream(func() (decision.RowReader, error) {
+		cr, err := openCSVRows(path)
+		if err != nil {
+			return nil, err
+		}
+		columns = len(cr.columns)
+		return cr, nil
+	}, *best)
+	done()
+	if err != nil {
+		return err
+	}
+	logger.Info("задачу оброблено потоково", "problem", path, "alternatives", rows, "columns", columns)
+	for _, r := range results {
+		logger.Debug("критерій", "problem", path, "criterion", r.Name, "value", r.Value, "best", r.Best, "ties", r.Ties)
+	}
+
+	fmt.Printf("Альтернатив: %d, станів: %d\n\n", rows, columns)
+	fmt.Printf("%-10s %14s  %s\n", "Критерій", "Значення", "Оптимальні альтернативи")
+	for _, r := range results {
+		names := r.Best
+		if r.Ties > len(names) {
+			names = append(names[:len(names):len(names)], fmt.Sprintf("… ще %d", r.Ties-len(names)))
+		}
+		fmt.Printf("%-10s %14.4f  %s\n", r.Name, r.Value, strings.Join(names, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"tpr/pkg/decision"
+)
+
+// benchCSV записує у тимчасовий каталог матрицю корисності rows×cols у форматі CSV
+func benchCSV(b *testing.B, rows, cols int) string {
+	path := filepath.Join(b.TempDir(), "matrix.csv")
+	f, err := os.Create(path)
+	if err != nil {
+		b.Fatal(err)
+	}
+	w := bufio.NewWriter(f)
+	fmt.Fprint(w, "Альтернатива")
+	for j := range cols {
+		fmt.Fprintf(w, ",s%d", j+1)
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	for i := range rows {
+		fmt.Fprintf(w, "\na%d", i+1)
+		for range cols {
+			fmt.Fprintf(w, ",%.2f", rng.Float64()*100)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		b.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		b.Fatal(err)
+	}
+	return path
+}
+
+// reportHeap додає метрику heap-MB – обсяг купи, що залишається зайнятим, поки
+// результат run ще використовується (для завантаженої матриці – сама матриця)
+func reportHeap(b *testing.B, run func() any) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	live := run()
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(live)
+	b.ReportMetric(float64(int64(after.HeapAlloc)-int64(before.HeapAlloc))/(1<<20), "heap-MB")
+}
+
+// BenchmarkStreamCSV порівнює потокове обчислення критеріїв з обчисленням
+// над матрицею, повністю завантаженою в пам'ять
+func BenchmarkStreamCSV(b *testing.B) {
+	path := benchCSV(b, 100_000, 10)
+	b.Run("stream", func(b *testing.B) {
+		b.ReportAllocs()
+		stream := func() any {
+			_, results, err := decision.Stream(func() (decision.RowReader, error) { return openCSVRows(path) }, 10)
+			if err != nil {
+				b.Fatal(err)
+			}
+			return results
+		}
+		for range b.N {
+			stream()
+		}
+		reportHeap(b, stream)
+	})
+	b.Run("load", func(b *testing.B) {
+		b.ReportAllocs()
+		load := func() any {
+			m, err := loadMatrix(path, "")
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, name := range []string{"wald", "maxmax", "savage", "laplace"} {
+				c, _ := decision.Lookup(name, decision.Params{})
+				decision.Evaluate(c, m)
+			}
+			return m
+		}
+		for range b.N {
+			load()
+		}
+		reportHeap(b, load)
+	})
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errTemplateFormat = "Невідомий формат шаблону '%s', доступні: %s"
+	errTemplateXLSX   = "Книгу Excel не можна вивести в термінал: вкажіть файл через -o <шаблон.xlsx>"
+
+	// templateFormats – формати шаблонів для довідки й повідомлення про помилку
+	templateFormats = "csv, json, yaml, tpr, xlsx"
+
+	// templateProblem – назва задачі в шаблоні, яку користувач замінює власною
+	templateProblem = "Нова задача"
+	// templateHint – коментар на початку шаблонів YAML і .tpr
+	templateHint = "Шаблон задачі: замініть назви альтернатив і станів та значення власними"
+)
+
+// TemplateProblem створює порожню задачу потрібної форми: альтернативи A1…An,
+// стани «Стан 1…» зі значеннями 0 або експерти «Експерт 1…», кожен з яких
+// ранжує альтернативи в порядку переліку, щоб шаблон одразу проходив tpr validate
+func TemplateProblem(kind string, alts, columns int) Problem {
+	p := Problem{Header: []string{"Альтернатива"}}
+	for j := range columns {
+		if kind == kindRanking {
+			p.Header = append(p.Header, fmt.Sprintf("Експерт %d", j+1))
+		} else {
+			p.Header = append(p.Header, fmt.Sprintf("Стан %d", j+1))
+		}
+	}
+	for i := range alts {
+		row := []any{fmt.Sprintf("A%d", i+1)}
+		for range columns {
+			if kind == kindRanking {
+				row = append(row, i+1)
+			} else {
+				row = append(row, 0)
+			}
+		}
+		p.Rows = append(p.Rows, row)
+	}
+	return p
+}
+
+// cells повертає назви альтернатив і значення задачі як рядки
+func (p Problem) cells() (alts []string, values [][]string) {
+	for _, row := range p.Rows {
+		alts = append(alts, fmt.Sprint(row[0]))
+		cells := make([]string, len(row)-1)
+		for j, v := range row[1:] {
+			cells[j] = fmt.Sprint(v)
+		}
+		values = append(values, cells)
+	}
+	return alts, values
+}
+
+// WriteCSV записує задачу у форматі CSV, який зчитують tpr analyze і tpr validate
+func (p Problem) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(p.Header)
+	alts, values := p.cells()
+	for i, alt := range alts {
+		cw.Write(append([]string{alt}, values[i]...))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteProblemJSON записує задачу у форматі JSON файлу задачі
+func (p Problem) WriteProblemJSON(w io.Writer, kind string) error {
+	alts, values := p.cells()
+	m := decision.Matrix{Alternatives: alts, Columns: p.Header[1:], Values: make([][]float64, len(values))}
+	for i, row := range values {
+		m.Values[i] = make([]float64, len(row))
+		for j, cell := range row {
+			m.Values[i][j], _ = strconv.ParseFloat(cell, 64)
+		}
+	}
+	return writeJSON(w, struct {
+		Problem string `json:"problem"`
+		Kind    string `json:"kind"`
+		decision.Matrix
+	}{templateProblem, kind, m})
+}
+
+// WriteYAML записує задачу у форматі YAML: стани й рядки матриці – у потоковому стилі,
+// щоб кожна альтернатива займала один рядок
+func (p Problem) WriteYAML(w io.Writer, kind string) error {
+	alts, values := p.cells()
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\nproblem: %s\nkind: %s\n", templateHint, templateProblem, kind)
+	fmt.Fprintf(&b, "columns: [%s]\nalternatives: [%s]\nvalues:\n", strings.Join(p.Header[1:], ", "), strings.Join(alts, ", "))
+	for i, row := range values {
+		fmt.Fprintf(&b, "  - [%s]  # %s\n", strings.Join(row, ", "), alts[i])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteText записує задачу в текстовому форматі .tpr (див. parseProblemText)
+func (p Problem) WriteText(w io.Writer, kind string) error {
+	alts, values := p.cells()
+	section := sectionStates
+	if kind == kindRanking {
+		section = sectionExperts
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\nproblem = %s\nkind = %s\n\n[%s]\n", templateHint, templateProblem, kind, section)
+	for _, c := range p.Header[1:] {
+		b.WriteString(c + "\n")
+	}
+	fmt.Fprintf(&b, "\n[%s]\n", sectionMatrix)
+	for i, row := range values {
+		fmt.Fprintf(&b, "%s: %s\n", alts[i], strings.Join(row, " "))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func runTemplate(args []string) error {
+	fs := flag.NewFlagSet("template", flag.ExitOnError)
+	kind := fs.String("kind", kindPayoff, "тип задачі: payoff (матриця корисності) або ranking (ранжування експертів)")
+	alts := fs.Int("alts", 3, "кількість альтернатив")
+	states := fs.Int("states", 3, "кількість станів (для payoff)")
+	experts := fs.Int("experts", 3, "кількість експертів (для ranking)")
+	format := fs.String("format", "", "формат шаблону: "+templateFormats+" (за замовчуванням за розширенням -o, інакше csv)")
+	output := fs.String("o", "", "файл для збереження шаблону (за замовчуванням – стандартний вивід)")
+	fs.Parse(args)
+
+	if *alts < 1 {
+		return fmt.Errorf(errGenerateCount, "альтернатив", *alts)
+	}
+	columns := *states
+	switch *kind {
+	case kindPayoff:
+		if *states < 1 {
+			return fmt.Errorf(errGenerateCount, "станів", *states)
+		}
+	case kindRanking:
+		if *experts < 1 {
+			return fmt.Errorf(errGenerateCount, "експертів", *experts)
+		}
+		columns = *experts
+	default:
+		return fmt.Errorf(errGenerateKind, *kind, kindPayoff, kindRanking)
+	}
+
+	if *format == "" {
+		if *format = formatByExt(*output); *format == "" {
+			*format = formatCSV
+		}
+	}
+	p := TemplateProblem(*kind, *alts, columns)
+	var write func(w io.Writer) error
+	switch *format {
+	case formatCSV:
+		write = p.WriteCSV
+	case formatJSON:
+		write = func(w io.Writer) error { return p.WriteProblemJSON(w, *kind) }
+	case formatYAML, "yml":
+		write = func(w io.Writer) error { return p.WriteYAML(w, *kind) }
+	case formatText:
+		write = func(w io.Writer) error { return p.WriteText(w, *kind) }
+	case formatXLSX:
+		if *output == "" {
+			return fmt.Errorf(errTemplateXLSX)
+		}
+		if err := p.SaveXLSX(*output); err != nil {
+			return err
+		}
+		fmt.Printf("Шаблон збережено у файл %s\n", *output)
+		return nil
+	default:
+		return fmt.Errorf(errTemplateFormat, *format, templateFormats)
+	}
+
+	if *output == "" {
+		return write(os.Stdout)
+	}
+	if err := saveFile(*output, write); err != nil {
+		return err
+	}
+	fmt.Printf("Шаблон збережено у файл %s\n", *output)
+	return nil
+}
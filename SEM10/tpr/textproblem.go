@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Текстовий формат задачі (.tpr) – зручна для ручного набору альтернатива JSON:
+//
+//	# Вибір культури для посіву
+//	problem = Посів
+//	kind = payoff
+//	alpha = 0.6
+//
+//	[states]
+//	Посуха = 0.2
+//	Норма  = 0.5
+//	Дощ    = 0.3
+//
+//	[matrix]
+//	Пшениця:   10 20 30
+//	Кукурудза:  5 40 20
+//
+// До розділів записуються параметри problem, kind, alpha, min і max. Розділ
+// [states] перелічує стани (для ранжування експертів – розділ [experts]), за
+// бажанням з ймовірностями для всіх станів. Рядок розділу [matrix] – назва
+// альтернативи, двокрапка та значення через пробіл. Усе після # – коментар.
+// Як і в JSON, alpha, min, max і ймовірності перевіряє tpr validate, а tpr analyze
+// бере з файлу матрицю й тип задачі (коефіцієнт Гурвіца задає прапорець -alpha).
+const (
+	problemTextExt = ".tpr"
+
+	sectionStates  = "states"
+	sectionExperts = "experts"
+	sectionMatrix  = "matrix"
+
+	errTextSection     = "некоректний заголовок розділу '%s': очікується [states], [experts] або [matrix]"
+	errTextSectionDup  = "розділ [%s] уже задано в рядку %d"
+	errTextSectionMiss = "немає розділу [%s]"
+	errTextKey         = "невідомий параметр '%s', доступні: problem, kind, alpha, min, max"
+	errTextKeyDup      = "параметр '%s' уже задано в рядку %d"
+	errTextKeyLine     = "очікується «параметр = значення» або заголовок розділу, а не '%s'"
+	errTextKind        = "невідомий тип задачі '%s', доступні: auto, payoff, ranking"
+	errTextNumber      = "параметр '%s': некоректне число '%s'"
+	errTextProb        = "стан '%s': некоректна ймовірність '%s'"
+	errTextProbMixed   = "ймовірності мають бути задані для всіх станів або для жодного"
+	errTextRow         = "очікується «альтернатива: значення через пробіл», а не '%s'"
+	errTextCell        = "альтернатива '%s', значення %d: некоректне число '%s'"
+)
+
+// lineError – помилка текстового файлу задачі з номером рядка
+type lineError struct {
+	Line    int
+	Message string
+}
+
+// parseProblemText розбирає текстовий формат задачі. Окрім задачі повертає
+// номер рядка кожного поля (шляхи як у помилках перевірки JSON: values[1][0]),
+// щоб помилки перевірки матриці теж вказували на рядок, і всі синтаксичні помилки.
+func parseProblemText(data []byte) (*problemFile, map[string]int, []lineError) {
+	p := &problemFile{}
+	lines := make(map[string]int)
+	var errs []lineError
+	fail := func(line int, format string, args ...any) {
+		errs = append(errs, lineError{line, fmt.Sprintf(format, args...)})
+	}
+
+	var probs []string
+	section := ""
+	n := 0
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		n++
+		text, _, _ := strings.Cut(sc.Text(), "#")
+		text = strings.TrimSpace(text)
+		switch {
+		case text == "":
+		case strings.HasPrefix(text, "["):
+			name, ok := strings.CutSuffix(text[1:], "]")
+			name = strings.ToLower(strings.TrimSpace(name))
+			field := map[string]string{sectionStates: "columns", sectionExperts: "columns", sectionMatrix: "values"}[name]
+			switch {
+			case !ok || field == "":
+				fail(n, errTextSection, text)
+				section = text
+				continue
+			case lines[field] != 0:
+				fail(n, errTextSectionDup, name, lines[field])
+			}
+			section = name
+			lines[field] = n
+			if field == "columns" {
+				lines["probabilities"] = n
+			} else {
+				lines["alternatives"] = n
+			}
+			if name == sectionExperts && p.Kind == "" {
+				p.Kind = kindRanking
+			}
+		case section == "":
+			parseProblemKey(p, lines, text, n, fail)
+		case section == sectionMatrix:
+			name, rest, ok := strings.Cut(text, ":")
+			if !ok {
+				fail(n, errTextRow, text)
+				continue
+			}
+			i := len(p.Alternatives)
+			name = strings.TrimSpace(name)
+			row := []float64{}
+			for j, cell := range strings.Fields(rest) {
+				v, err := strconv.ParseFloat(cell, 64)
+				if err != nil {
+					fail(n, errTextCell, name, j+1, cell)
+				}
+				lines[fmt.Sprintf("values[%d][%d]", i, j)] = n
+				row = append(row, v)
+			}
+			p.Alternatives = append(p.Alternatives, name)
+			p.Values = append(p.Values, row)
+			lines[fmt.Sprintf("alternatives[%d]", i)] = n
+			lines[fmt.Sprintf("values[%d]", i)] = n
+		case section == sectionStates || section == sectionExperts:
+			name, prob, _ := strings.Cut(text, "=")
+			j := len(p.Columns)
+			p.Columns = append(p.Columns, strings.TrimSpace(name))
+			probs = append(probs, strings.TrimSpace(prob))
+			lines[fmt.Sprintf("columns[%d]", j)] = n
+			lines[fmt.Sprintf("probabilities[%d]", j)] = n
+			// Рядки розділу з некоректним заголовком пропускаються: помилку вже повідомлено
+		}
+	}
+	if err := sc.Err(); err != nil {
+		fail(n+1, "%v", err)
+	}
+
+	for _, s := range []struct{ field, section string }{{"columns", sectionStates}, {"values", sectionMatrix}} {
+		if lines[s.field] == 0 {
+			fail(max(n, 1), errTextSectionMiss, s.section)
+		}
+	}
+	p.Probabilities = parseProbabilities(p.Columns, probs, lines, fail)
+	lines[""] = 1
+	return p, lines, errs
+}
+
+// parseProblemKey розбирає рядок «параметр = значення» до першого розділу
+func parseProblemKey(p *problemFile, lines map[string]int, text string, n int, fail func(int, string, ...any)) {
+	key, value, ok := strings.Cut(text, "=")
+	key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+	if !ok {
+		fail(n, errTextKeyLine, text)
+		return
+	}
+	if lines[key] != 0 {
+		fail(n, errTextKeyDup, key, lines[key])
+		return
+	}
+
+	number := func() *float64 {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			fail(n, errTextNumber, key, value)
+			return nil
+		}
+		return &v
+	}
+	switch key {
+	case "problem":
+		p.Problem = value
+	case "kind":
+		if value != kindAuto && value != kindPayoff && value != kindRanking {
+			fail(n, errTextKind, value)
+		}
+		p.Kind = value
+	case "alpha":
+		p.Alpha = number()
+	case "min":
+		p.Min = number()
+	case "max":
+		p.Max = number()
+	default:
+		fail(n, errTextKey, key)
+		return
+	}
+	lines[key] = n
+}
+
+// parseProbabilities перетворює ймовірності станів; якщо жодну не задано, повертає nil
+func parseProbabilities(states, probs []string, lines map[string]int, fail func(int, string, ...any)) []float64 {
+	given := 0
+	for _, s := range probs {
+		if s != "" {
+			given++
+		}
+	}
+	if given == 0 {
+		return nil
+	}
+	if given < len(probs) {
+		fail(lines["probabilities"], errTextProbMixed)
+		return nil
+	}
+	values := make([]float64, len(probs))
+	for j, s := range probs {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			fail(lines[fmt.Sprintf("probabilities[%d]", j)], errTextProb, states[j], s)
+		}
+		values[j] = v
+	}
+	return values
+}
+
+// checkProblemText розбирає текстовий файл задачі й перевіряє її так само, як
+// tpr validate перевіряє JSON; перевірка полів виконується лише без синтаксичних помилок
+func checkProblemText(data []byte) (*problemFile, []diagnostic) {
+	p, lines, syntaxErrs := parseProblemText(data)
+	var diags []diagnostic
+	for _, e := range syntaxErrs {
+		diags = append(diags, diagnostic{line: e.Line, fieldError: fieldError{Message: e.Message}})
+	}
+	if len(diags) > 0 {
+		return p, sortDiagnostics(diags)
+	}
+	for _, e := range validateProblemFields(p) {
+		diags = append(diags, diagnostic{line: lineOf(lines, e.Field), fieldError: e})
+	}
+	return p, sortDiagnostics(diags)
+}
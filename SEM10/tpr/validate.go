@@ -0,0 +1,352 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	// probabilityEpsilon – допустиме відхилення суми ймовірностей від 1
+	probabilityEpsilon = 1e-6
+
+	errValidateFile     = "Вкажіть файл задачі: tpr validate <задача.json|yaml|csv|tpr>"
+	errValidateXLSX     = "Книга Excel перевіряється під час зчитування (tpr analyze); tpr validate перевіряє файли JSON, YAML, CSV і .tpr"
+	errValidateFailed   = "Знайдено помилок: %d"
+	errValidateSyntax   = "некоректний JSON: %v"
+	errValidateEmpty    = "назва не може бути порожньою"
+	errValidateProbLen  = "кількість ймовірностей (%d) не збігається з кількістю станів (%d)"
+	errValidateProbSum  = "сума ймовірностей %g, а має бути 1"
+	errValidateProb     = "ймовірність %g має бути від 0 до 1"
+	errValidateRange    = "значення %g поза межами [%g; %g]"
+	errValidateMinMax   = "min (%g) більше за max (%g)"
+	errValidateRank     = "ранг %g має бути цілим числом від 1 до %d"
+	errValidateRankDup  = "ранг %d повторюється у стовпці (альтернативи %s)"
+	errValidateRankMiss = "у стовпці немає рангу %d"
+)
+
+// problemFile – задача у форматі JSON: матриця як у запитах tpr serve, а також
+// тип задачі, ймовірності станів і допустимий діапазон значень
+type problemFile struct {
+	Problem       string    `json:"problem,omitempty"`
+	Kind          string    `json:"kind,omitempty"`
+	Alpha         *float64  `json:"alpha,omitempty"`
+	Probabilities []float64 `json:"probabilities,omitempty"`
+	Min           *float64  `json:"min,omitempty"`
+	Max           *float64  `json:"max,omitempty"`
+	decision.Matrix
+}
+
+var problemSchema = &schema{
+	Type:                 "object",
+	Description:          "задача: матриця корисності або ранжування експертів",
+	Required:             []string{"alternatives", "columns", "values"},
+	AdditionalProperties: ptr(false),
+	Properties: func() map[string]*schema {
+		p := matrixProperties("назви станів або експертів", "рядок значень для кожної альтернативи")
+		p["kind"] = &schema{Type: "string", Enum: []string{kindAuto, kindPayoff, kindRanking},
+			Description: "тип задачі (за замовчуванням визначається автоматично)"}
+		p["alpha"] = uncertaintySchema.Properties["alpha"]
+		p["probabilities"] = &schema{Type: "array", Description: "ймовірності станів, сума – 1",
+			Items: &schema{Type: "number", Minimum: ptr(0.0), Maximum: ptr(1.0)}}
+		p["min"] = &schema{Type: "number", Description: "найменше допустиме значення матриці"}
+		p["max"] = &schema{Type: "number", Description: "найбільше допустиме значення матриці"}
+		return p
+	}(),
+}
+
+// validateProblem перевіряє задачу повністю й повертає всі знайдені помилки
+func validateProblem(data []byte) []fieldError {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return []fieldError{{Message: fmt.Sprintf(errValidateSyntax, err)}}
+	}
+	// Після помилок схеми перевірка продовжується, якщо типи полів дозволяють розібрати задачу,
+	// щоб одразу показати всі проблеми файлу
+	errs := validateValue(raw, problemSchema, "")
+	var p problemFile
+	if err := json.Unmarshal(data, &p); err != nil {
+		return errs
+	}
+	// Поля, про які вже повідомила схема (alpha, ймовірності), вдруге не згадуються
+	for _, e := range validateProblemFields(&p) {
+		if !slices.ContainsFunc(errs, func(s fieldError) bool { return s.Field == e.Field }) {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+// validateProblemFields перевіряє розібрану задачу: матрицю, скінченність значень,
+// назви, коефіцієнт оптимізму, ймовірності, діапазон значень і ранги; спільна для
+// всіх форматів задач (JSON, YAML, CSV і текстового)
+func validateProblemFields(p *problemFile) []fieldError {
+	errs := validateMatrix(&p.Matrix)
+	for _, names := range []struct {
+		field string
+		list  []string
+	}{{"alternatives", p.Alternatives}, {"columns", p.Columns}} {
+		for i, name := range names.list {
+			if strings.TrimSpace(name) == "" {
+				errs = append(errs, fieldError{Field: fmt.Sprintf("%s[%d]", names.field, i), Message: errValidateEmpty})
+			}
+		}
+	}
+
+	for i, row := range p.Values {
+		for j, v := range row {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				errs = append(errs, fieldErrors(&decision.ValidationError{Field: fmt.Sprintf("values[%d][%d]", i, j),
+					Value: v, Err: decision.ErrNotFinite})...)
+			}
+		}
+	}
+	if p.Alpha != nil {
+		errs = append(errs, fieldErrors(decision.ValidateAlpha(*p.Alpha))...)
+	}
+
+	if p.Probabilities != nil {
+		for j, v := range p.Probabilities {
+			if !(v >= 0 && v <= 1) {
+				errs = append(errs, fieldError{Field: fmt.Sprintf("probabilities[%d]", j), Message: fmt.Sprintf(errValidateProb, v)})
+			}
+		}
+		if len(p.Probabilities) != len(p.Columns) {
+			errs = append(errs, fieldError{Field: "probabilities",
+				Message: fmt.Sprintf(errValidateProbLen, len(p.Probabilities), len(p.Columns))})
+		}
+		sum := 0.0
+		for _, v := range p.Probabilities {
+			sum += v
+		}
+		if math.Abs(sum-1) > probabilityEpsilon {
+			errs = append(errs, fieldError{Field: "probabilities", Message: fmt.Sprintf(errValidateProbSum, sum)})
+		}
+	}
+
+	lo, hi := math.Inf(-1), math.Inf(1)
+	if p.Min != nil {
+		lo = *p.Min
+	}
+	if p.Max != nil {
+		hi = *p.Max
+	}
+	for _, bound := range []struct {
+		field string
+		v     float64
+	}{{"min", lo}, {"max", hi}} {
+		if math.IsNaN(bound.v) {
+			errs = append(errs, fieldErrors(&decision.ValidationError{Field: bound.field, Value: bound.v, Err: decision.ErrNotFinite})...)
+		}
+	}
+	if lo > hi {
+		errs = append(errs, fieldError{Field: "min", Message: fmt.Sprintf(errValidateMinMax, lo, hi)})
+	}
+	for i, row := range p.Values {
+		for j, v := range row {
+			if v < lo || v > hi {
+				errs = append(errs, fieldError{Field: fmt.Sprintf("values[%d][%d]", i, j),
+					Message: fmt.Sprintf(errValidateRange, v, lo, hi)})
+			}
+		}
+	}
+
+	// Ранги перевіряються, лише якщо розміри матриці узгоджені
+	if p.Kind == kindRanking && len(validateMatrix(&p.Matrix)) == 0 {
+		errs = append(errs, validateRanks(&p.Matrix)...)
+	}
+	return errs
+}
+
+// validateRanks перевіряє, що кожен стовпець є перестановкою рангів 1…n
+func validateRanks(m *decision.Matrix) []fieldError {
+	n := len(m.Alternatives)
+	var errs []fieldError
+	for j := range m.Columns {
+		holders := make(map[int][]string)
+		for i, alt := range m.Alternatives {
+			r := m.Values[i][j]
+			if r != math.Trunc(r) || r < 1 || r > float64(n) {
+				errs = append(errs, fieldError{Field: fmt.Sprintf("values[%d][%d]", i, j),
+					Message: fmt.Sprintf(errValidateRank, r, n)})
+				continue
+			}
+			holders[int(r)] = append(holders[int(r)], alt)
+		}
+		for r := 1; r <= n; r++ {
+			switch alts := holders[r]; {
+			case len(alts) > 1:
+				errs = append(errs, fieldError{Field: fmt.Sprintf("columns[%d]", j),
+					Message: fmt.Sprintf(errValidateRankDup, r, strings.Join(alts, ", "))})
+			case len(alts) == 0 && len(holders) > 0:
+				errs = append(errs, fieldError{Field: fmt.Sprintf("columns[%d]", j),
+					Message: fmt.Sprintf(errValidateRankMiss, r)})
+			}
+		}
+	}
+	return errs
+}
+
+// fieldLines повертає номер рядка, з якого починається значення кожного поля
+// JSON-документа (шлях у форматі помилок перевірки: values[1][0])
+func fieldLines(data []byte) map[string]int {
+	lines := make(map[string]int)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	lineAt := func() int {
+		off := int(dec.InputOffset())
+		for off < len(data) && strings.ContainsRune(" \t\r\n:,", rune(data[off])) {
+			off++
+		}
+		return bytes.Count(data[:off], []byte("\n")) + 1
+	}
+
+	var walk func(path string) error
+	walk = func(path string) error {
+		lines[path] = lineAt()
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'):
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				if err := walk(joinPath(path, fmt.Sprint(key))); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				if err := walk(fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+	walk("")
+	return lines
+}
+
+// lineOf шукає рядок поля; для відсутнього поля береться найближчий наявний батьківський
+func lineOf(lines map[string]int, field string) int {
+	for {
+		if line, ok := lines[field]; ok {
+			return line
+		}
+		i := strings.LastIndexAny(field, ".[")
+		if i < 0 {
+			return lines[""]
+		}
+		field = field[:i]
+	}
+}
+
+// diagnostic – помилка перевірки файлу задачі з номером рядка
+type diagnostic struct {
+	line int
+	fieldError
+}
+
+// format повертає помилку у вигляді «файл:рядок: поле: повідомлення»
+func (d diagnostic) format(path string) string {
+	if d.Field == "" {
+		return fmt.Sprintf("%s:%d: %s", path, d.line, d.Message)
+	}
+	return fmt.Sprintf("%s:%d: %s: %s", path, d.line, d.Field, d.Message)
+}
+
+// sortDiagnostics упорядковує помилки за номером рядка, зберігаючи порядок у межах рядка
+func sortDiagnostics(diags []diagnostic) []diagnostic {
+	sort.SliceStable(diags, func(i, j int) bool { return diags[i].line < diags[j].line })
+	return diags
+}
+
+// syntaxLine повертає рядок синтаксичної помилки JSON
+func syntaxLine(data []byte) int {
+	var raw any
+	var se *json.SyntaxError
+	if err := json.Unmarshal(data, &raw); errors.As(err, &se) {
+		return bytes.Count(data[:min(int(se.Offset), len(data))], []byte("\n")) + 1
+	}
+	return 1
+}
+
+func runValidate(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf(errValidateFile)
+	}
+	path := positional[0]
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	format, err := detectFormat(path, data)
+	if err != nil {
+		return err
+	}
+	if format == formatXLSX {
+		return fmt.Errorf(errValidateXLSX)
+	}
+
+	p, diags := problemChecker(format)(data)
+	if len(diags) == 0 {
+		kind := p.Kind
+		if kind == "" || kind == kindAuto {
+			kind = kindPayoff
+			if p.IsRanking() {
+				kind = kindRanking
+			}
+		}
+		fmt.Printf("%s: задача коректна (тип %s, альтернатив: %d, стовпців: %d)\n",
+			path, kind, len(p.Alternatives), len(p.Columns))
+		return nil
+	}
+
+	for _, d := range diags {
+		fmt.Println(d.format(path))
+	}
+	return fmt.Errorf(errValidateFailed, len(diags))
+}
+
+// checkProblemJSON перевіряє задачу у форматі JSON і визначає рядки помилок
+func checkProblemJSON(data []byte) (*problemFile, []diagnostic) {
+	errs := validateProblem(data)
+	var p problemFile
+	if len(errs) == 0 {
+		json.Unmarshal(data, &p)
+		return &p, nil
+	}
+
+	return &p, locateErrors(errs, fieldLines(data), syntaxLine(data))
+}
+
+// locateErrors визначає рядки помилок перевірки за рядками полів; помилки без
+// поля (синтаксичні) отримують рядок syntax
+func locateErrors(errs []fieldError, lines map[string]int, syntax int) []diagnostic {
+	diags := make([]diagnostic, len(errs))
+	for i, e := range errs {
+		line := lineOf(lines, e.Field)
+		if e.Field == "" {
+			line = syntax
+		}
+		diags[i] = diagnostic{line, e}
+	}
+	return sortDiagnostics(diags)
+}
@@ -0,0 +1,126 @@
+// Редактор задачі та виведення результатів через REST API tpr serve
+"use strict";
+
+const state = {
+  alternatives: ["A1", "A2", "A3"],
+  columns: ["Стан 1", "Стан 2", "Стан 3"],
+  values: [[4, 7, 5], [6, 3, 8], [5, 5, 5]],
+};
+
+const $ = id => document.getElementById(id);
+const kind = () => document.querySelector("input[name=kind]:checked").value;
+const columnWord = () => kind() === "payoff" ? "Стан" : "Експерт";
+
+function el(tag, text, className) {
+  const e = document.createElement(tag);
+  if (text !== undefined) e.textContent = text;
+  if (className) e.className = className;
+  return e;
+}
+
+function input(value, onChange, className) {
+  const e = el("input", undefined, className);
+  e.value = value;
+  e.addEventListener("change", () => onChange(e.value));
+  return e;
+}
+
+function renderMatrix() {
+  const table = $("matrix");
+  table.replaceChildren();
+  const head = table.insertRow();
+  head.append(el("th", "Альтернатива"));
+  state.columns.forEach((c, j) => {
+    const th = el("th");
+    th.append(input(c, v => state.columns[j] = v));
+    head.append(th);
+  });
+  state.alternatives.forEach((alt, i) => {
+    const row = table.insertRow();
+    const name = row.insertCell();
+    name.append(input(alt, v => state.alternatives[i] = v));
+    state.values[i].forEach((v, j) => {
+      row.insertCell().append(input(v, x => state.values[i][j] = Number(x.replace(",", ".")), "number"));
+    });
+  });
+  document.querySelectorAll(".col-name").forEach(s => s.textContent = columnWord().toLowerCase());
+  $("payoff-options").hidden = kind() !== "payoff";
+}
+
+function resize(rows, cols) {
+  if (rows < 1 || cols < 1) return;
+  while (state.alternatives.length < rows) {
+    state.alternatives.push("A" + (state.alternatives.length + 1));
+    state.values.push(state.columns.map(() => 1));
+  }
+  state.alternatives.length = rows;
+  state.values.length = rows;
+  while (state.columns.length < cols) {
+    state.columns.push(columnWord() + " " + (state.columns.length + 1));
+  }
+  state.columns.length = cols;
+  state.values.forEach(row => {
+    while (row.length < cols) row.push(1);
+    row.length = cols;
+  });
+  renderMatrix();
+}
+
+function renderError(body) {
+  const out = $("result");
+  out.replaceChildren(el("p", body.error, "error"));
+  if (body.errors) {
+    const list = el("ul", undefined, "error");
+    body.errors.forEach(e => list.append(el("li", e.field + ": " + e.message)));
+    out.append(list);
+  }
+}
+
+function renderResult(r) {
+  const out = $("result");
+  out.replaceChildren(el("h2", "Результати"));
+  const selected = [...document.querySelectorAll("input[name=criterion]:checked")].map(c => c.value);
+  const criteria = (r.criteria || []).filter(c => selected.includes(c.name));
+
+  if (criteria.length > 0) {
+    const table = el("table");
+    const head = table.insertRow();
+    head.append(el("th", "Альтернатива"), ...criteria.map(c => el("th", c.name)));
+    r.alternatives.forEach((alt, i) => {
+      const row = table.insertRow();
+      row.append(el("td", alt));
+      criteria.forEach(c => row.append(el("td", c.values[i].toFixed(4), c.best.includes(alt) ? "best" : "")));
+    });
+    out.append(table, el("h3", "Ранжування"));
+    criteria.forEach(c => out.append(el("p", c.name + ": " + c.ranking.join(" ≻ "))));
+  }
+  out.append(el("p", "Множина Парето: " + r.pareto.join(", ")));
+}
+
+async function compute() {
+  const body = { problem: $("problem").value, ...state };
+  let url = "/pareto";
+  if (kind() === "payoff") {
+    url = "/uncertainty/criteria";
+    body.alpha = Number($("alpha").value.replace(",", "."));
+  }
+  try {
+    const resp = await fetch(url, {
+      method: "POST",
+      headers: { "Content-Type": "application/json" },
+      body: JSON.stringify(body),
+    });
+    const data = await resp.json();
+    resp.ok ? renderResult(data) : renderError(data);
+  } catch (err) {
+    renderError({ error: String(err) });
+  }
+}
+
+$("add-row").onclick = () => resize(state.alternatives.length + 1, state.columns.length);
+$("del-row").onclick = () => resize(state.alternatives.length - 1, state.columns.length);
+$("add-col").onclick = () => resize(state.alternatives.length, state.columns.length + 1);
+$("del-col").onclick = () => resize(state.alternatives.length, state.columns.length - 1);
+$("compute").onclick = compute;
+document.querySelectorAll("input[name=kind]").forEach(r => r.onchange = renderMatrix);
+renderMatrix();
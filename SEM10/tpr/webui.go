@@ -0,0 +1,22 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// webFiles – односторінковий інтерфейс для експертів, які не працюють
+// з терміналом: редактор матриці, вибір критеріїв і таблиці результатів
+//
+//go:embed web
+var webFiles embed.FS
+
+// webHandler віддає вбудований інтерфейс з кореня сервера
+func webHandler() http.Handler {
+	sub, err := fs.Sub(webFiles, "web")
+	if err != nil {
+		panic(err)
+	}
+	return http.FileServerFS(sub)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"tpr/pkg/decision"
+)
+
+const (
+	errWorkers = "Кількість горутин для обчислення критеріїв має бути цілим числом не менше 1, а не %q"
+
+	workersUsage = "кількість горутин, між якими діляться рядки великих матриць (від 512 альтернатив) під час обчислення " +
+		"критеріїв, матриці жалю та множини Парето; 1 – без паралелізму (за замовчуванням – кількість процесорів)"
+)
+
+// criteriaWorkersFlag задає кількість горутин для обчислень над рядками матриці (-criteria-workers);
+// на результат не впливає, тому в параметри запуску не записується
+type criteriaWorkersFlag struct{}
+
+func (criteriaWorkersFlag) String() string { return "" }
+
+func (criteriaWorkersFlag) Set(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return fmt.Errorf(errWorkers, value)
+	}
+	decision.SetWorkers(n)
+	return nil
+}
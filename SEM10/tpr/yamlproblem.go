@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// errValidateYAML – синтаксична помилка YAML або документ, який не можна подати як JSON
+	errValidateYAML = "некоректний YAML: %v"
+
+	// yamlExtensionPrefix – префікс допоміжних ключів верхнього рівня з якорями
+	yamlExtensionPrefix = "x-"
+)
+
+// yamlErrorLine виділяє номер рядка з повідомлення бібліотеки YAML ("yaml: line 3: …")
+var yamlErrorLine = regexp.MustCompile(`line (\d+)`)
+
+// checkProblemYAML перевіряє задачу у форматі YAML. Документ з коментарями,
+// якорями (&base, *base) і злиттям (<<: *base) розгортається й перетворюється
+// на JSON, тож діють ті самі схема й правила, що й для JSON, а рядки помилок
+// беруться з вузлів YAML.
+func checkProblemYAML(data []byte) (*problemFile, []diagnostic) {
+	js, err := yamlToJSON(data)
+	if err != nil {
+		line := 1
+		if m := yamlErrorLine.FindStringSubmatch(err.Error()); m != nil {
+			line, _ = strconv.Atoi(m[1])
+		}
+		return &problemFile{}, []diagnostic{{line, fieldError{Message: fmt.Sprintf(errValidateYAML, err)}}}
+	}
+
+	errs := validateProblem(js)
+	var p problemFile
+	if len(errs) == 0 {
+		json.Unmarshal(js, &p)
+		return &p, nil
+	}
+	return &p, locateErrors(errs, yamlFieldLines(data), 1)
+}
+
+// yamlToJSON розгортає якорі та злиття YAML-документа й записує його як JSON.
+// Ключі верхнього рівня з префіксом x- (x-defaults: &d …) лише зберігають
+// якорі для повторного використання й до задачі не входять.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var v any
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	if fields, ok := v.(map[string]any); ok {
+		for key := range fields {
+			if strings.HasPrefix(key, yamlExtensionPrefix) {
+				delete(fields, key)
+			}
+		}
+	}
+	return json.Marshal(v)
+}
+
+// yamlFieldLines повертає номер рядка значення кожного поля YAML-документа
+// (шлях у форматі помилок перевірки: values[1][0]). Поля, отримані через
+// псевдонім або злиття, вказують на рядок якоря, а явно задані ключі мають
+// перевагу над злитими.
+func yamlFieldLines(data []byte) map[string]int {
+	lines := make(map[string]int)
+	var doc yaml.Node
+	if yaml.Unmarshal(data, &doc) != nil {
+		return lines
+	}
+
+	var walk func(n *yaml.Node, path string, merged bool)
+	walk = func(n *yaml.Node, path string, merged bool) {
+		if _, ok := lines[path]; !ok || !merged {
+			lines[path] = n.Line
+		}
+		switch n.Kind {
+		case yaml.DocumentNode:
+			for _, c := range n.Content {
+				walk(c, path, merged)
+			}
+		case yaml.AliasNode:
+			walk(n.Alias, path, true)
+		case yaml.SequenceNode:
+			for i, c := range n.Content {
+				walk(c, fmt.Sprintf("%s[%d]", path, i), merged)
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				key, value := n.Content[i], n.Content[i+1]
+				if key.Value != "<<" {
+					walk(value, joinPath(path, key.Value), merged)
+					continue
+				}
+				sources := []*yaml.Node{value}
+				if value.Kind == yaml.SequenceNode {
+					sources = value.Content
+				}
+				for _, s := range sources {
+					walk(s, path, true)
+				}
+			}
+		}
+	}
+	walk(&doc, "", false)
+	lines[""] = 1
+	return lines
+}
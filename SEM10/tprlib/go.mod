@@ -0,0 +1,3 @@
+module tprlib
+
+go 1.22.0
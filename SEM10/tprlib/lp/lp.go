@@ -0,0 +1,231 @@
+// Package lp – розв'язувач задач лінійного програмування, спільний для лабораторних
+// tpr-5 (ваги методу BWM) і tpr-7 (змішані стратегії матричних ігор)
+package lp
+
+import "math"
+
+const eps = 1e-9
+
+// Знак обмеження
+const (
+	LessEqual Sense = iota
+	GreaterEqual
+	Equal
+)
+
+type (
+	// Sense – знак обмеження: <=, >= або =
+	Sense int
+
+	// Constraint описує обмеження Coef·x (<=, >=, =) RHS
+	Constraint struct {
+		Coef  []float64
+		Sense Sense
+		RHS   float64
+	}
+
+	// simplexTableau – симплекс-таблиця; останній стовпець містить праві частини
+	simplexTableau struct {
+		rows  [][]float64
+		obj   []float64
+		basis []int
+	}
+)
+
+// Minimize розв'язує задачу лінійного програмування min c·x при x >= 0
+// двофазним симплекс-методом з правилом Бленда (без зациклення).
+// Повертає false, якщо допустимих розв'язків немає або цільова функція необмежена.
+func Minimize(c []float64, constraints []Constraint) ([]float64, float64, bool) {
+	n := len(c)
+	m := len(constraints)
+
+	// Праві частини обмежень мають бути невід'ємними
+	normalized := make([]Constraint, m)
+	slackCount, artCount := 0, 0
+	for i, con := range constraints {
+		normalized[i] = con
+		if con.RHS < 0 {
+			normalized[i] = Constraint{
+				Coef:  make([]float64, len(con.Coef)),
+				Sense: flipSense(con.Sense),
+				RHS:   -con.RHS,
+			}
+			for j, v := range con.Coef {
+				normalized[i].Coef[j] = -v
+			}
+		}
+		if normalized[i].Sense != Equal {
+			slackCount++
+		}
+		if normalized[i].Sense != LessEqual {
+			artCount++
+		}
+	}
+
+	width := n + slackCount + artCount
+	t := &simplexTableau{
+		rows:  make([][]float64, m),
+		basis: make([]int, m),
+	}
+
+	slack, art := n, n+slackCount
+	isArtificial := make([]bool, width)
+	for i, con := range normalized {
+		row := make([]float64, width+1)
+		copy(row, con.Coef)
+		row[width] = con.RHS
+
+		switch con.Sense {
+		case LessEqual:
+			row[slack] = 1
+			t.basis[i] = slack
+			slack++
+		case GreaterEqual:
+			row[slack] = -1
+			slack++
+			fallthrough
+		case Equal:
+			row[art] = 1
+			isArtificial[art] = true
+			t.basis[i] = art
+			art++
+		}
+		t.rows[i] = row
+	}
+
+	// Фаза 1: мінімізуємо суму штучних змінних
+	if artCount > 0 {
+		cost := make([]float64, width)
+		for j := range width {
+			if isArtificial[j] {
+				cost[j] = 1
+			}
+		}
+		t.setObjective(cost)
+		if !t.solve(nil) || -t.obj[width] > eps {
+			return nil, 0, false
+		}
+		t.dropArtificial(isArtificial)
+	}
+
+	// Фаза 2: мінімізуємо початкову цільову функцію
+	cost := make([]float64, width)
+	copy(cost, c)
+	t.setObjective(cost)
+	if !t.solve(isArtificial) {
+		return nil, 0, false
+	}
+
+	x := make([]float64, n)
+	for i, b := range t.basis {
+		if b < n {
+			x[b] = t.rows[i][width]
+		}
+	}
+	value := 0.0
+	for j := range n {
+		value += c[j] * x[j]
+	}
+	return x, value, true
+}
+
+func flipSense(sense Sense) Sense {
+	switch sense {
+	case LessEqual:
+		return GreaterEqual
+	case GreaterEqual:
+		return LessEqual
+	}
+	return sense
+}
+
+// setObjective записує рядок відносних оцінок для поточного базису
+func (t *simplexTableau) setObjective(cost []float64) {
+	width := len(cost)
+	t.obj = make([]float64, width+1)
+	copy(t.obj, cost)
+	for i, b := range t.basis {
+		if cost[b] == 0 {
+			continue
+		}
+		for j := range t.obj {
+			t.obj[j] -= cost[b] * t.rows[i][j]
+		}
+	}
+}
+
+// solve виконує симплекс-ітерації; стовпці з blocked не вводяться в базис
+func (t *simplexTableau) solve(blocked []bool) bool {
+	width := len(t.obj) - 1
+	for {
+		enter := -1
+		for j := range width {
+			if blocked != nil && blocked[j] {
+				continue
+			}
+			if t.obj[j] < -eps {
+				enter = j
+				break
+			}
+		}
+		if enter < 0 {
+			return true
+		}
+
+		leave := -1
+		best := math.Inf(1)
+		for i, row := range t.rows {
+			if row[enter] <= eps {
+				continue
+			}
+			ratio := row[width] / row[enter]
+			if ratio < best-eps || (math.Abs(ratio-best) <= eps && t.basis[i] < t.basis[leave]) {
+				best, leave = ratio, i
+			}
+		}
+		if leave < 0 {
+			return false
+		}
+		t.pivot(leave, enter)
+	}
+}
+
+// dropArtificial виводить з базису штучні змінні, що залишились після фази 1
+func (t *simplexTableau) dropArtificial(isArtificial []bool) {
+	width := len(t.obj) - 1
+	for i, b := range t.basis {
+		if !isArtificial[b] {
+			continue
+		}
+		for j := range width {
+			if !isArtificial[j] && math.Abs(t.rows[i][j]) > eps {
+				t.pivot(i, j)
+				break
+			}
+		}
+	}
+}
+
+func (t *simplexTableau) pivot(row, col int) {
+	p := t.rows[row][col]
+	for j := range t.rows[row] {
+		t.rows[row][j] /= p
+	}
+	for i := range t.rows {
+		if i != row {
+			eliminate(t.rows[i], t.rows[row], col)
+		}
+	}
+	eliminate(t.obj, t.rows[row], col)
+	t.basis[row] = col
+}
+
+func eliminate(target, pivotRow []float64, col int) {
+	f := target[col]
+	if f == 0 {
+		return
+	}
+	for j := range target {
+		target[j] -= f * pivotRow[j]
+	}
+}
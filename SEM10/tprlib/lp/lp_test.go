@@ -0,0 +1,46 @@
+package lp
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestMinimize(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		c           []float64
+		constraints []Constraint
+		x           []float64
+		value       float64
+		ok          bool
+	}{
+		// Вершина перетину x + 2y = 4 і 3x + y = 6
+		{"<=", []float64{-1, -1}, []Constraint{
+			{Coef: []float64{1, 2}, Sense: LessEqual, RHS: 4},
+			{Coef: []float64{3, 1}, Sense: LessEqual, RHS: 6},
+		}, []float64{1.6, 1.2}, -2.8, true},
+		// Фаза 1 потрібна для >= і =
+		{">= і =", []float64{1, 1}, []Constraint{
+			{Coef: []float64{1, 1}, Sense: GreaterEqual, RHS: 2},
+			{Coef: []float64{1, -1}, Sense: Equal, RHS: 0},
+		}, []float64{1, 1}, 2, true},
+		// -x <= -3 перетворюється на x >= 3
+		{"від'ємна права частина", []float64{1}, []Constraint{
+			{Coef: []float64{-1}, Sense: LessEqual, RHS: -3},
+		}, []float64{3}, 3, true},
+		{"немає допустимих розв'язків", []float64{1}, []Constraint{
+			{Coef: []float64{1}, Sense: LessEqual, RHS: 1},
+			{Coef: []float64{1}, Sense: GreaterEqual, RHS: 2},
+		}, nil, 0, false},
+		{"необмежена", []float64{-1}, []Constraint{
+			{Coef: []float64{1}, Sense: GreaterEqual, RHS: 1},
+		}, nil, 0, false},
+	} {
+		x, value, ok := Minimize(tt.c, tt.constraints)
+		same := slices.EqualFunc(x, tt.x, func(a, b float64) bool { return math.Abs(a-b) <= 1e-9 })
+		if ok != tt.ok || !same || math.Abs(value-tt.value) > 1e-9 {
+			t.Errorf("%s: Minimize = %v, %v, %v; очікувалося %v, %v, %v", tt.name, x, value, ok, tt.x, tt.value, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Package progress – індикатор тривалих обчислень лабораторних робіт: смуга з
+// відсотком і оцінкою часу до завершення у стандартному потоці помилок.
+// Ним звітують цикли, що можуть тривати тисячі кроків, – SMAA у tpr-5 та ітерації
+// за цінністю у tpr-8; симплекс-метод, BWM та ітерації за стратегіями
+// завершуються за кілька кроків і індикатора не мають.
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// progressWidth – ширина смуги прогресу в символах
+	progressWidth = 30
+	// progressTTYInterval і progressLogInterval – як часто оновлюється стан у терміналі
+	// та як часто додається рядок, коли stderr перенаправлено у файл
+	progressTTYInterval = 200 * time.Millisecond
+	progressLogInterval = 5 * time.Second
+)
+
+// Bar виводить у stderr стан тривалих обчислень: смугу, відсоток і оцінку
+// часу до завершення.
+type Bar struct {
+	w        io.Writer
+	tty      bool
+	label    string
+	total    int
+	start    time.Time
+	last     time.Time
+	interval time.Duration
+}
+
+// New створює індикатор для total кроків. У терміналі смуга
+// перемальовується в одному рядку, інакше періодично додаються рядки стану.
+func New(label string, total int) *Bar {
+	tty := false
+	if info, err := os.Stderr.Stat(); err == nil {
+		tty = info.Mode()&os.ModeCharDevice != 0
+	}
+	p := &Bar{w: os.Stderr, tty: tty, label: label, total: total, start: time.Now(), interval: progressLogInterval}
+	if tty {
+		p.interval = progressTTYInterval
+	}
+	return p
+}
+
+// Update повідомляє, що виконано done кроків; вивід оновлюється не частіше за interval
+func (p *Bar) Update(done int) {
+	now := time.Now()
+	if now.Sub(p.last) < p.interval && done < p.total {
+		return
+	}
+	p.last = now
+
+	// Оцінка total може виявитися заниженою, тому частка обмежується одиницею
+	fraction := min(float64(done)/float64(p.total), 1)
+	eta := "?"
+	if done > 0 {
+		elapsed := now.Sub(p.start)
+		eta = formatETA(time.Duration(float64(elapsed) / fraction * (1 - fraction)))
+	}
+	status := fmt.Sprintf("%s: %5.1f%% (%d/%d), залишилось ≈ %s", p.label, fraction*100, done, p.total, eta)
+	if !p.tty {
+		fmt.Fprintln(p.w, status)
+		return
+	}
+	filled := int(fraction * progressWidth)
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressWidth-filled)
+	// \033[K стирає залишок попереднього, довшого рядка
+	fmt.Fprintf(p.w, "\r[%s] %s\033[K", bar, status)
+}
+
+// SetTotal змінює кількість кроків, коли її заздалегідь відомо лише
+// приблизно (ітераційні методи уточнюють оцінку в міру збіжності)
+func (p *Bar) SetTotal(total int) {
+	p.total = max(total, 1)
+}
+
+// Done завершує вивід: у терміналі переводить рядок і повідомляє загальний час
+func (p *Bar) Done(done int) {
+	if p.tty {
+		fmt.Fprint(p.w, "\r\033[K")
+	}
+	fmt.Fprintf(p.w, "%s: виконано %d з %d за %s\n", p.label, done, p.total, formatETA(time.Since(p.start)))
+}
+
+// formatETA округлює тривалість до секунд, а коротші за секунду – до десятих
+func formatETA(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(100 * time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,3 @@
+module tprtest
+
+go 1.22.0
@@ -0,0 +1,43 @@
+// Package tprtest – спільні допоміжні функції фазинг-тестів лабораторних tpr-2…tpr-9:
+// введення для інтерактивних читачів і приглушення виводу їхніх запитів
+package tprtest
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Input повертає читача введення з даних фазера data; після них ідуть рядки valid –
+// коректні відповіді, щоб цикл повторного запиту завершився до кінця введення
+// (на якому програма виходить)
+func Input(data string, valid ...string) *bufio.Reader {
+	var b strings.Builder
+	b.WriteString(data)
+	for _, v := range valid {
+		b.WriteString("\n" + v + "\n")
+	}
+	return bufio.NewReader(strings.NewReader(b.String()))
+}
+
+// FirstAnswer повертає першу відповідь із даних фазера так, як її прочитає
+// readString: перший рядок без пробілів на краях
+func FirstAnswer(data string) string {
+	line, _, _ := strings.Cut(data, "\n")
+	return strings.TrimSpace(line)
+}
+
+// Silence перенаправляє стандартний вивід у os.DevNull до завершення тесту
+func Silence(tb testing.TB) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = devNull
+	tb.Cleanup(func() {
+		os.Stdout = stdout
+		devNull.Close()
+	})
+}